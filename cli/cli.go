@@ -0,0 +1,152 @@
+// Package cli implements the commands that Wahay can run from a terminal,
+// without a display and without ever initializing GTK.
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// The exit codes of the commands. Wrong arguments, including
+// invalid meeting addresses, are reported as usage errors
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+var errTorNoBinary = errors.New("tor can't be used")
+
+type command func(args []string) int
+
+var commands = map[string]command{
+	"host": host,
+	"join": join,
+}
+
+// Execute runs the command named by the first of the given arguments, and
+// returns the exit code for the process. If there is no such command, it
+// returns false so Wahay can start the graphical interface instead
+func Execute(args []string) (int, bool) {
+	if len(args) == 0 {
+		return exitOK, false
+	}
+
+	c, ok := commands[args[0]]
+	if !ok {
+		return exitOK, false
+	}
+
+	return c(args[1:]), true
+}
+
+// Commands returns the names of the commands that can be run
+func Commands() []string {
+	result := []string{}
+	for name := range commands {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// loadConfig loads the configuration file of the profile given in the
+// command line, if it exists. Since there is nobody to ask for the master
+// password, an encrypted configuration can't be read, and the default
+// values are used instead
+func loadConfig() *config.ApplicationConfig {
+	conf := config.New()
+	conf.Init()
+	// The name was already checked with the rest of the arguments,
+	// so it only fails when the directory can't be created
+	err := conf.SetProfile(*config.Profile)
+	if err != nil {
+		log.Warnf("The profile can't be created, using the default settings: %s", err)
+		return conf
+	}
+
+	configFile, err := conf.DetectPersistence()
+	if err != nil || !conf.IsPersistentConfiguration() {
+		return conf
+	}
+
+	if conf.ShouldEncrypt() {
+		log.Warn("The configuration file is encrypted, using the default settings")
+		conf.InitDefault()
+		return conf
+	}
+
+	invalid, _, err := conf.LoadFromFile(configFile, nil)
+	if err == config.ErrConfigFileTooNew {
+		log.Warn("The configuration file was written by a newer version of Wahay, using the default settings")
+		conf.SetPersistentConfiguration(false)
+		conf.InitDefault()
+		return conf
+	}
+
+	if invalid || err != nil {
+		log.Warnf("The configuration file can't be loaded, using the default settings: %v", err)
+		conf.InitDefault()
+	}
+
+	return conf
+}
+
+// cleanup keeps the functions to call before exiting, in the same way the
+// graphical interface does, so interrupting Wahay never leaves Tor or the
+// meeting servers behind
+type cleanup struct {
+	callbacks []func()
+}
+
+func (c *cleanup) add(cb func()) {
+	c.callbacks = append(c.callbacks, cb)
+}
+
+func (c *cleanup) run() {
+	log.Debug("Cleaning Wahay...")
+
+	// The last things created depend on the first ones,
+	// so they have to go away first
+	for i := len(c.callbacks) - 1; i >= 0; i-- {
+		c.callbacks[i]()
+	}
+	c.callbacks = nil
+}
+
+func startTor(conf *config.ApplicationConfig, c *cleanup) (tor.Instance, error) {
+	instance, err := tor.NewInstance(conf, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance == nil {
+		return nil, errTorNoBinary
+	}
+
+	c.add(instance.Destroy)
+
+	return instance, nil
+}
+
+func waitForInterrupt() os.Signal {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(c)
+
+	return <-c
+}
+
+func failf(format string, args ...interface{}) int {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	return exitFailure
+}
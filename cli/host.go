@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+const defaultWelcomeText = "Welcome to this server running <b>Wahay</b>."
+
+type hostOptions struct {
+	password    string
+	port        string
+	welcomeText string
+}
+
+func parseHostOptions(args []string) (*hostOptions, error) {
+	o := &hostOptions{}
+
+	fs := flag.NewFlagSet("wahay host", flag.ContinueOnError)
+	fs.StringVar(&o.password, "password", "", "the password participants need to join the meeting")
+	fs.StringVar(&o.port, "port", "", "the port of the meeting in the onion service (default "+strconv.Itoa(hosting.DefaultPort)+")")
+	fs.StringVar(&o.welcomeText, "welcome", defaultWelcomeText, "the text participants see when joining")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if fs.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected argument: %s", fs.Arg(0))
+	}
+
+	return o, nil
+}
+
+// host runs a meeting until Wahay is interrupted
+func host(args []string) int {
+	o, err := parseHostOptions(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	c := &cleanup{}
+	defer c.run()
+
+	conf := loadConfig()
+
+	if len(o.port) == 0 {
+		o.port = conf.GetPortMumble()
+	}
+
+	t, err := startTor(conf, c)
+	if err != nil {
+		return failf("Tor can't be started: %s", err)
+	}
+
+	servers, err := hosting.CreateServerCollection()
+	if err != nil {
+		return failf("The meeting server can't be created: %s", err)
+	}
+
+	service, err := servers.NewService(o.port, t)
+	if err != nil {
+		return failf("The meeting can't be created: %s", err)
+	}
+
+	c.add(func() {
+		err := service.Close()
+		if err != nil {
+			log.Errorf("The meeting can't be closed: %s", err)
+		}
+	})
+
+	service.SetWelcomeText(o.welcomeText)
+
+	err = service.NewConferenceRoom(o.password, hosting.SuperUserData{})
+	if err != nil {
+		return failf("The meeting can't be started: %s", err)
+	}
+
+	fmt.Println(invitationText(service.URL(), o.password))
+
+	sig := waitForInterrupt()
+	log.Infof("Received %s, finishing the meeting", sig)
+
+	return exitOK
+}
+
+func invitationText(url, password string) string {
+	text := fmt.Sprintf("Please join the Wahay meeting with the following details:\n\nMeeting ID: %s\n", url)
+	if len(password) != 0 {
+		text += fmt.Sprintf("Password: %s\n", password)
+	}
+	return text
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/client"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+var errMissingMeetingAddr = errors.New("the meeting address is required")
+
+func parseJoinOptions(args []string) (hosting.MeetingData, error) {
+	var username, password string
+
+	fs := flag.NewFlagSet("wahay join", flag.ContinueOnError)
+	fs.StringVar(&username, "username", "", "the name other participants will see")
+	fs.StringVar(&password, "password", "", "the password of the meeting")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: wahay join [options] <meeting ID, wahay:// invitation or mumble:// URL>\n")
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return hosting.MeetingData{}, err
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return hosting.MeetingData{}, errMissingMeetingAddr
+	}
+
+	data, err := hosting.ParseMeetingAddress(fs.Arg(0))
+	if err != nil {
+		return data, err
+	}
+
+	if len(username) != 0 {
+		data.Username = username
+	}
+
+	if len(password) != 0 {
+		data.Password = password
+	}
+
+	return data, nil
+}
+
+// join runs Mumble connected to the given meeting,
+// and returns once Mumble has been closed
+func join(args []string) int {
+	data, err := parseJoinOptions(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	c := &cleanup{}
+	defer c.run()
+
+	conf := loadConfig()
+
+	t, err := startTor(conf, c)
+	if err != nil {
+		return failf("Tor can't be started: %s", err)
+	}
+
+	mumble := client.InitSystem(conf, t)
+	if !mumble.IsValid() {
+		return failf("Mumble client can't be used: %s", mumble.LastError())
+	}
+
+	for _, w := range mumble.Warnings() {
+		log.Warn(w)
+	}
+
+	c.add(mumble.Destroy)
+
+	interrupted := make(chan bool)
+	go func() {
+		sig := waitForInterrupt()
+		log.Infof("Received %s, leaving the meeting", sig)
+		close(interrupted)
+	}()
+
+	r := &client.Rejoiner{}
+
+	for {
+		closed := make(chan tor.ExitStatus, 1)
+
+		s, err := mumble.Launch(data.GenerateURL(), func(status tor.ExitStatus) {
+			closed <- status
+		})
+		var certErr *client.CertificateError
+		if errors.As(err, &certErr) {
+			return failf("The certificate of the meeting couldn't be checked, so it was not joined: %s\n"+
+				"Try again in a moment, or disable the strict certificate mode in the settings.", err)
+		}
+		if err != nil {
+			return failf("Mumble can't be started: %s", err)
+		}
+
+		var status tor.ExitStatus
+
+		select {
+		case status = <-closed:
+		case <-interrupted:
+			s.Close()
+			<-closed
+			return exitOK
+		}
+
+		delay, rejoin := r.Next(status)
+		if !rejoin {
+			if status.Crashed() {
+				return failf("Mumble finished unexpectedly: %s", status.Err)
+			}
+			if client.HandedOff(status) {
+				return failf("Mumble closed right after starting. Another Mumble that is already running " +
+					"probably took the meeting, without the settings Wahay made for it. Please close it and try again.")
+			}
+			return exitOK
+		}
+
+		log.Warnf("Mumble finished unexpectedly, joining the meeting again in %s", delay)
+
+		select {
+		case <-time.After(delay):
+		case <-interrupted:
+			return exitOK
+		}
+	}
+}
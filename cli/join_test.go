@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/digitalautonomy/wahay/hosting"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type CLIJoinSuite struct{}
+
+var _ = Suite(&CLIJoinSuite{})
+
+const testMeetingID = "qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion"
+
+func (s *CLIJoinSuite) Test_parseJoinOptions_letsFlagsOverrideTheURL(c *C) {
+	data, err := parseJoinOptions([]string{"--username", "bob", "mumble://alice:secret@" + testMeetingID})
+
+	c.Assert(err, IsNil)
+	c.Assert(data.Username, Equals, "bob")
+	c.Assert(data.Password, Equals, "secret")
+}
+
+func (s *CLIJoinSuite) Test_parseJoinOptions_acceptsAnInvitation(c *C) {
+	data, err := parseJoinOptions([]string{"wahay://" + testMeetingID + "?username=carol"})
+
+	c.Assert(err, IsNil)
+	c.Assert(data.MeetingID, Equals, testMeetingID)
+	c.Assert(data.Port, Equals, hosting.DefaultPort)
+	c.Assert(data.Username, Equals, "carol")
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// These are the values of the transmit modes in the Mumble settings
+var mumbleTransmitModes = map[string]int{
+	config.TransmitContinuous:    0,
+	config.TransmitVoiceActivity: 1,
+	config.TransmitPushToTalk:    2,
+}
+
+// mumbleDeviceSections are the sections of the Mumble settings that
+// have the devices of each sound system. The other sound systems
+// always use the default devices
+var mumbleDeviceSections = map[string]string{
+	config.AudioBackendPulseAudio: "pulseaudio",
+	config.AudioBackendALSA:       "alsa",
+}
+
+// pushToTalkKeycodes are the X11 keycodes of the keys
+// that Mumble can use for push-to-talk
+var pushToTalkKeycodes = map[string]uint32{
+	"Control_R":   105,
+	"Control_L":   37,
+	"Alt_R":       108,
+	"Shift_R":     62,
+	"Super_R":     134,
+	"Menu":        135,
+	"Caps_Lock":   66,
+	"Scroll_Lock": 78,
+	"Pause":       127,
+	"F12":         96,
+}
+
+// mumbleIniData is what the mumble.ini template is rendered with.
+// The certificate and the proxy are only known when Mumble is about
+// to run, so the file is rendered again at that moment
+type mumbleIniData struct {
+	Language string
+	Audio    mumbleAudioSettings
+	// Certificate is the client certificate in the @ByteArray
+	// format, or empty if it's not known yet
+	Certificate string
+	// Proxy is only used by the Mumble that can't be run with torsocks
+	Proxy *mumbleProxy
+}
+
+// mumbleAudioSettings are the audio preferences
+// written the way Mumble reads them
+type mumbleAudioSettings struct {
+	Backend       string
+	Quality       int
+	Transmit      int
+	VADMin        string
+	VADMax        string
+	DeviceSection string
+	InputDevice   string
+	OutputDevice  string
+	PushToTalkKey string
+}
+
+func (c *client) mumbleIniData() mumbleIniData {
+	p := config.DefaultAudioPreferences()
+	if c.conf != nil {
+		p = c.conf.GetAudioPreferences()
+	}
+
+	if p.Backend == config.AudioBackendPipeWire && c.binary != nil && !c.binary.supports(featurePipeWire) {
+		// PipeWire also serves the applications that use PulseAudio
+		log.Debug("This version of Mumble doesn't support PipeWire, using PulseAudio instead")
+		p.Backend = config.AudioBackendPulseAudio
+	}
+
+	return mumbleIniData{
+		Language:    config.DetectLanguage().String(),
+		Audio:       mumbleAudioSettingsFor(p),
+		Certificate: c.certificate,
+		Proxy:       c.proxy,
+	}
+}
+
+func mumbleAudioSettingsFor(p config.AudioPreferences) mumbleAudioSettings {
+	threshold := float64(p.VoiceThreshold) / 100
+
+	s := mumbleAudioSettings{
+		Backend:       p.Backend,
+		Quality:       p.Quality,
+		Transmit:      mumbleTransmitModes[p.Transmit],
+		VADMin:        strconv.FormatFloat(threshold*0.8, 'f', 2, 64),
+		VADMax:        strconv.FormatFloat(threshold, 'f', 2, 64),
+		PushToTalkKey: mumbleShortcutKeys(pushToTalkKeycodes[p.PushToTalkKey]),
+	}
+
+	if section, ok := mumbleDeviceSections[p.Backend]; ok {
+		s.DeviceSection = section
+		s.InputDevice = p.InputDevice
+		s.OutputDevice = p.OutputDevice
+	}
+
+	return s
+}
+
+func renderMumbleIni(content string, data mumbleIniData) (string, error) {
+	t, err := template.New(configFileName).Funcs(template.FuncMap{
+		"ini": iniValue,
+	}).Parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	err = t.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// mumbleShortcutKeys returns the keys of a Mumble shortcut that is
+// the given key alone. It's a list of one int serialized by Qt, with
+// the same layout that Mumble writes in its configuration file
+func mumbleShortcutKeys(keycode uint32) string {
+	data := []rune{
+		0, 0, 0, 9, // a list
+		0, 0, 0, 1, // of one element
+		0, 0, 0, 2, // that is an int
+		rune(keycode >> 24 & 0xff), rune(keycode >> 16 & 0xff), rune(keycode >> 8 & 0xff), rune(keycode & 0xff),
+	}
+	return fmt.Sprintf("@Variant(%s)", qtEscape(data))
+}
+
+// iniValue returns the text written as a string
+// value that QSettings reads back in the same way
+func iniValue(s string) string {
+	escaped := qtEscape([]rune(s))
+	if strings.ContainsAny(s, ";,=") || strings.HasPrefix(s, " ") || strings.HasSuffix(s, " ") {
+		return `"` + escaped + `"`
+	}
+	return escaped
+}
+
+// qtEscape escapes the characters the same way QSettings does in ini files.
+// After an escaped character, a hexadecimal digit has to be escaped too,
+// or it would be read as part of the escape sequence
+func qtEscape(data []rune) string {
+	var b strings.Builder
+	escapeNextIfDigit := false
+
+	for _, ch := range data {
+		if escapeNextIfDigit && isHexDigit(ch) {
+			fmt.Fprintf(&b, `\x%x`, ch)
+			continue
+		}
+
+		escapeNextIfDigit = false
+
+		switch ch {
+		case 0:
+			b.WriteString(`\0`)
+			escapeNextIfDigit = true
+		case '\a':
+			b.WriteString(`\a`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\v':
+			b.WriteString(`\v`)
+		case '"', '\\':
+			b.WriteRune('\\')
+			b.WriteRune(ch)
+		default:
+			if ch <= 0x1f || ch >= 0x7f {
+				fmt.Fprintf(&b, `\x%x`, ch)
+				escapeNextIfDigit = true
+			} else {
+				b.WriteRune(ch)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func isHexDigit(ch rune) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+type ClientAudioSuite struct{}
+
+var _ = Suite(&ClientAudioSuite{})
+
+func (s *ClientAudioSuite) Test_mumbleShortcutKeys_writesWhatMumbleWrites(c *C) {
+	c.Assert(mumbleShortcutKeys(105), Equals, `@Variant(\0\0\0\t\0\0\0\x1\0\0\0\x2\0\0\0i)`)
+	c.Assert(mumbleShortcutKeys(66), Equals, `@Variant(\0\0\0\t\0\0\0\x1\0\0\0\x2\0\0\0\x42)`)
+	c.Assert(mumbleShortcutKeys(134), Equals, `@Variant(\0\0\0\t\0\0\0\x1\0\0\0\x2\0\0\0\x86)`)
+}
+
+func (s *ClientAudioSuite) Test_iniValue_quotesWhatQSettingsWouldSplit(c *C) {
+	c.Assert(iniValue("alsa_input.usb-mic"), Equals, "alsa_input.usb-mic")
+	c.Assert(iniValue("hw:0,0"), Equals, `"hw:0,0"`)
+	c.Assert(iniValue("a \"b\"\nc"), Equals, `a \"b\"\nc`)
+	c.Assert(iniValue("Micrófono"), Equals, `Micr\xf3\x66ono`)
+}
+
+func (s *ClientAudioSuite) Test_renderMumbleIni_writesTheAudioPreferences(c *C) {
+	p := config.DefaultAudioPreferences()
+	p.Backend = config.AudioBackendALSA
+	p.InputDevice = "hw:1,0"
+	p.Transmit = config.TransmitVoiceActivity
+	p.VoiceThreshold = 40
+	p.Quality = config.AudioQualityBalanced
+
+	content, err := renderMumbleIni(rederMumbleIniConfig(), mumbleIniData{
+		Language: "en",
+		Audio:    mumbleAudioSettingsFor(p),
+	})
+	c.Assert(err, IsNil)
+
+	c.Assert(strings.Contains(content, "[audio]\ninput=ALSA\noutput=ALSA\nquality=40000\ntransmit=1\n"+
+		"vadsource=0\nvadmin=0.32\nvadmax=0.40\n\n[alsa]\ninput=\"hw:1,0\"\n\n[shortcuts]\n"), Equals, true)
+	c.Assert(strings.HasSuffix(content, "language=en"), Equals, true)
+
+	p.Backend = config.AudioBackendJACK
+	content, err = renderMumbleIni(rederMumbleIniConfig(), mumbleIniData{Audio: mumbleAudioSettingsFor(p)})
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(content, "vadmax=0.40\n\n[shortcuts]\n"), Equals, true)
+}
+
+func (s *ClientAudioSuite) Test_renderMumbleIni_onlyWritesTheCertificateAndTheProxyWhenKnown(c *C) {
+	content, err := renderMumbleIni(rederMumbleIniConfig(), mumbleIniData{})
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(content, "[net]\ntcponly=true\n\n[overlay]\n"), Equals, true)
+
+	content, err = renderMumbleIni(rederMumbleIniConfig(), mumbleIniData{
+		Certificate: "@ByteArray(0a)",
+		Proxy:       &mumbleProxy{Type: mumbleSocks5Proxy, Host: "127.0.0.1", Port: 9050, Username: "u", Password: "p"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(content, "[net]\ntcponly=true\ncertificate=@ByteArray(0a)\nproxytype=2\n"+
+		"proxyhost=127.0.0.1\nproxyport=9050\nproxyusername=u\nproxypassword=p\n\n[overlay]\n"), Equals, true)
+}
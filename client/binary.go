@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ var (
 	errBinaryAlreadyExists        = errors.New("the binary already exists in the destination directory")
 	errDestinationIsNotADirectory = errors.New("the destination to copy the binary is not a directory")
 	errNoClientInConfiguredPath   = errors.New("no client in the configured path")
+	errNoMumbleBinary             = errors.New("a valid binary of Mumble is no available in your system")
 )
 
 const (
@@ -39,18 +41,19 @@ type binary struct {
 	// with Wahay or not
 	isBundle bool
 
-	// shouldBeCopied is a boolean indicating if the detected Mumble client
-	// should be copied or not to a temporary directory
-	shouldBeCopied bool
-
-	// isTemporary is a boolean indicating were the Mumble client has been
-	// copied or not to a temporary directory and we should remove it when
-	// Wahay has finished using it
-	isTemporary bool
-
 	// env contains the Mumble binary required environment variables
 	env []string
 
+	// sandbox is not nil when Mumble is installed as a Flatpak or a Snap,
+	// and then path is the program that runs it inside the sandbox
+	sandbox *sandbox
+
+	// version is the version of Mumble, or nil if it can't be detected
+	version *mumbleVersion
+
+	// warnings are the problems that this version of Mumble might have with Wahay
+	warnings []error
+
 	// The last occurred error during Mumble binary detection
 	lastError error
 }
@@ -92,24 +95,10 @@ func (b *binary) copyTo(path string) error {
 	}
 
 	b.path = filepath.Join(destination)
-	b.isTemporary = true
 
 	return nil
 }
 
-func (b *binary) destroy() {
-	b.remove()
-}
-
-func (b *binary) remove() {
-	if b.isTemporary {
-		err := os.RemoveAll(filepath.Dir(b.path))
-		if err != nil {
-			log.Errorf("An error occurred while removing Mumble temp directory: %s", err.Error())
-		}
-	}
-}
-
 func closeAndIgnore(c io.Closer) {
 	_ = c.Close()
 }
@@ -145,12 +134,10 @@ func (b *binary) copyBinaryToDir(destination string) error {
 
 func newBinary(path string) *binary {
 	b := &binary{
-		isValid:        true,
-		isBundle:       false,
-		env:            []string{},
-		lastError:      nil,
-		shouldBeCopied: false,
-		isTemporary:    false,
+		isValid:   true,
+		isBundle:  false,
+		env:       []string{},
+		lastError: nil,
 	}
 
 	b.path = realBinaryPath(path)
@@ -175,15 +162,21 @@ func realBinaryPath(path string) string {
 	return path
 }
 
-func searchBinary(conf *config.ApplicationConfig) *binary {
+// searchBinary returns the first Mumble that Wahay can use. If none is
+// found but some has a version that Wahay can't use, that is the error
+func searchBinary(conf *config.ApplicationConfig) (*binary, error) {
 	callbacks := []func() (*binary, error){
 		searchBinaryInConf(conf),
 		searchBinaryInLocalDir,
 		searchBinaryInCurrentWorkingDir,
 		searchBinaryInDataDir,
 		searchBinaryInSystem,
+		searchBinaryInFlatpak,
+		searchBinaryInSnap,
 	}
 
+	var incompatible error
+
 	for _, c := range callbacks {
 		b, err := c()
 
@@ -206,10 +199,25 @@ func searchBinary(conf *config.ApplicationConfig) *binary {
 			continue
 		}
 
-		return b
+		warnings, err := b.checkCompatibility()
+		if err != nil {
+			log.Debugf("searchBinary(): %s", err)
+			if incompatible == nil {
+				incompatible = err
+			}
+			continue
+		}
+
+		b.warnings = warnings
+
+		return b, nil
 	}
 
-	return nil
+	if incompatible != nil {
+		return nil, incompatible
+	}
+
+	return nil, errNoMumbleBinary
 }
 
 func searchBinaryInConf(conf *config.ApplicationConfig) func() (*binary, error) {
@@ -271,7 +279,7 @@ func searchBinaryInDataDir() (*binary, error) {
 
 func searchBinaryInSystem() (*binary, error) {
 	path, err := exec.LookPath("mumble")
-	if err != nil {
+	if err != nil || isSnapLauncher(path) {
 		return nil, nil
 	}
 
@@ -302,7 +310,6 @@ func isThereAnAvailableBinary(path string) *binary {
 	}
 
 	b.isBundle = isBundle
-	b.shouldBeCopied = !isBundle
 
 	output, err := command.Output()
 	if len(output) == 0 && err != nil {
@@ -311,9 +318,29 @@ func isThereAnAvailableBinary(path string) *binary {
 		return b
 	}
 
+	b.version = mumbleVersionOf(bin, command.Env, output)
+
 	return b
 }
 
+// mumbleVersionOf asks Mumble for its version, only if its help says that
+// it has the --version option. The versions older than 1.4 don't have it,
+// and they would take it as a file to open and start as usual
+func mumbleVersionOf(bin string, env []string, help []byte) *mumbleVersion {
+	if !bytes.Contains(help, []byte("--version")) {
+		return nil
+	}
+
+	// This executes the Mumble binary, which is under control of the code
+	/* #nosec G204 */
+	command := exec.Command(bin, "--version")
+	command.Env = env
+
+	output, _ := command.CombinedOutput()
+
+	return versionFromOutput(output)
+}
+
 func checkLibsDependenciesInPath(path string) (isBundle bool, env []string) {
 	libsDir := filepath.Join(filepath.Dir(path), mumbleBundleLibsDir)
 
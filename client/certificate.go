@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
 
@@ -12,13 +13,9 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"math/big"
 	"net"
 	"net/url"
-	"os"
-	"os/exec"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -26,12 +23,46 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const certServerPort = 8181
+const (
+	certServerPort = 8181
+	invitationPath = "/invitation"
+)
+
+var (
+	errCertificateNotPresented = errors.New("the server didn't present any certificate")
+	errCertificateMismatch     = errors.New("the server presented a different certificate than the one the meeting published")
+)
+
+// CertificateError is the reason why the certificate of a meeting couldn't
+// be checked before joining it. In the strict certificate mode, the
+// meeting is not joined when it happens
+type CertificateError struct {
+	// Mismatch is true when the certificate was fetched,
+	// but the server of the meeting presented a different one
+	Mismatch bool
+	Err      error
+}
+
+func (e *CertificateError) Error() string {
+	return e.Err.Error()
+}
 
-func (c *client) requestCertificate(address string) error {
+// Unwrap returns the error that made the check fail
+func (e *CertificateError) Unwrap() error {
+	return e.Err
+}
+
+func (c *client) strictCertificates() bool {
+	return c.conf != nil && c.conf.GetStrictCertificates()
+}
+
+// requestCertificate pins the certificate of the meeting, and returns the
+// address Mumble should connect to. It's the given one, unless the password
+// in it was a personal invitation
+func (c *client) requestCertificate(address string) (string, error) {
 	hostname, port, err := extractHostAndPort(address)
 	if err != nil {
-		return errors.New("invalid certificate url")
+		return address, errors.New("invalid certificate url")
 	}
 
 	u := &url.URL{
@@ -41,17 +72,67 @@ func (c *client) requestCertificate(address string) error {
 
 	content, err := c.tor.HTTPrequest(u.String())
 	if err != nil {
-		return err
+		return address, &CertificateError{Err: err}
 	}
 
 	cert := []byte(content)
+	if c.strictCertificates() {
+		err = c.checkPresentedCertificate(net.JoinHostPort(hostname, port), cert)
+		if err != nil {
+			return address, err
+		}
+	}
+
 	p, _ := strconv.Atoi(port)
 	err = c.storeCertificate(hostname, p, cert)
 	if err != nil {
-		return err
+		return address, err
 	}
 
-	return c.saveCertificateConfigFile()
+	digest, err := c.saveCertificateConfigFile()
+	if err != nil {
+		return address, err
+	}
+
+	return c.redeemInvitation(address, digest), nil
+}
+
+// redeemInvitation tells the host which certificate we are going to use, in case
+// the password in the address is a personal invitation. Then, the host answers
+// with the password of the meeting, and the address with it is returned. If
+// the password is just the meeting password, the host simply refuses it
+func (c *client) redeemInvitation(address, digest string) string {
+	u, err := url.Parse(address)
+	if err != nil || u.User == nil || len(digest) == 0 {
+		return address
+	}
+
+	token, ok := u.User.Password()
+	if !ok || len(token) == 0 {
+		return address
+	}
+
+	params := url.Values{}
+	params.Set("token", token)
+	params.Set("digest", digest)
+
+	ru := &url.URL{
+		Scheme:   "http",
+		Host:     net.JoinHostPort(u.Hostname(), strconv.Itoa(certServerPort)),
+		Path:     invitationPath,
+		RawQuery: params.Encode(),
+	}
+
+	password, err := c.tor.HTTPrequest(ru.String())
+	if err != nil {
+		log.Debugf("redeemInvitation(): the password is not a personal invitation: %s", err)
+		return address
+	}
+
+	log.Info("Personal invitation redeemed")
+
+	u.User = url.UserPassword(u.User.Username(), password)
+	return u.String()
 }
 
 func extractHostAndPort(address string) (host string, port string, err error) {
@@ -68,17 +149,53 @@ func extractHostAndPort(address string) (host string, port string, err error) {
 	return host, port, nil
 }
 
-func (c *client) storeCertificate(hostname string, port int, cert []byte) error {
-	if c.isTheCertificateInDB(hostname) {
-		return nil
+// checkPresentedCertificate makes sure that the Mumble server of the
+// meeting presents the same certificate that the meeting published
+func (c *client) checkPresentedCertificate(address string, cert []byte) error {
+	der, err := decodeCertificate(cert)
+	if err != nil {
+		return &CertificateError{Err: err}
 	}
 
+	conn, err := dialMeeting(c.tor, address, meetingConnectTimeout)
+	if err != nil {
+		return &CertificateError{Err: err}
+	}
+	defer conn.Close()
+
+	return checkCertificateMatches(conn.ConnectionState().PeerCertificates, der)
+}
+
+func checkCertificateMatches(presented []*x509.Certificate, der []byte) error {
+	if len(presented) == 0 {
+		return &CertificateError{Err: errCertificateNotPresented}
+	}
+
+	if !bytes.Equal(presented[0].Raw, der) {
+		return &CertificateError{Mismatch: true, Err: errCertificateMismatch}
+	}
+
+	return nil
+}
+
+var errInvalidCertificate = errors.New("invalid certificate")
+
+func decodeCertificate(cert []byte) ([]byte, error) {
 	block, _ := pem.Decode(cert)
-	if block == nil || block.Type != "CERTIFICATE" {
-		return errors.New("invalid certificate")
+	if block == nil || block.Type != pemCertificate {
+		return nil, errInvalidCertificate
+	}
+
+	return block.Bytes, nil
+}
+
+func (c *client) storeCertificate(hostname string, port int, cert []byte) error {
+	der, err := decodeCertificate(cert)
+	if err != nil {
+		return &CertificateError{Err: err}
 	}
 
-	digest, err := digestForCertificate(block.Bytes)
+	digest, err := digestForCertificate(der)
 	if err != nil {
 		return err
 	}
@@ -92,41 +209,18 @@ func (c *client) storeCertificate(hostname string, port int, cert []byte) error
 	return c.storeCertificateInDB(hostname, port, digest)
 }
 
-const (
-	defaultHostToReplace   = "ffaaffaabbddaabbddeeaaddccaaffeebbaabbeeddeeaaddbbeeeeff.onion"
-	defaultPortToReplace   = 64738
-	defaultDigestToReplace = "AAABACADAFBABBBCBDBEBFCACBCCCDCECFDADBDC"
-)
-
-func (c *client) storeCertificateInDB(id string, port int, digest string) error {
+func (c *client) storeCertificateInDB(hostname string, port int, digest string) error {
 	db, err := c.db()
 	if err != nil {
 		return err
 	}
 
-	log.WithFields(log.Fields{
-		"defaultHost":   defaultHostToReplace,
-		"defaultPort":   defaultPortToReplace,
-		"defaultDigest": defaultDigestToReplace,
-		"newHost":       id,
-		"newPort":       port,
-		"newDigest":     digest,
-	}).Debug("Replacing content in Mumble sqlite database")
-
-	db.replaceString(defaultHostToReplace, id)
-	db.replaceString(defaultDigestToReplace, digest)
-	db.replaceInteger(uint16(defaultPortToReplace), uint16(port))
-
-	return db.write()
-}
-
-func (c *client) isTheCertificateInDB(hostname string) bool {
-	d, err := c.db()
+	err = db.pinCertificate(hostname, port, digest)
 	if err != nil {
-		return false
+		return err
 	}
 
-	return d.exists(hostname)
+	return db.write()
 }
 
 func digestForCertificate(cert []byte) (string, error) {
@@ -142,8 +236,11 @@ func digestForCertificate(cert []byte) (string, error) {
 	return fmt.Sprintf("%x", bs), nil
 }
 
-// openssl req -newkey rsa:2048 -nodes -keyout key.pem -x509 -days 365 -out certificate.pem
-func genCertInto(certFilename, keyFilename string) error {
+const temporaryCertificateValidity = 24 * time.Hour * 365
+
+// genCert creates a self-signed certificate and its private key valid
+// for the given time, the same as: openssl req -newkey rsa:2048 -nodes -x509
+func genCert(validity time.Duration) ([]byte, *rsa.PrivateKey, error) {
 	now := time.Now()
 	tmpl := &x509.Certificate{
 		SerialNumber: big.NewInt(0),
@@ -151,7 +248,7 @@ func genCertInto(certFilename, keyFilename string) error {
 			CommonName: "Wahay Autogenerated Certificate",
 		},
 		NotBefore: now.Add(-300 * time.Second),
-		NotAfter:  now.Add(24 * time.Hour * 365),
+		NotAfter:  now.Add(validity),
 
 		SubjectKeyId: []byte{1, 2, 3, 4},
 		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
@@ -159,79 +256,26 @@ func genCertInto(certFilename, keyFilename string) error {
 
 	priv, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	certbuf, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
 	if err != nil {
-		return err
-	}
-	certblk := pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: certbuf,
+		return nil, nil, err
 	}
 
-	keybuf := x509.MarshalPKCS1PrivateKey(priv)
-	keyblk := pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: keybuf,
-	}
-
-	file, err := os.OpenFile(certFilename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
-	if err != nil {
-		return err
-	}
-	defer closeAndIgnore(file)
-	err = pem.Encode(file, &certblk)
-	if err != nil {
-		return err
-	}
-
-	file, err = os.OpenFile(keyFilename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
-	if err != nil {
-		return err
-	}
-	defer closeAndIgnore(file)
-	err = pem.Encode(file, &keyblk)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return certbuf, priv, nil
 }
 
-// generateTemporaryMumbleCertificate will generate a certificate and private key and
-// then format that in PKCS12, finally formatting it in the @ByteArray format that
-// Mumble configuration files use
-// This will fail if OpenSSL is not installed on the system.
-func generateTemporaryMumbleCertificate() (string, error) {
-	dir, err := ioutil.TempDir("", "wahay_cert_generation")
+// newTemporaryIdentity will generate a certificate and private key
+// that are only used for one meeting
+func newTemporaryIdentity() (*Identity, error) {
+	cert, key, err := genCert(temporaryCertificateValidity)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	defer os.RemoveAll(dir)
 
-	err = genCertInto(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"))
-	if err != nil {
-		return "", err
-	}
-
-	args := []string{"pkcs12", "-passout", "pass:", "-inkey", filepath.Join(dir, "key.pem"),
-		"-in", filepath.Join(dir, "cert.pem"), "-export", "-out", filepath.Join(dir, "transformed.p12")}
-	// This executes the openssl command. The args are completely under our control
-	/* #nosec G204 */
-	cmd := exec.Command("openssl", args...)
-	_, err = cmd.Output()
-	if err != nil {
-		return "", err
-	}
-
-	data, err := ioutil.ReadFile(filepath.Clean(filepath.Join(dir, "transformed.p12")))
-	if err != nil {
-		return "", err
-	}
-
-	return byteArrayUnparse(data), nil
+	return &Identity{certificate: cert, key: key}, nil
 }
 
 // Implement functions that match the QByteArray used in Mumble among other things
@@ -344,5 +388,92 @@ func byteArrayUnparse(bs []byte) string {
 
 	result = append(result, byteArraySuffix)
 
-	return strings.Join(result, "")
+	// Like QSettings does, the value is quoted when it has
+	// characters that would split it in a list of values
+	value := strings.Join(result, "")
+	if strings.ContainsAny(value, ",;=") {
+		return "\"" + value + "\""
+	}
+
+	return value
+}
+
+var errInvalidByteArray = errors.New("invalid @ByteArray value")
+
+// byteArrayParse reads a value written by byteArrayUnparse or by
+// QSettings, in the same way that QSettings reads it
+func byteArrayParse(s string) ([]byte, error) {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	if !strings.HasPrefix(s, byteArrayPrefix) || !strings.HasSuffix(s, byteArraySuffix) {
+		return nil, errInvalidByteArray
+	}
+
+	s = s[len(byteArrayPrefix) : len(s)-len(byteArraySuffix)]
+	result := make([]byte, 0, len(s))
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			result = append(result, s[i])
+			continue
+		}
+
+		i++
+		if i == len(s) {
+			return nil, errInvalidByteArray
+		}
+
+		if b, ok := byteArrayUnescapeSpecial(s[i]); ok {
+			result = append(result, b)
+			continue
+		}
+
+		// Numeric escapes take all the digits that follow them
+		base, digits := 8, "01234567"
+		if s[i] == 'x' {
+			base, digits = 16, "0123456789abcdefABCDEF"
+			i++
+		}
+
+		value := 0
+		start := i
+		for ; i < len(s) && strings.IndexByte(digits, s[i]) >= 0; i++ {
+			d, _ := strconv.ParseInt(s[i:i+1], base, 0)
+			value = value*base + int(d)
+		}
+
+		if i == start || value > 0xff {
+			return nil, errInvalidByteArray
+		}
+
+		result = append(result, byte(value))
+		i--
+	}
+
+	return result, nil
+}
+
+func byteArrayUnescapeSpecial(b byte) (byte, bool) {
+	switch b {
+	case 't':
+		return '\t', true
+	case 'r':
+		return '\r', true
+	case 'a':
+		return '\a', true
+	case 'b':
+		return '\b', true
+	case 'v':
+		return '\v', true
+	case 'f':
+		return '\f', true
+	case 'n':
+		return '\n', true
+	case '"', '\\', '\'', '?':
+		return b, true
+	default:
+		return 0, false
+	}
 }
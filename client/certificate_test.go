@@ -0,0 +1,192 @@
+package client
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"os"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+type ClientCertificateSuite struct{}
+
+var _ = Suite(&ClientCertificateSuite{})
+
+func (s *ClientCertificateSuite) Test_byteArrayUnparse_escapesLikeQSettings(c *C) {
+	value := byteArrayUnparse([]byte{0, '1', 'a', '\n', 0xff, '"', 'z', 0x1f, '\\'})
+
+	c.Assert(value, Equals, `@ByteArray(\0\x31\x61\n\xff\"z\x1f\\)`)
+}
+
+func (s *ClientCertificateSuite) Test_byteArrayUnparse_quotesTheValuesThatWouldBeSplit(c *C) {
+	c.Assert(byteArrayUnparse([]byte("a,b")), Equals, `"@ByteArray(a,b)"`)
+	c.Assert(byteArrayUnparse([]byte("a;b")), Equals, `"@ByteArray(a;b)"`)
+	c.Assert(byteArrayUnparse([]byte("ab")), Equals, `@ByteArray(ab)`)
+}
+
+func (s *ClientCertificateSuite) Test_byteArrayParse_readsWhatMumbleWrites(c *C) {
+	// Taken from the [shortcuts] section of a Mumble configuration
+	data, err := byteArrayParse(`@ByteArray(\0\0\0\t\0\0\0\x1\0\0\0\x2\0\0\0i)`)
+
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, []byte{0, 0, 0, 9, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 'i'})
+}
+
+func (s *ClientCertificateSuite) Test_byteArrayParse_refusesInvalidValues(c *C) {
+	_, err1 := byteArrayParse(`@Variant(abc)`)
+	_, err2 := byteArrayParse(`@ByteArray(abc\)`)
+	_, err3 := byteArrayParse(`@ByteArray(\x100)`)
+
+	c.Assert(err1, Equals, errInvalidByteArray)
+	c.Assert(err2, Equals, errInvalidByteArray)
+	c.Assert(err3, Equals, errInvalidByteArray)
+}
+
+func (s *ClientCertificateSuite) Test_byteArray_roundTripsAnyContent(c *C) {
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 4096)
+	_, _ = r.Read(random)
+
+	for _, data := range [][]byte{{}, all, random, []byte("0123456789abcdef"), {0, '7', 0, 'f'}} {
+		parsed, err := byteArrayParse(byteArrayUnparse(data))
+
+		c.Assert(err, IsNil)
+		c.Assert(parsed, DeepEquals, data)
+	}
+}
+
+func (s *ClientCertificateSuite) Test_pkcs12KDF_matchesTheKnownVectors(c *C) {
+	password := []byte{0, 's', 0, 'm', 0, 'e', 0, 'g', 0, 0}
+	salt := []byte{0x0a, 0x58, 0xcf, 0x64, 0x53, 0x0d, 0x82, 0x3f}
+
+	c.Assert(pkcs12KDF(password, salt, pkcs12KeyID, 1, 24), DeepEquals, []byte{
+		0x8a, 0xaa, 0xe6, 0x29, 0x7b, 0x6c, 0xb0, 0x46, 0x42, 0xab, 0x5b, 0x07,
+		0x78, 0x51, 0x28, 0x4e, 0xb7, 0x12, 0x8f, 0x1a, 0x2a, 0x7f, 0xbc, 0xa3})
+	c.Assert(pkcs12KDF(password, salt, pkcs12IVID, 1, 8), DeepEquals, []byte{
+		0x79, 0x99, 0x3d, 0xfe, 0x04, 0x8d, 0x3b, 0x76})
+}
+
+func (s *ClientCertificateSuite) Test_encodePKCS12_containsTheCertificateAndTheKey(c *C) {
+	cert, key, err := genCert(temporaryCertificateValidity)
+	c.Assert(err, IsNil)
+
+	data, err := encodePKCS12(key, cert)
+	c.Assert(err, IsNil)
+
+	var p pfx
+	_, err = asn1.Unmarshal(data, &p)
+	c.Assert(err, IsNil)
+	c.Assert(p.Version, Equals, pkcs12Version)
+
+	var authSafe []byte
+	_, err = asn1.Unmarshal(p.AuthSafe.Content.Bytes, &authSafe)
+	c.Assert(err, IsNil)
+	c.Assert(pkcs12MAC(authSafe, p.MacData.MacSalt, p.MacData.Iterations), DeepEquals, p.MacData.Mac.Digest)
+
+	var contents []contentInfo
+	_, err = asn1.Unmarshal(authSafe, &contents)
+	c.Assert(err, IsNil)
+	c.Assert(contents, HasLen, 2)
+
+	bags := make([]safeBag, 0, 2)
+	for _, ci := range contents {
+		var octets []byte
+		_, err = asn1.Unmarshal(ci.Content.Bytes, &octets)
+		c.Assert(err, IsNil)
+
+		var b []safeBag
+		_, err = asn1.Unmarshal(octets, &b)
+		c.Assert(err, IsNil)
+		bags = append(bags, b...)
+	}
+
+	var cb certBag
+	_, err = asn1.Unmarshal(bags[0].Value.Bytes, &cb)
+	c.Assert(err, IsNil)
+	c.Assert(bags[0].ID.Equal(oidCertBag), Equals, true)
+	c.Assert(cb.Data, DeepEquals, cert)
+
+	var ek encryptedPrivateKeyInfo
+	_, err = asn1.Unmarshal(bags[1].Value.Bytes, &ek)
+	c.Assert(err, IsNil)
+	c.Assert(bags[1].ID.Equal(oidShroudedKeyBag), Equals, true)
+
+	var params pbeParams
+	_, err = asn1.Unmarshal(ek.Algorithm.Parameters.FullBytes, &params)
+	c.Assert(err, IsNil)
+
+	block, err := des.NewTripleDESCipher(pkcs12KDF(emptyPassword, params.Salt, pkcs12KeyID, params.Iterations, pkcs12KeyLen))
+	c.Assert(err, IsNil)
+	iv := pkcs12KDF(emptyPassword, params.Salt, pkcs12IVID, params.Iterations, block.BlockSize())
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(ek.EncryptedData, ek.EncryptedData)
+
+	keyInfo, err := x509.MarshalPKCS8PrivateKey(key)
+	c.Assert(err, IsNil)
+	c.Assert(bytes.HasPrefix(ek.EncryptedData, keyInfo), Equals, true)
+}
+
+func (s *ClientCertificateSuite) Test_checkCertificateMatches_acceptsOnlyThePublishedCertificate(c *C) {
+	published, _, err := genCert(temporaryCertificateValidity)
+	c.Assert(err, IsNil)
+	other, _, err := genCert(temporaryCertificateValidity)
+	c.Assert(err, IsNil)
+
+	cert, err := x509.ParseCertificate(published)
+	c.Assert(err, IsNil)
+	c.Assert(checkCertificateMatches([]*x509.Certificate{cert}, published), IsNil)
+
+	err = checkCertificateMatches([]*x509.Certificate{cert}, other)
+	var certErr *CertificateError
+	c.Assert(errors.As(err, &certErr), Equals, true)
+	c.Assert(certErr.Mismatch, Equals, true)
+
+	err = checkCertificateMatches(nil, published)
+	c.Assert(errors.As(err, &certErr), Equals, true)
+	c.Assert(certErr.Mismatch, Equals, false)
+}
+
+func (s *ClientCertificateSuite) Test_decodeCertificate_onlyReadsCertificates(c *C) {
+	der, err := decodeCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{1, 2, 3}}))
+	c.Assert(err, IsNil)
+	c.Assert(der, DeepEquals, []byte{1, 2, 3})
+
+	_, err = decodeCertificate(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1, 2, 3}}))
+	c.Assert(err, Equals, errInvalidCertificate)
+
+	_, err = decodeCertificate([]byte("<html>Not found</html>"))
+	c.Assert(err, Equals, errInvalidCertificate)
+}
+
+func (s *ClientCertificateSuite) Test_Launch_doesntRunMumbleInStrictModeWhenTheCertificateIsNotPinned(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-client-test")
+	c.Assert(err, IsNil)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	conf := config.New()
+	conf.SetStrictCertificates(true)
+
+	cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	cl.conf = conf
+	cl.binary = &binary{isValid: true, version: &mumbleVersion{1, 4, 0}, sandbox: &sandbox{configDir: dir}}
+	c.Assert(cl.validate(), IsNil)
+
+	_, err = cl.Launch("not a meeting address", nil)
+
+	var certErr *CertificateError
+	c.Assert(errors.As(err, &certErr), Equals, true)
+	c.Assert(cl.launchDirs, HasLen, 0)
+}
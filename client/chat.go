@@ -0,0 +1,252 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"html"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/mumble"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// The chat is a second connection to the Mumble server of the meeting,
+// made by Wahay itself through Tor, that only sends and receives text
+// messages. It uses the control channel of the Mumble protocol alone,
+// so no audio ever goes through it. A Mumble server doesn't let two
+// connections share the same session, so the chat joins the meeting
+// as a companion of the participant, with their name, password and
+// certificate. The certificate is what lets the host recognize the
+// companions and leave them out of the waiting room, the event feed
+// and the statistics, see hosting/waiting_room.go
+
+const (
+	chatDefaultName = "Wahay"
+
+	// chatConnectTimeout is how long the connection and the
+	// authentication can take
+	chatConnectTimeout = meetingConnectTimeout
+)
+
+var (
+	errChatClosed       = errors.New("the chat is closed")
+	errChatInvalidURL   = errors.New("invalid meeting address for the chat")
+	errChatNotConnected = errors.New("the chat is not in any channel yet")
+	errChatNoMumble     = errors.New("the chat needs the Mumble of the meeting running")
+)
+
+// ChatMessage is a text message sent to the meeting. From
+// is empty if the participant that sent it is unknown
+type ChatMessage struct {
+	From string
+	Text string
+	Time time.Time
+}
+
+// Chat is the connection to a meeting that sends and receives text messages
+type Chat interface {
+	// Send sends the text to everyone in the same channel of the meeting
+	Send(text string) error
+
+	// Close leaves the meeting. The chat can't be used after closing it
+	Close()
+}
+
+type chat struct {
+	client    mumble.Client
+	onMessage func(ChatMessage)
+	onClose   func(error)
+
+	sync.Mutex
+	closed bool
+}
+
+// ConnectChat joins through Tor the meeting the Mumble running in the
+// given service is in, only to chat. It uses the address Mumble used, with
+// the password of the invitation already redeemed, and the same identity.
+// Every message sent to the channel of the participant is given to
+// onMessage, and onClose is called when the connection ends, with the
+// reason if it was not closed by us. Both are called from a different
+// goroutine than the one calling ConnectChat
+func (c *client) ConnectChat(m tor.Service, password string, onMessage func(ChatMessage), onClose func(error)) (Chat, error) {
+	l, ok := c.launchOf(m)
+	if !ok {
+		return nil, errChatNoMumble
+	}
+
+	return connectChat(l.address, l.id, password, torDialer(c.tor, chatConnectTimeout), onMessage, onClose)
+}
+
+func connectChat(address string, id *Identity, password string, dial func(network, address string) (net.Conn, error),
+	onMessage func(ChatMessage), onClose func(error)) (Chat, error) {
+	u, err := url.Parse(address)
+	if err != nil || u.Hostname() == "" || u.Port() == "" {
+		return nil, errChatInvalidURL
+	}
+
+	if password == "" {
+		password = chatPassword(u)
+	}
+
+	certificates := []tls.Certificate{}
+	if id != nil {
+		certificates = append(certificates, id.tlsCertificate())
+	}
+
+	c := &chat{
+		onMessage: onMessage,
+		onClose:   onClose,
+	}
+
+	cl, err := mumble.Connect(&mumble.Config{
+		Address:      u.Host,
+		Username:     chatUsername(u),
+		Password:     password,
+		Certificates: certificates,
+		Dial:         dial,
+		Handlers: mumble.Handlers{
+			OnTextMessage: c.received,
+			OnDisconnect:  c.disconnected,
+		},
+	})
+	if err != nil {
+		return nil, chatError(err)
+	}
+
+	// The messages can start arriving before Connect returns
+	c.Lock()
+	c.client = cl
+	c.Unlock()
+
+	return c, nil
+}
+
+func chatUsername(u *url.URL) string {
+	name := ""
+	if u.User != nil {
+		name = u.User.Username()
+	}
+
+	if name == "" {
+		name = chatDefaultName
+	}
+
+	return name + mumble.CompanionSuffix
+}
+
+func chatPassword(u *url.URL) string {
+	if u.User == nil {
+		return ""
+	}
+
+	password, _ := u.User.Password()
+	return password
+}
+
+func chatError(err error) error {
+	var rejected *mumble.RejectedError
+	if errors.As(err, &rejected) && rejected.Reason != "" {
+		return errors.New("the meeting didn't accept the chat: " + rejected.Reason)
+	}
+	return err
+}
+
+// chatSender returns the name to show for the participant that sent
+// a message. The messages sent from the chat of another participant
+// are shown with the name of the participant
+func chatSender(from mumble.User, users []mumble.User) string {
+	if p, ok := from.CompanionOf(users); ok {
+		return p.Name
+	}
+	return from.Name
+}
+
+func (c *chat) received(from mumble.User, message string) {
+	if c.onMessage != nil {
+		c.onMessage(ChatMessage{
+			From: chatSender(from, c.users()),
+			Text: chatPlainText(message),
+			Time: time.Now(),
+		})
+	}
+}
+
+func (c *chat) users() []mumble.User {
+	c.Lock()
+	cl := c.client
+	c.Unlock()
+
+	if cl == nil {
+		return nil
+	}
+	return cl.Users()
+}
+
+func (c *chat) disconnected(err error) {
+	if c.isClosed() {
+		err = nil
+	} else if err != nil {
+		log.Errorf("Chat: the connection with the meeting finished: %s", err)
+	}
+
+	if c.onClose != nil {
+		c.onClose(chatError(err))
+	}
+}
+
+func (c *chat) Send(text string) error {
+	if c.isClosed() {
+		return errChatClosed
+	}
+
+	me, ok := c.client.User(c.client.Session())
+	if !ok {
+		return errChatNotConnected
+	}
+
+	return c.client.SendText(me.ChannelID, chatHTML(text))
+}
+
+func (c *chat) Close() {
+	c.Lock()
+	if c.closed {
+		c.Unlock()
+		return
+	}
+	c.closed = true
+	c.Unlock()
+
+	_ = c.client.Close()
+}
+
+func (c *chat) isClosed() bool {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.closed
+}
+
+var (
+	htmlLineBreak = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>`)
+	htmlTag       = regexp.MustCompile(`<[^>]*>`)
+)
+
+// chatPlainText returns the text of a message. Mumble
+// writes the messages in HTML, but we only show the text
+func chatPlainText(message string) string {
+	s := htmlLineBreak.ReplaceAllString(message, "\n")
+	s = htmlTag.ReplaceAllString(s, "")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+// chatHTML returns the text written the way Mumble shows it
+func chatHTML(text string) string {
+	return strings.Replace(html.EscapeString(text), "\n", "<br/>", -1)
+}
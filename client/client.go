@@ -4,7 +4,6 @@ package client
 
 import (
 	"errors"
-	"io/ioutil"
 	"os/exec"
 	"sync"
 
@@ -23,10 +22,33 @@ type Instance interface {
 	// LastError returns the last error registered during client initialization
 	LastError() error
 
+	// Warnings returns the problems that the found client might have with Wahay,
+	// even if it's valid, like a version that Wahay has not been tested with
+	Warnings() []error
+
 	// Launch runs the found client through the Tor proxy with the given Mumble URL.
 	// Before running the client the system will make a request of the certificate to the origin
-	// based on the given url.
-	Launch(url string, onClose func()) (tor.Service, error)
+	// based on the given url. In the strict certificate mode, a *CertificateError is returned
+	// if the certificate can't be checked or pinned. When the client finishes, onClose is called with how it finished.
+	Launch(url string, onClose func(tor.ExitStatus)) (tor.Service, error)
+
+	// Mute mutes or unmutes the microphone of the client
+	// running in the given service, returned by Launch
+	Mute(m tor.Service, muted bool) error
+
+	// Deafen deafens or undeafens the client
+	// running in the given service, returned by Launch
+	Deafen(m tor.Service, deafened bool) error
+
+	// QueryState asks the client running in the given service about its state
+	// in the meeting. It returns an error if the client can't tell, for example
+	// because it has not started yet or because D-Bus is not available
+	QueryState(m tor.Service) (MeetingState, error)
+
+	// ConnectChat joins, only to chat, the meeting of the client running in
+	// the given service. If the password is empty, the one Mumble used is
+	// sent. See Chat
+	ConnectChat(m tor.Service, password string, onMessage func(ChatMessage), onClose func(error)) (Chat, error)
 
 	Destroy()
 }
@@ -42,6 +64,33 @@ type client struct {
 	err                   error
 	torCmdModifier        tor.ModifyCommand
 	tor                   tor.Instance
+	conf                  *config.ApplicationConfig
+
+	// certificate and proxy are written in the Mumble configuration
+	// of a launch, once they are known. See mumbleIniData
+	certificate string
+	proxy       *mumbleProxy
+
+	// address and id are the Mumble URL that a launch joins the
+	// meeting with, and the identity it presents there. The chat of
+	// the meeting uses them too, since the host expects them
+	address string
+	id      *Identity
+
+	// parent is the client that created this one to run Mumble once
+	parent *client
+
+	// launchDirs are the private directories of the runs
+	// of Mumble that have not finished yet
+	launchDirs []string
+
+	// launches are the clients running Mumble, by their service
+	launches map[tor.Service]*client
+
+	// pid is the process started to run Mumble, and dbusName the
+	// connection to D-Bus of that Mumble, once it has been found
+	pid      int
+	dbusName string
 }
 
 func newMumbleClient(p mumbleIniProvider, d databaseProvider, t tor.Instance) *client {
@@ -64,36 +113,22 @@ type databaseProvider func() []byte
 // for the  appropriate Mumble binary and check for errors
 func InitSystem(conf *config.ApplicationConfig, tor tor.Instance) Instance {
 	i := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, tor)
+	i.conf = conf
 
-	b := searchBinary(conf)
-
-	if b == nil {
-		return invalidInstance(errors.New("a valid binary of Mumble is no available in your system"))
-	}
-
-	if b.shouldBeCopied {
-		tempDir, err := tempFolder()
-		if err != nil {
-			return invalidInstance(err)
-		}
-
-		err = b.copyTo(tempDir)
-		if err != nil {
-			return invalidInstance(err)
-		}
-	}
-
-	err := i.setBinary(b)
+	b, err := searchBinary(conf)
 	if err != nil {
 		return invalidInstance(err)
 	}
 
-	err = i.ensureConfiguration()
+	err = i.setBinary(b)
 	if err != nil {
 		return invalidInstance(err)
 	}
 
 	log.Infof("Using Mumble located at: %s\n", i.pathToBinary())
+	if b.version != nil {
+		log.Infof("Using Mumble version: %s\n", b.version)
+	}
 	log.Infof("Using Mumble environment variables: %s\n", i.binaryEnv())
 
 	return i
@@ -108,37 +143,100 @@ func invalidInstance(err error) Instance {
 	return invalidInstance
 }
 
-func (c *client) Launch(url string, onClose func()) (tor.Service, error) {
+func (c *client) Launch(url string, onClose func(tor.ExitStatus)) (tor.Service, error) {
+	l, err := c.newLaunch()
+	if err != nil {
+		log.Errorf("Launch() client: %s", err.Error())
+		return nil, errors.New("error: the client configuration can't be created")
+	}
+
 	// First, we load the certificate from the remote server and if a
 	// valid certificate is found then we execute the client through Tor
-	err := c.requestCertificate(url)
+	url, err = l.requestCertificate(url)
 	if err != nil {
 		log.WithFields(log.Fields{"url": url}).Errorf("Launch() client: %s", err.Error())
+
+		// In the strict mode, Mumble is not run if we couldn't make sure that
+		// it will trust the server of the meeting and only that one. Whatever
+		// went wrong, the certificate was not pinned, so it's always reported
+		// as a problem with the certificate
+		if c.strictCertificates() {
+			l.removeLaunch()
+
+			var certErr *CertificateError
+			if !errors.As(err, &certErr) {
+				err = &CertificateError{Err: err}
+			}
+			return nil, err
+		}
 	}
 
-	return c.execute([]string{url}, onClose)
+	l.address = url
+
+	return l.execute([]string{mumbleMultipleInstancesOption, url}, onClose)
 }
 
-func (c *client) execute(args []string, onClose func()) (tor.Service, error) {
-	s, err := c.tor.NewService(c.pathToBinary(), args, c.torCommandModifier())
+func (c *client) execute(args []string, onClose func(tor.ExitStatus)) (tor.Service, error) {
+	s, err := c.startService(args)
 	if err != nil {
+		log.Errorf("Mumble client execute(): %s", err.Error())
+		c.removeLaunch()
 		return nil, errors.New("error: the service can't be started")
 	}
 
+	c.Lock()
+	c.pid = s.Pid()
+	c.Unlock()
+
+	c.addLaunch(s)
+
 	s.OnClose(func() {
-		err := c.regenerateConfiguration()
-		if err != nil {
-			log.Errorf("Mumble client Destroy(): %s", err.Error())
+		c.forgetLaunch(s)
+		c.removeLaunch()
+
+		status := s.ExitStatus()
+		if HandedOff(status) {
+			log.WithFields(log.Fields{
+				"duration": status.Duration,
+			}).Error("Mumble exited right after starting, it probably passed the meeting to another Mumble")
+		}
+		if status.Crashed() {
+			log.WithFields(log.Fields{
+				"code":     status.Code,
+				"duration": status.Duration,
+			}).Errorf("Mumble client finished unexpectedly: %s", status.Err)
 		}
 
 		if onClose != nil {
-			onClose()
+			onClose(status)
 		}
 	})
 
 	return s, nil
 }
 
+// startService runs Mumble through Tor. A sandboxed Mumble can't be run
+// with torsocks, so it's configured to use the Tor SOCKS proxy instead
+func (c *client) startService(args []string) (tor.Service, error) {
+	sb := c.binary.sandbox
+	if sb == nil {
+		return c.tor.NewService(c.pathToBinary(), args, c.torCommandModifier())
+	}
+
+	// A Mumble that ignores --config would run without the proxy
+	if !c.binary.supports(featureConfigOption) {
+		return nil, errSandboxWithoutConfigOption
+	}
+
+	host, port := c.tor.SocksProxy()
+	err := c.saveProxyConfigFile(host, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.tor.NewProxiedService(c.pathToBinary(), sb.arguments(c.configFile, args), c.torCommandModifier())
+}
+
 var errInvalidBinary = errors.New("invalid client binary")
 
 func (c *client) validate() error {
@@ -188,6 +286,13 @@ func (c *client) LastError() error {
 	return c.err
 }
 
+func (c *client) Warnings() []error {
+	if c.binary == nil {
+		return nil
+	}
+	return c.binary.warnings
+}
+
 func (c *client) setBinary(b *binary) error {
 	if !b.isValid {
 		return errors.New("the provided binary is not valid")
@@ -218,15 +323,15 @@ func (c *client) torCommandModifier() tor.ModifyCommand {
 	return c.torCmdModifier
 }
 
+// Destroy removes the configuration of the runs
+// of Mumble that are still going on
 func (c *client) Destroy() {
-	c.binary.destroy()
-}
+	c.Lock()
+	dirs := c.launchDirs
+	c.launchDirs = nil
+	c.Unlock()
 
-func tempFolder() (string, error) {
-	dir, err := ioutil.TempDir("", "mumble")
-	if err != nil {
-		return "", err
+	for _, dir := range dirs {
+		removeLaunchDir(dir)
 	}
-
-	return dir, nil
 }
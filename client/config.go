@@ -6,7 +6,6 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strings"
 
 	log "github.com/sirupsen/logrus"
 
@@ -26,6 +25,10 @@ var (
 )
 
 func (c *client) pathToConfig() string {
+	if len(c.configDir) == 0 && c.binary != nil && c.binary.sandbox != nil {
+		c.configDir = c.binary.sandbox.configDir
+	}
+
 	if len(c.configDir) == 0 {
 		location := c.pathToBinary()
 		if !isADirectory(location) {
@@ -36,22 +39,6 @@ func (c *client) pathToConfig() string {
 	return c.configDir
 }
 
-func (c *client) regenerateConfiguration() error {
-	location := c.pathToConfig()
-
-	err := os.Remove(filepath.Join(location, configFileName))
-	if err != nil {
-		log.Errorf("Mumble client regenerateConfiguration(): %s", err.Error())
-	}
-
-	err = os.Remove(filepath.Join(location, configDBName))
-	if err != nil {
-		log.Errorf("Mumble client regenerateConfiguration(): %s", err.Error())
-	}
-
-	return c.ensureConfiguration()
-}
-
 func (c *client) ensureConfiguration() error {
 	c.Lock()
 	defer c.Unlock()
@@ -157,7 +144,12 @@ func (c *client) writeConfigToFile(path string) error {
 		}
 	}
 
-	err := config.SafeWrite(configFile, []byte(c.configContentProvider()), 0600)
+	content, err := renderMumbleIni(c.configContentProvider(), c.mumbleIniData())
+	if err != nil {
+		return err
+	}
+
+	err = config.SafeWrite(configFile, []byte(content), 0600)
 	if err != nil {
 		return err
 	}
@@ -167,40 +159,59 @@ func (c *client) writeConfigToFile(path string) error {
 	return nil
 }
 
-func (c *client) saveCertificateConfigFile() error {
-	if !pathExists(c.configFile) {
-		return errors.New("invalid mumble.ini file")
+// launchIdentity returns the persistent identity of the participant, if
+// it's enabled, or a new temporary one in the other case
+func (c *client) launchIdentity() (*Identity, error) {
+	id, err := c.identity()
+	if err != nil {
+		log.Errorf("Error loading the persistent identity: %v", err)
+		return nil, err
+	}
+
+	if id != nil {
+		return id, nil
 	}
 
-	content, err := ioutil.ReadFile(c.configFile)
+	id, err = newTemporaryIdentity()
 	if err != nil {
-		return err
+		log.Errorf("Error generating temporary mumble certificate: %v", err)
+	}
+
+	return id, err
+}
+
+// saveCertificateConfigFile writes the client certificate that Mumble
+// will use in its configuration, and returns its digest
+func (c *client) saveCertificateConfigFile() (string, error) {
+	if !pathExists(c.configFile) {
+		return "", errors.New("invalid mumble.ini file")
 	}
 
-	tmc, err := generateTemporaryMumbleCertificate()
+	id, err := c.launchIdentity()
 	if err != nil {
-		log.Debugf("Error generating temporary mumble certificate: %v, assigning empty string", err)
-		tmc = ""
+		return "", err
 	}
 
-	certSectionProp := strings.Replace(
-		string(content),
-		"#CERTIFICATE",
-		fmt.Sprintf("certificate=%s", tmc),
-		1,
-	)
+	tmc, digest, err := id.mumbleCertificate()
+	if err != nil {
+		return "", err
+	}
 
-	langSection := strings.Replace(
-		certSectionProp,
-		"#LANGUAGE",
-		fmt.Sprintf("language=%s", config.DetectLanguage().String()),
-		1,
-	)
+	c.certificate = tmc
+	c.id = id
 
-	err = ioutil.WriteFile(c.configFile, []byte(langSection), 0600)
+	err = c.writeConfigToFile(c.configFile)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return digest, nil
+}
+
+// saveProxyConfigFile makes Mumble connect through the Tor SOCKS proxy.
+// If it can't, Mumble must not be run, since it would connect directly
+func (c *client) saveProxyConfigFile(host string, port int) error {
+	c.proxy = newMumbleProxy(host, port)
+
+	return c.writeConfigToFile(c.configFile)
 }
@@ -0,0 +1,332 @@
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// Mumble publishes controls for the running client on the session D-Bus,
+// which we use through the gdbus command. Every Mumble registers them in its
+// own connection to the bus, but only the first one running gets the well
+// known name. Since Wahay can run more than one Mumble at the same time, we
+// look for the connection that belongs to the process we started, or to one
+// of its children, because sandboxes run Mumble in another process. When
+// D-Bus is not available, the mute and deafen actions can still be sent with
+// "mumble rpc", but that doesn't tell us anything about the state, and it
+// always goes to the first Mumble, so it's only used when there is only one.
+
+const (
+	mumbleDBusName      = "net.sourceforge.mumble.mumble"
+	mumbleDBusPath      = "/"
+	mumbleDBusInterface = "net.sourceforge.mumble.Mumble"
+
+	busDBusName      = "org.freedesktop.DBus"
+	busDBusPath      = "/org/freedesktop/DBus"
+	busDBusInterface = "org.freedesktop.DBus"
+)
+
+var (
+	errNoControl          = errors.New("the Mumble client can't be controlled")
+	errInvalidDBusReply   = errors.New("invalid reply from the Mumble client")
+	errControlUnavailable = errors.New("the state of the Mumble client is not available")
+	errNoDBusConnection   = errors.New("the Mumble client is not connected to D-Bus")
+)
+
+// MeetingState is what the running Mumble client reports about the meeting
+type MeetingState struct {
+	// Connected is true while the client is connected to the Mumble server
+	Connected bool
+
+	// Muted is true when the participant has muted the microphone
+	Muted bool
+
+	// Deafened is true when the participant has stopped hearing the others
+	Deafened bool
+}
+
+func (c *client) Mute(m tor.Service, muted bool) error {
+	l, ok := c.launchOf(m)
+	if !ok {
+		return errNoControl
+	}
+
+	_, err := l.dbusCall("setSelfMuted", strconv.FormatBool(muted))
+	if err == nil {
+		return nil
+	}
+
+	if muted {
+		return l.rpc("mute")
+	}
+	return l.rpc("unmute")
+}
+
+func (c *client) Deafen(m tor.Service, deafened bool) error {
+	l, ok := c.launchOf(m)
+	if !ok {
+		return errNoControl
+	}
+
+	_, err := l.dbusCall("setSelfDeaf", strconv.FormatBool(deafened))
+	if err == nil {
+		return nil
+	}
+
+	if deafened {
+		return l.rpc("deaf")
+	}
+	return l.rpc("undeaf")
+}
+
+func (c *client) QueryState(m tor.Service) (MeetingState, error) {
+	l, ok := c.launchOf(m)
+	if !ok {
+		return MeetingState{}, errControlUnavailable
+	}
+
+	muted, err := l.dbusBool("isSelfMuted")
+	if err != nil {
+		return MeetingState{}, errControlUnavailable
+	}
+
+	deafened, err := l.dbusBool("isSelfDeaf")
+	if err != nil {
+		return MeetingState{}, errControlUnavailable
+	}
+
+	// Mumble answers with an error to this one when it's not connected
+	_, err = l.dbusCall("getCurrentUrl")
+
+	return MeetingState{
+		Connected: err == nil,
+		Muted:     muted,
+		Deafened:  deafened,
+	}, nil
+}
+
+func (c *client) dbusCall(method string, args ...string) (string, error) {
+	dest, err := c.dbusDestination()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := gdbusCall(dest, mumbleDBusPath, mumbleDBusInterface+"."+method, args...)
+	if err != nil {
+		// Mumble could have been restarted, so it's searched again next time
+		c.Lock()
+		c.dbusName = ""
+		c.Unlock()
+	}
+
+	return output, err
+}
+
+func (c *client) dbusBool(method string) (bool, error) {
+	output, err := c.dbusCall(method)
+	if err != nil {
+		return false, err
+	}
+	return parseDBusBool(output)
+}
+
+// dbusDestination returns the unique name of the connection
+// to the session bus of the Mumble run by this client
+func (c *client) dbusDestination() (string, error) {
+	c.Lock()
+	name, pid := c.dbusName, c.pid
+	c.Unlock()
+
+	if name != "" {
+		return name, nil
+	}
+
+	if pid == 0 {
+		return "", errNoDBusConnection
+	}
+
+	name, err := findDBusConnection(descendantsOf(pid))
+	if err != nil {
+		return "", err
+	}
+
+	c.Lock()
+	c.dbusName = name
+	c.Unlock()
+
+	return name, nil
+}
+
+// findDBusConnection returns the connection of one of the given processes
+// that answers to the Mumble controls. The owner of the well known name is
+// tried first, since most of the time there is only one Mumble
+func findDBusConnection(pids map[int]bool) (string, error) {
+	names := []string{}
+	if owner, err := gdbusCall(busDBusName, busDBusPath, busDBusInterface+".GetNameOwner", mumbleDBusName); err == nil {
+		names = append(names, parseDBusStrings(owner)...)
+	}
+
+	all, err := gdbusCall(busDBusName, busDBusPath, busDBusInterface+".ListNames")
+	if err != nil {
+		return "", errNoControl
+	}
+	names = append(names, parseDBusStrings(all)...)
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, ":") {
+			continue
+		}
+
+		reply, err := gdbusCall(busDBusName, busDBusPath, busDBusInterface+".GetConnectionUnixProcessID", name)
+		if err != nil || !pids[parseDBusUint(reply)] {
+			continue
+		}
+
+		_, err = gdbusCall(name, mumbleDBusPath, mumbleDBusInterface+".isSelfMuted")
+		if err == nil {
+			return name, nil
+		}
+	}
+
+	return "", errNoDBusConnection
+}
+
+func gdbusCall(dest, objectPath, method string, args ...string) (string, error) {
+	path, err := exec.LookPath("gdbus")
+	if err != nil {
+		return "", errNoControl
+	}
+
+	a := []string{
+		"call", "--session",
+		"--dest", dest,
+		"--object-path", objectPath,
+		"--method", method,
+	}
+
+	// This executes the gdbus command with arguments under control of the code
+	/* #nosec G204 */
+	output, err := exec.Command(path, append(a, args...)...).Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// parseDBusBool reads the reply of gdbus for a method
+// that returns a boolean, that looks like "(true,)"
+func parseDBusBool(output string) (bool, error) {
+	switch strings.TrimSpace(output) {
+	case "(true,)":
+		return true, nil
+	case "(false,)":
+		return false, nil
+	}
+	return false, errInvalidDBusReply
+}
+
+var (
+	dbusStringPattern = regexp.MustCompile(`'([^']*)'`)
+	dbusUintPattern   = regexp.MustCompile(`\(uint32 (\d+),\)`)
+)
+
+// parseDBusStrings returns the strings in the reply of gdbus,
+// like "(':1.42',)" or "(['org.freedesktop.DBus', ':1.42'],)"
+func parseDBusStrings(output string) []string {
+	result := []string{}
+	for _, m := range dbusStringPattern.FindAllStringSubmatch(output, -1) {
+		result = append(result, m[1])
+	}
+	return result
+}
+
+// parseDBusUint reads the reply of gdbus for a method that returns
+// an unsigned integer, like "(uint32 1234,)", or 0 if it doesn't
+func parseDBusUint(output string) int {
+	m := dbusUintPattern.FindStringSubmatch(strings.TrimSpace(output))
+	if m == nil {
+		return 0
+	}
+	v, _ := strconv.Atoi(m[1])
+	return v
+}
+
+// descendantsOf returns the given process and all the ones below it
+func descendantsOf(pid int) map[int]bool {
+	children := map[int][]int{}
+
+	stats, _ := filepath.Glob("/proc/[0-9]*/stat")
+	for _, f := range stats {
+		data, err := ioutil.ReadFile(filepath.Clean(f))
+		if err != nil {
+			continue
+		}
+		child, parent := parseProcStat(string(data))
+		if child > 0 {
+			children[parent] = append(children[parent], child)
+		}
+	}
+
+	result := map[int]bool{}
+	pending := []int{pid}
+	for len(pending) > 0 {
+		p := pending[0]
+		pending = pending[1:]
+		if result[p] {
+			continue
+		}
+		result[p] = true
+		pending = append(pending, children[p]...)
+	}
+
+	return result
+}
+
+// parseProcStat returns the process ID and the parent process ID in the
+// content of /proc/<pid>/stat. The name of the command is between
+// parentheses and can have spaces, so the fields are read after it
+func parseProcStat(stat string) (pid, parent int) {
+	open := strings.Index(stat, "(")
+	end := strings.LastIndex(stat, ")")
+	if open < 0 || end < open {
+		return 0, 0
+	}
+
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 2 {
+		return 0, 0
+	}
+
+	pid, _ = strconv.Atoi(strings.TrimSpace(stat[:open]))
+	parent, _ = strconv.Atoi(fields[1])
+
+	return pid, parent
+}
+
+// rpc sends the action with "mumble rpc", that goes to the first Mumble
+// running. So it's only used when this is the only Mumble Wahay is running
+func (c *client) rpc(action string) error {
+	if !c.IsValid() || !c.binary.supports(featureRPC) || !c.onlyLaunch() {
+		return errNoControl
+	}
+
+	args := []string{"rpc", action}
+	if sb := c.binary.sandbox; sb != nil {
+		args = append(append([]string{}, sb.args...), args...)
+	}
+
+	// This executes the Mumble binary with arguments under control of the code
+	/* #nosec G204 */
+	cmd := exec.Command(c.pathToBinary(), args...)
+	cmd.Env = append(os.Environ(), c.binaryEnv()...)
+
+	return cmd.Run()
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"os"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type ClientControlSuite struct{}
+
+var _ = Suite(&ClientControlSuite{})
+
+func (s *ClientControlSuite) Test_parseDBusBool_readsTheRepliesOfGdbus(c *C) {
+	v, err := parseDBusBool("(true,)\n")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, true)
+
+	v, err = parseDBusBool("(false,)")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, false)
+
+	_, err = parseDBusBool("()")
+	c.Assert(err, Equals, errInvalidDBusReply)
+}
+
+func (s *ClientControlSuite) Test_rpc_isNotUsedWithVersionsThatDontHaveIt(c *C) {
+	cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	c.Assert(cl.rpc("mute"), Equals, errNoControl)
+
+	c.Assert(cl.setBinary(&binary{isValid: true, path: "/bin/false", version: &mumbleVersion{1, 2, 19}}), IsNil)
+	c.Assert(cl.rpc("mute"), Equals, errNoControl)
+}
+
+func (s *ClientControlSuite) Test_parseDBusStrings_readsNamesAndLists(c *C) {
+	c.Assert(parseDBusStrings("(':1.42',)"), DeepEquals, []string{":1.42"})
+	c.Assert(parseDBusStrings("(['org.freedesktop.DBus', ':1.7'],)"), DeepEquals, []string{"org.freedesktop.DBus", ":1.7"})
+	c.Assert(parseDBusStrings("()"), HasLen, 0)
+}
+
+func (s *ClientControlSuite) Test_parseDBusUint_readsTheProcessOfAConnection(c *C) {
+	c.Assert(parseDBusUint("(uint32 1234,)\n"), Equals, 1234)
+	c.Assert(parseDBusUint("(true,)"), Equals, 0)
+}
+
+func (s *ClientControlSuite) Test_parseProcStat_readsTheParentAfterTheCommandName(c *C) {
+	pid, parent := parseProcStat("4321 (Mumble (main) x) S 1234 4321 4321 0 -1")
+	c.Assert(pid, Equals, 4321)
+	c.Assert(parent, Equals, 1234)
+
+	pid, _ = parseProcStat("garbage")
+	c.Assert(pid, Equals, 0)
+}
+
+func (s *ClientControlSuite) Test_descendantsOf_includesTheProcessItself(c *C) {
+	c.Assert(descendantsOf(os.Getpid())[os.Getpid()], Equals, true)
+}
+
+func (s *ClientControlSuite) Test_launchOf_onlyFindsTheLaunchesOfTheClient(c *C) {
+	parent := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	first := &client{parent: parent}
+	second := &client{parent: parent}
+
+	s1, s2 := &fakeService{}, &fakeService{}
+	first.addLaunch(s1)
+	c.Assert(first.onlyLaunch(), Equals, true)
+	second.addLaunch(s2)
+	c.Assert(first.onlyLaunch(), Equals, false)
+
+	l, ok := parent.launchOf(s2)
+	c.Assert(ok, Equals, true)
+	c.Assert(l, Equals, second)
+
+	second.forgetLaunch(s2)
+	_, ok = parent.launchOf(s2)
+	c.Assert(ok, Equals, false)
+
+	_, err := parent.QueryState(s2)
+	c.Assert(err, Equals, errControlUnavailable)
+}
+
+type fakeService struct {
+	tor.Service
+}
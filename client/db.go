@@ -1,15 +1,25 @@
 package client
 
 import (
-	"bytes"
-	b "encoding/binary"
+	"errors"
 	"io/ioutil"
-	"os"
 	"path/filepath"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/sqlite"
+)
+
+const (
+	certTable          = "cert"
+	certHostnameColumn = "hostname"
+	certPortColumn     = "port"
+	certDigestColumn   = "digest"
 )
 
+var errInvalidCertTable = errors.New("the Mumble database doesn't have the expected certificates table")
+
 func (c *client) db() (*dbData, error) {
 	sqlFile := filepath.Join(filepath.Dir(c.configFile), ".mumble.sqlite")
 
@@ -35,42 +45,107 @@ func (c *client) db() (*dbData, error) {
 
 type dbData struct {
 	filename string
-	content  []byte
+	db       *sqlite.Database
 }
 
-func (d *dbData) exists(k string) bool {
-	return bytes.Contains(d.content, []byte(k))
+// pinnedCertificate is a row of the table where Mumble keeps
+// the digest of the certificate it accepts for each server
+type pinnedCertificate struct {
+	hostname string
+	port     int
+	digest   string
 }
 
-func (d *dbData) replaceString(find, replace string) {
-	newContent := bytes.Replace(d.content, []byte(find), []byte(replace), -1)
-	d.content = newContent
+type certTableColumns struct {
+	count    int
+	hostname int
+	port     int
+	digest   int
 }
 
-func (d *dbData) replaceInteger(find, replace uint16) {
-	d.replaceString(
-		intToStringToSearch(find),
-		intToStringToSearch(replace),
-	)
+// certColumns returns the position of the columns we
+// use in the certificates table, whatever its order is
+func (d *dbData) certColumns() (certTableColumns, error) {
+	columns, err := d.db.Columns(certTable)
+	if err != nil {
+		return certTableColumns{}, err
+	}
+
+	result := certTableColumns{count: len(columns), hostname: -1, port: -1, digest: -1}
+	for i, c := range columns {
+		switch strings.ToLower(c) {
+		case certHostnameColumn:
+			result.hostname = i
+		case certPortColumn:
+			result.port = i
+		case certDigestColumn:
+			result.digest = i
+		}
+	}
+
+	if result.hostname < 0 || result.port < 0 || result.digest < 0 {
+		return certTableColumns{}, errInvalidCertTable
+	}
+
+	return result, nil
 }
 
-func (d *dbData) write() error {
-	err := ioutil.WriteFile(d.filename, d.content, 0)
+func (d *dbData) certificates() ([]pinnedCertificate, error) {
+	columns, err := d.certColumns()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	rows, err := d.db.Rows(certTable)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]pinnedCertificate, 0, len(rows))
+	for _, r := range rows {
+		hostname, _ := r.Values[columns.hostname].(string)
+		port, _ := r.Values[columns.port].(int64)
+		digest, _ := r.Values[columns.digest].(string)
+
+		result = append(result, pinnedCertificate{hostname: hostname, port: int(port), digest: digest})
 	}
-	return nil
+
+	return result, nil
 }
 
-func intToStringToSearch(n uint16) string {
-	n1 := n >> 8
-	n2 := n % 256
+// pinCertificate makes Mumble accept the certificate with the given
+// digest for the server, replacing the one it accepted before, if any
+func (d *dbData) pinCertificate(hostname string, port int, digest string) error {
+	columns, err := d.certColumns()
+	if err != nil {
+		return err
+	}
 
-	buf := new(bytes.Buffer)
-	_ = b.Write(buf, b.LittleEndian, uint8(n1))
-	_ = b.Write(buf, b.LittleEndian, uint8(n2))
+	rows, err := d.db.Rows(certTable)
+	if err != nil {
+		return err
+	}
+
+	var next int64 = 1
+	for _, r := range rows {
+		if r.Values[columns.hostname] == hostname && r.Values[columns.port] == int64(port) {
+			r.Values[columns.digest] = digest
+			return d.db.SetRows(certTable, rows)
+		}
+
+		next = r.ID + 1
+	}
 
-	return buf.String()
+	values := make([]interface{}, columns.count)
+	values[columns.hostname] = hostname
+	values[columns.port] = int64(port)
+	values[columns.digest] = digest
+
+	return d.db.SetRows(certTable, append(rows, sqlite.Row{ID: next, Values: values}))
+}
+
+func (d *dbData) write() error {
+	return d.db.Save(d.filename)
 }
 
 func loadDBFromFile(filename string) (*dbData, error) {
@@ -78,25 +153,15 @@ func loadDBFromFile(filename string) (*dbData, error) {
 		"filepath": filename,
 	}).Debug("Loading Mumble sqlite database")
 
-	content, err := readBinaryContent(filename)
+	db, err := sqlite.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 
 	d := &dbData{
 		filename: filename,
-		content:  content,
+		db:       db,
 	}
 
 	return d, nil
 }
-
-func readBinaryContent(filename string) ([]byte, error) {
-	file, err := os.Open(filepath.Clean(filename))
-	if err != nil {
-		return nil, err
-	}
-	defer closeAndIgnore(file)
-
-	return ioutil.ReadAll(file)
-}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ClientDBSuite struct {
+	dir string
+	c   *client
+}
+
+var _ = Suite(&ClientDBSuite{})
+
+const (
+	testHost1 = "qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion"
+	testHost2 = "ffaaffaabbddaabbddeeaaddccaaffeebbaabbeeddeeaaddbbeeeeff.onion"
+)
+
+func (s *ClientDBSuite) SetUpTest(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-client-test")
+	c.Assert(err, IsNil)
+
+	s.dir = dir
+	s.c = newMumbleClient(nil, readerMumbleDB, nil)
+	s.c.configFile = filepath.Join(dir, configFileName)
+}
+
+func (s *ClientDBSuite) TearDownTest(c *C) {
+	_ = os.RemoveAll(s.dir)
+}
+
+func (s *ClientDBSuite) certificates(c *C) []pinnedCertificate {
+	d, err := s.c.db()
+	c.Assert(err, IsNil)
+
+	certs, err := d.certificates()
+	c.Assert(err, IsNil)
+
+	return certs
+}
+
+func (s *ClientDBSuite) Test_theTemplateDatabaseHasNoCertificates(c *C) {
+	c.Assert(s.certificates(c), HasLen, 0)
+}
+
+func (s *ClientDBSuite) Test_storeCertificateInDB_pinsAnyNumberOfServers(c *C) {
+	c.Assert(s.c.storeCertificateInDB(testHost1, 64738, "aaaa"), IsNil)
+	c.Assert(s.c.storeCertificateInDB(testHost2, 8080, "bbbb"), IsNil)
+	c.Assert(s.c.storeCertificateInDB(testHost1, 8080, "cccc"), IsNil)
+
+	c.Assert(s.certificates(c), DeepEquals, []pinnedCertificate{
+		{hostname: testHost1, port: 64738, digest: "aaaa"},
+		{hostname: testHost2, port: 8080, digest: "bbbb"},
+		{hostname: testHost1, port: 8080, digest: "cccc"},
+	})
+}
+
+func (s *ClientDBSuite) Test_storeCertificateInDB_replacesTheDigestOfAKnownServer(c *C) {
+	c.Assert(s.c.storeCertificateInDB(testHost1, 64738, "aaaa"), IsNil)
+	c.Assert(s.c.storeCertificateInDB(testHost1, 64738, "dddd"), IsNil)
+
+	c.Assert(s.certificates(c), DeepEquals, []pinnedCertificate{
+		{hostname: testHost1, port: 64738, digest: "dddd"},
+	})
+}
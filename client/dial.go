@@ -0,0 +1,57 @@
+package client
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/proxy"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// meetingConnectTimeout is how long it can take to
+// connect to a meeting. Reaching an onion service can be slow
+const meetingConnectTimeout = 2 * time.Minute
+
+// torDialer returns a function that opens connections through Tor
+func torDialer(t tor.Instance, timeout time.Duration) func(network, address string) (net.Conn, error) {
+	return func(network, address string) (net.Conn, error) {
+		host, port := t.SocksProxy()
+
+		dialer, err := proxy.SOCKS5("tcp", net.JoinHostPort(host, strconv.Itoa(port)), nil, &net.Dialer{Timeout: timeout})
+		if err != nil {
+			return nil, err
+		}
+
+		return dialer.Dial(network, address)
+	}
+}
+
+// dialMeeting connects through Tor to the Mumble server at the given
+// address, and finishes the TLS handshake before the timeout. The
+// deadline of the connection is left at the end of the timeout
+func dialMeeting(t tor.Instance, address string, timeout time.Duration) (*tls.Conn, error) {
+	conn, err := torDialer(t, timeout)("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The meeting servers use self-signed certificates. The onion
+	// address already proves who we are talking to, and Tor
+	// encrypts the connection from end to end
+	/* #nosec G402 */
+	tc := tls.Client(conn, &tls.Config{InsecureSkipVerify: true})
+
+	err = tc.SetDeadline(time.Now().Add(timeout))
+	if err == nil {
+		err = tc.Handshake()
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return tc, nil
+}
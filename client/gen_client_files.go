@@ -187,8 +187,8 @@ var _escData = map[string]*_escFile{
 		size:    122880,
 		modtime: 1582925979,
 		compressed: `
-U1FMaXRlIGZvcm1hdCAzABAAAQEAQCAgAAAAKAAAAB4AAAAAAAAAAAAAACMAAAAEAAAAAAAAAAAAAAAB
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAC4csA0AAAAdA58ADzkO5w6HDjINvA0Z
+U1FMaXRlIGZvcm1hdCAzABAAAQEAQCAgAAAAKQAAAB4AAAAAAAAAAAAAACMAAAAEAAAAAAAAAAAAAAAB
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAApAC6KEg0AAAAdA58ADzkO5w6HDjINvA0Z
 DLoMNgvAC1cK9Ap+CcAJLAi/CGoIGgfRB3gHFwbDBmEGCQWxBVkFCQS1BBUDnwAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
@@ -733,7 +733,7 @@ AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAANAAAAAQ+PAA+PAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAANAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
@@ -799,9 +799,9 @@ AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAG8BBgCBCQNdZmZhYWZmYWFiYmRkYWFiYmRkZWVh
-YWRkY2NhYWZmZWViYmFhYmJlZWRkZWVhYWRkYmJlZWVlZmYub25pb24A/OJBQUFCQUNBREFGQkFCQkJD
-QkRCRUJGQ0FDQkNDQ0RDRUNGREFEQkRDDQAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADQAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
@@ -1688,8 +1688,10 @@ AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAAAABD7kA
-D7kAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAAAAAEAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
@@ -1755,9 +1757,7 @@ AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARgWB
-CQMJZmZhYWZmYWFiYmRkYWFiYmRkZWVhYWRkY2NhYWZmZWViYmFhYmJlZWRkZWVhYWRkYmJlZWVlZmYu
-b25pb24A/OIKAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+AAAAAAAAAAAKAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
@@ -2240,17 +2240,25 @@ AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 
 	"/files/mumble.ini": {
 		local:   "files/mumble.ini",
-		size:    467,
+		size:    935,
 		modtime: 1594917661,
 		compressed: `
 IyBNdW1ibGUgY29uZmlndXJhdGlvbiB0byBiZSB1c2VkIGluIFdhaGF5CltHZW5lcmFsXQpsYXN0dXBk
-YXRlPTIKCltuZXRdCnRjcG9ubHk9dHJ1ZQojQ0VSVElGSUNBVEUKCltvdmVybGF5XQplbmFibGU9ZmFs
-c2UKdmVyc2lvbj0xLjMuMAoKW3ByaXZhY3ldCmhpZGVvcz10cnVlCgpbYXVkaW9dCmlucHV0PVB1bHNl
-QXVkaW8Kb3V0cHV0PVB1bHNlQXVkaW8KcXVhbGl0eT0xNjAwMAp0cmFuc21pdD0yCgpbc2hvcnRjdXRz
-XQoxXGRhdGE9QEludmFsaWQoKQoxXGluZGV4PTEKMVxrZXlzPUBWYXJpYW50KFwwXDBcMFx0XDBcMFww
-XHgxXDBcMFwwXHgyXDBcMFwwaSkKMVxzdXBwcmVzcz1mYWxzZQpzaXplPTEKClt0dHNdCmVuYWJsZT1m
-YWxzZQoKW3VpXQpXaW5kb3dMYXlvdXQ9Mgphc2tvbnF1aXQ9ZmFsc2UKZHJhZz0xCnNob3d1c2VyY291
-bnQ9dHJ1ZQpzdGF0ZWludHJheT1mYWxzZQp1c2FnZT1mYWxzZQojTEFOR1VBR0U=
+YXRlPTIKCltuZXRdCnRjcG9ubHk9dHJ1ZQp7ey0gd2l0aCAuQ2VydGlmaWNhdGV9fQpjZXJ0aWZpY2F0
+ZT17ey59fQp7ey0gZW5kfX0Ke3stIHdpdGggLlByb3h5fX0KcHJveHl0eXBlPXt7LlR5cGV9fQpwcm94
+eWhvc3Q9e3suSG9zdH19CnByb3h5cG9ydD17ey5Qb3J0fX0KcHJveHl1c2VybmFtZT17ey5Vc2VybmFt
+ZX19CnByb3h5cGFzc3dvcmQ9e3suUGFzc3dvcmR9fQp7ey0gZW5kfX0KCltvdmVybGF5XQplbmFibGU9
+ZmFsc2UKdmVyc2lvbj0xLjMuMAoKW3ByaXZhY3ldCmhpZGVvcz10cnVlCgpbYXVkaW9dCmlucHV0PXt7
+LkF1ZGlvLkJhY2tlbmR9fQpvdXRwdXQ9e3suQXVkaW8uQmFja2VuZH19CnF1YWxpdHk9e3suQXVkaW8u
+UXVhbGl0eX19CnRyYW5zbWl0PXt7LkF1ZGlvLlRyYW5zbWl0fX0KdmFkc291cmNlPTAKdmFkbWluPXt7
+LkF1ZGlvLlZBRE1pbn19CnZhZG1heD17ey5BdWRpby5WQURNYXh9fQp7ey0gaWYgLkF1ZGlvLkRldmlj
+ZVNlY3Rpb259fQoKW3t7LkF1ZGlvLkRldmljZVNlY3Rpb259fV0Ke3stIHdpdGggLkF1ZGlvLklucHV0
+RGV2aWNlfX0KaW5wdXQ9e3tpbmkgLn19Cnt7LSBlbmR9fQp7ey0gd2l0aCAuQXVkaW8uT3V0cHV0RGV2
+aWNlfX0Kb3V0cHV0PXt7aW5pIC59fQp7ey0gZW5kfX0Ke3stIGVuZH19Cgpbc2hvcnRjdXRzXQoxXGRh
+dGE9QEludmFsaWQoKQoxXGluZGV4PTEKMVxrZXlzPXt7LkF1ZGlvLlB1c2hUb1RhbGtLZXl9fQoxXHN1
+cHByZXNzPWZhbHNlCnNpemU9MQoKW3R0c10KZW5hYmxlPWZhbHNlCgpbdWldCldpbmRvd0xheW91dD0y
+CmFza29ucXVpdD1mYWxzZQpkcmFnPTEKc2hvd3VzZXJjb3VudD10cnVlCnN0YXRlaW50cmF5PWZhbHNl
+CnVzYWdlPWZhbHNlCmxhbmd1YWdlPXt7Lkxhbmd1YWdlfX0=
 `,
 	},
 
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+)
+
+const (
+	identityValidity = 24 * time.Hour * 365 * 10
+
+	pemCertificate   = "CERTIFICATE"
+	pemPrivateKey    = "PRIVATE KEY"
+	pemRSAPrivateKey = "RSA PRIVATE KEY"
+	pemECPrivateKey  = "EC PRIVATE KEY"
+)
+
+var (
+	errInvalidIdentity     = errors.New("the identity must have a certificate and its private key")
+	errIdentityKeyMismatch = errors.New("the private key doesn't belong to the certificate")
+	errNoIdentity          = errors.New("the persistent identity is enabled but there is no identity")
+)
+
+// Identity is the client certificate, and its private key, that Mumble
+// presents to the servers. Keeping the same one lets the hosts
+// recognize the participant when they join another meeting
+type Identity struct {
+	certificate []byte
+	key         interface{}
+}
+
+// NewIdentity creates a new identity
+func NewIdentity() (*Identity, error) {
+	cert, key, err := genCert(identityValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{certificate: cert, key: key}, nil
+}
+
+// ParseIdentity reads an identity in PEM format, with the
+// certificate and its private key, like Bytes returns it
+func ParseIdentity(data []byte) (*Identity, error) {
+	i := &Identity{}
+
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+
+		switch block.Type {
+		case pemCertificate:
+			if i.certificate == nil {
+				i.certificate = block.Bytes
+			}
+		case pemPrivateKey, pemRSAPrivateKey, pemECPrivateKey:
+			if i.key != nil {
+				continue
+			}
+
+			key, err := parsePrivateKey(block)
+			if err != nil {
+				return nil, err
+			}
+			i.key = key
+		}
+	}
+
+	if i.certificate == nil || i.key == nil {
+		return nil, errInvalidIdentity
+	}
+
+	cert, err := x509.ParseCertificate(i.certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo, err := x509.MarshalPKIXPublicKey(publicKeyOf(i.key))
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(keyInfo, cert.RawSubjectPublicKeyInfo) {
+		return nil, errIdentityKeyMismatch
+	}
+
+	return i, nil
+}
+
+func parsePrivateKey(block *pem.Block) (interface{}, error) {
+	switch block.Type {
+	case pemRSAPrivateKey:
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case pemECPrivateKey:
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+func publicKeyOf(key interface{}) interface{} {
+	if k, ok := key.(interface{ Public() crypto.PublicKey }); ok {
+		return k.Public()
+	}
+	return nil
+}
+
+// Bytes returns the certificate and the private key in PEM format
+func (i *Identity) Bytes() ([]byte, error) {
+	keyInfo, err := x509.MarshalPKCS8PrivateKey(i.key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := pem.EncodeToMemory(&pem.Block{Type: pemCertificate, Bytes: i.certificate})
+	return append(result, pem.EncodeToMemory(&pem.Block{Type: pemPrivateKey, Bytes: keyInfo})...), nil
+}
+
+// Fingerprint returns the digest of the certificate, the same
+// that the hosts see when the participant joins a meeting
+func (i *Identity) Fingerprint() string {
+	digest, _ := digestForCertificate(i.certificate)
+	return digest
+}
+
+// mumbleCertificate returns the identity in the format that the Mumble
+// configuration file uses, and the digest of the certificate
+func (i *Identity) mumbleCertificate() (string, string, error) {
+	data, err := encodePKCS12(i.key, i.certificate)
+	if err != nil {
+		return "", "", err
+	}
+
+	return byteArrayUnparse(data), i.Fingerprint(), nil
+}
+
+// tlsCertificate returns the identity the way it's
+// presented when connecting to a Mumble server
+func (i *Identity) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{i.certificate}, PrivateKey: i.key}
+}
+
+// identity returns the identity that the client should use,
+// or nil if it has to generate a new one each time
+func (c *client) identity() (*Identity, error) {
+	if c.conf == nil || !c.conf.GetPersistentIdentity() {
+		return nil, nil
+	}
+
+	data := c.conf.GetIdentityCertificate()
+	if len(data) == 0 {
+		return nil, errNoIdentity
+	}
+
+	return ParseIdentity([]byte(data))
+}
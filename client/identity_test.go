@@ -0,0 +1,72 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+type ClientIdentitySuite struct{}
+
+var _ = Suite(&ClientIdentitySuite{})
+
+func (s *ClientIdentitySuite) Test_ParseIdentity_readsWhatBytesReturns(c *C) {
+	id, err := NewIdentity()
+	c.Assert(err, IsNil)
+
+	data, err := id.Bytes()
+	c.Assert(err, IsNil)
+
+	parsed, err := ParseIdentity(data)
+	c.Assert(err, IsNil)
+	c.Assert(parsed.certificate, DeepEquals, id.certificate)
+	c.Assert(parsed.Fingerprint(), Equals, id.Fingerprint())
+}
+
+func (s *ClientIdentitySuite) Test_ParseIdentity_refusesAKeyOfAnotherCertificate(c *C) {
+	id1, err := NewIdentity()
+	c.Assert(err, IsNil)
+	id2, err := NewIdentity()
+	c.Assert(err, IsNil)
+
+	data, err := (&Identity{certificate: id1.certificate, key: id2.key}).Bytes()
+	c.Assert(err, IsNil)
+
+	_, err = ParseIdentity(data)
+	c.Assert(err, Equals, errIdentityKeyMismatch)
+
+	_, err = ParseIdentity([]byte("not an identity"))
+	c.Assert(err, Equals, errInvalidIdentity)
+}
+
+func (s *ClientIdentitySuite) Test_saveCertificateConfigFile_usesThePersistentIdentity(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-client-test")
+	c.Assert(err, IsNil)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	id, err := NewIdentity()
+	c.Assert(err, IsNil)
+	data, err := id.Bytes()
+	c.Assert(err, IsNil)
+
+	conf := config.New()
+	conf.SetPersistentIdentity(true)
+	conf.SetIdentityCertificate(string(data))
+
+	cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	cl.conf = conf
+	c.Assert(cl.writeConfigToFile(dir), IsNil)
+
+	digest, err := cl.saveCertificateConfigFile()
+	c.Assert(err, IsNil)
+	c.Assert(digest, Equals, id.Fingerprint())
+
+	conf.SetIdentityCertificate("")
+	c.Assert(cl.writeConfigToFile(dir), IsNil)
+
+	_, err = cl.saveCertificateConfigFile()
+	c.Assert(err, Equals, errNoIdentity)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// Every time Mumble is run, it gets its own private directory with the
+// configuration file and the database, that is removed when that Mumble
+// finishes. This way, two meetings running at the same time don't touch
+// the configuration of each other. A Mumble installed in the system reads
+// its configuration from the directory where the binary is, so the binary
+// is copied there too. A sandboxed Mumble can only read the directories it
+// has access to, so its directory is created inside of them, and Mumble
+// is told to use it with --config. Mumble is always run with --multiple.
+// Otherwise, when another Mumble is already running, the new one would pass
+// the meeting to it and exit, and the meeting would use the configuration
+// of the other one.
+
+const (
+	launchDirPrefix = "mumble"
+
+	mumbleMultipleInstancesOption = "--multiple"
+)
+
+// newLaunch returns a client that has its configuration in a new private
+// directory, ready to run Mumble once. The directory is removed with
+// removeLaunch, or when the main client is destroyed
+func (c *client) newLaunch() (*client, error) {
+	dir, err := c.launchDir()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	c.launchDirs = append(c.launchDirs, dir)
+	c.Unlock()
+
+	l := newMumbleClient(c.configContentProvider, c.databaseProvider, c.tor)
+	l.conf = c.conf
+	l.configDir = dir
+	l.parent = c
+
+	err = l.prepareLaunch(c.binary)
+	if err != nil {
+		l.removeLaunch()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (c *client) launchDir() (string, error) {
+	parent := ""
+	if sb := c.binary.sandbox; sb != nil {
+		parent = sb.configDir
+		err := createDir(parent)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return ioutil.TempDir(parent, launchDirPrefix)
+}
+
+func (c *client) prepareLaunch(b *binary) error {
+	if b.sandbox == nil {
+		copied := *b
+		err := copied.copyTo(c.configDir)
+		if err != nil {
+			return err
+		}
+		b = &copied
+	}
+
+	err := c.setBinary(b)
+	if err != nil {
+		return err
+	}
+
+	return c.ensureConfiguration()
+}
+
+// removeLaunch removes the private directory of this run of Mumble
+func (c *client) removeLaunch() {
+	if c.parent == nil {
+		return
+	}
+
+	removeLaunchDir(c.configDir)
+
+	c.parent.Lock()
+	defer c.parent.Unlock()
+
+	for i, d := range c.parent.launchDirs {
+		if d == c.configDir {
+			c.parent.launchDirs = append(c.parent.launchDirs[:i], c.parent.launchDirs[i+1:]...)
+			break
+		}
+	}
+}
+
+func removeLaunchDir(dir string) {
+	err := os.RemoveAll(dir)
+	if err != nil {
+		log.Errorf("An error occurred while removing the Mumble configuration directory: %s", err.Error())
+	}
+}
+
+// addLaunch lets the parent client find this one
+// to control the Mumble running in the service
+func (c *client) addLaunch(s tor.Service) {
+	if c.parent == nil {
+		return
+	}
+
+	c.parent.Lock()
+	defer c.parent.Unlock()
+
+	if c.parent.launches == nil {
+		c.parent.launches = make(map[tor.Service]*client)
+	}
+	c.parent.launches[s] = c
+}
+
+func (c *client) forgetLaunch(s tor.Service) {
+	if c.parent == nil {
+		return
+	}
+
+	c.parent.Lock()
+	defer c.parent.Unlock()
+
+	delete(c.parent.launches, s)
+}
+
+// launchOf returns the client running Mumble in the given service
+func (c *client) launchOf(s tor.Service) (*client, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	l, ok := c.launches[s]
+	return l, ok
+}
+
+// onlyLaunch tells if this is the only Mumble that Wahay is running
+func (c *client) onlyLaunch() bool {
+	if c.parent == nil {
+		return true
+	}
+
+	c.parent.Lock()
+	defer c.parent.Unlock()
+
+	return len(c.parent.launches) <= 1
+}
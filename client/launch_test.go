@@ -0,0 +1,46 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ClientLaunchSuite struct{}
+
+var _ = Suite(&ClientLaunchSuite{})
+
+func (s *ClientLaunchSuite) Test_newLaunch_givesEveryRunItsOwnConfiguration(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-client-test")
+	c.Assert(err, IsNil)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	bin := filepath.Join(dir, "mumble")
+	c.Assert(ioutil.WriteFile(bin, []byte("#!/bin/sh\n"), 0700), IsNil)
+
+	cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	c.Assert(cl.setBinary(&binary{path: bin, isValid: true}), IsNil)
+
+	l1, err := cl.newLaunch()
+	c.Assert(err, IsNil)
+	l2, err := cl.newLaunch()
+	c.Assert(err, IsNil)
+
+	c.Assert(l1.configDir, Not(Equals), l2.configDir)
+	c.Assert(l1.configFile, Equals, filepath.Join(l1.configDir, configFileName))
+	c.Assert(l1.pathToBinary(), Equals, filepath.Join(l1.configDir, "mumble"))
+	c.Assert(pathExists(filepath.Join(l1.configDir, configDBName)), Equals, true)
+	c.Assert(cl.pathToBinary(), Equals, bin)
+	c.Assert(cl.launchDirs, DeepEquals, []string{l1.configDir, l2.configDir})
+
+	l1.removeLaunch()
+	c.Assert(pathExists(l1.configDir), Equals, false)
+	c.Assert(pathExists(l2.configFile), Equals, true)
+	c.Assert(cl.launchDirs, DeepEquals, []string{l2.configDir})
+
+	cl.Destroy()
+	c.Assert(pathExists(l2.configDir), Equals, false)
+	c.Assert(cl.launchDirs, HasLen, 0)
+}
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/rand"
+
+	// #nosec
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+)
+
+// This is the subset of PKCS#12 (RFC 7292) that Mumble needs to import
+// a certificate: the same as `openssl pkcs12 -export -passout pass:`
+// produces, with the private key encrypted with 3DES, the certificate
+// in plain form and a SHA-1 MAC, all of them with an empty password.
+
+var (
+	oidData                 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidShroudedKeyBag       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidX509Certificate      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidLocalKeyID           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidPBEWithSHAAnd3KeyDES = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidSHA1                 = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+)
+
+const (
+	pkcs12Version    = 3
+	pkcs12Iterations = 2048
+	pkcs12SaltSize   = 8
+
+	// The purposes of the keys derived from the password
+	pkcs12KeyID  = 1
+	pkcs12IVID   = 2
+	pkcs12MACID  = 3
+	pkcs12KeyLen = 24
+	pkcs12MACLen = 20
+)
+
+type pfx struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData `asn1:"optional"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"set"`
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+// encodePKCS12 returns the PKCS#12 file with the given private key and
+// certificate, protected with an empty password like Mumble expects
+func encodePKCS12(key interface{}, certificate []byte) ([]byte, error) {
+	keyInfo, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec
+	keyID := sha1.Sum(certificate)
+	attributes, err := localKeyIDAttributes(keyID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := asn1.Marshal(certBag{ID: oidX509Certificate, Data: certificate})
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedKey, err := encryptPrivateKeyInfo(keyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	certContents, err := safeContents(safeBag{ID: oidCertBag, Value: explicit(cert), Attributes: attributes})
+	if err != nil {
+		return nil, err
+	}
+
+	keyContents, err := safeContents(safeBag{ID: oidShroudedKeyBag, Value: explicit(encryptedKey), Attributes: attributes})
+	if err != nil {
+		return nil, err
+	}
+
+	authSafe, err := asn1.Marshal([]contentInfo{dataContentInfo(certContents), dataContentInfo(keyContents)})
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := randomSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(pfx{
+		Version:  pkcs12Version,
+		AuthSafe: dataContentInfo(authSafe),
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    pkcs12MAC(authSafe, salt, pkcs12Iterations),
+			},
+			MacSalt:    salt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+func localKeyIDAttributes(keyID []byte) ([]pkcs12Attribute, error) {
+	value, err := asn1.Marshal(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []pkcs12Attribute{{
+		ID:    oidLocalKeyID,
+		Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value},
+	}}, nil
+}
+
+func explicit(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func safeContents(bags ...safeBag) ([]byte, error) {
+	return asn1.Marshal(bags)
+}
+
+func dataContentInfo(data []byte) contentInfo {
+	octets, _ := asn1.Marshal(data)
+	return contentInfo{ContentType: oidData, Content: explicit(octets)}
+}
+
+func randomSalt() ([]byte, error) {
+	salt := make([]byte, pkcs12SaltSize)
+	_, err := rand.Read(salt)
+	return salt, err
+}
+
+func encryptPrivateKeyInfo(keyInfo []byte) ([]byte, error) {
+	salt, err := randomSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := asn1.Marshal(pbeParams{Salt: salt, Iterations: pkcs12Iterations})
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := des.NewTripleDESCipher(pkcs12KDF(emptyPassword, salt, pkcs12KeyID, pkcs12Iterations, pkcs12KeyLen))
+	if err != nil {
+		return nil, err
+	}
+
+	iv := pkcs12KDF(emptyPassword, salt, pkcs12IVID, pkcs12Iterations, block.BlockSize())
+
+	padding := block.BlockSize() - len(keyInfo)%block.BlockSize()
+	data := append(append([]byte{}, keyInfo...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(data, data)
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBEWithSHAAnd3KeyDES, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: data,
+	})
+}
+
+func pkcs12MAC(data, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha1.New, pkcs12KDF(emptyPassword, salt, pkcs12MACID, iterations, pkcs12MACLen))
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// emptyPassword is how the empty password is written in PKCS#12:
+// a BMPString with a terminator, so just two zero bytes
+var emptyPassword = []byte{0, 0}
+
+// pkcs12KDF derives a key from the password, as
+// described in appendix B.2 of RFC 7292
+func pkcs12KDF(password, salt []byte, id byte, iterations, size int) []byte {
+	const v = 64
+
+	d := bytes.Repeat([]byte{id}, v)
+	i := append(fillBlocks(salt, v), fillBlocks(password, v)...)
+
+	var result []byte
+	for len(result) < size {
+		// #nosec
+		h := sha1.New()
+		_, _ = h.Write(d)
+		_, _ = h.Write(i)
+		a := h.Sum(nil)
+
+		for r := 1; r < iterations; r++ {
+			// #nosec
+			sum := sha1.Sum(a)
+			a = sum[:]
+		}
+
+		result = append(result, a...)
+
+		// Every block of I becomes (I + B + 1) mod 2^(v*8)
+		b := new(big.Int).SetBytes(fillBlocks(a, v))
+		b.Add(b, big.NewInt(1))
+		for j := 0; j < len(i); j += v {
+			block := new(big.Int).SetBytes(i[j : j+v])
+			block.Add(block, b)
+			sum := block.Bytes()
+			if len(sum) > v {
+				sum = sum[len(sum)-v:]
+			}
+			copy(i[j:j+v], make([]byte, v))
+			copy(i[j+v-len(sum):j+v], sum)
+		}
+	}
+
+	return result[:size]
+}
+
+// fillBlocks repeats the data until it fills a number of whole blocks
+func fillBlocks(data []byte, size int) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+
+	n := size * ((len(data) + size - 1) / size)
+	result := make([]byte, n)
+	for i := range result {
+		result[i] = data[i%len(data)]
+	}
+
+	return result
+}
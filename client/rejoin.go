@@ -0,0 +1,61 @@
+package client
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+const (
+	rejoinMaxAttempts = 3
+	rejoinFirstDelay  = 2 * time.Second
+
+	// rejoinStableDuration is how long Mumble has to run before a crash
+	// is not considered part of the same series of failed attempts
+	rejoinStableDuration = 2 * time.Minute
+
+	// handoffMaxDuration is how long a Mumble that passes the meeting to
+	// another one takes to exit. Nobody joins and leaves a meeting so fast
+	handoffMaxDuration = 5 * time.Second
+)
+
+// Rejoiner decides when to join a meeting again after Mumble crashes.
+// The time to wait before joining grows after every attempt, and after
+// some attempts in a row without success it gives up
+type Rejoiner struct {
+	attempts int
+}
+
+// Next returns how long to wait before joining the meeting again after
+// Mumble finished with the given status, and false when we shouldn't
+func (r *Rejoiner) Next(status tor.ExitStatus) (time.Duration, bool) {
+	if !status.Crashed() {
+		return 0, false
+	}
+
+	if status.Duration >= rejoinStableDuration {
+		r.attempts = 0
+	}
+
+	if r.attempts >= rejoinMaxAttempts {
+		return 0, false
+	}
+
+	delay := rejoinFirstDelay << uint(r.attempts)
+	r.attempts++
+
+	return delay, true
+}
+
+// Attempts returns how many times the meeting has been joined again
+func (r *Rejoiner) Attempts() int {
+	return r.attempts
+}
+
+// HandedOff tells if Mumble exited successfully right after starting. That's
+// what a Mumble that can't run more than once does when another one is
+// already running: it passes the meeting to the other one and exits. Then the
+// meeting doesn't use the configuration Wahay made for it, so it's not a quit
+func HandedOff(status tor.ExitStatus) bool {
+	return !status.Closed && status.Err == nil && status.Duration < handoffMaxDuration
+}
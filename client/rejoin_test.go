@@ -0,0 +1,55 @@
+package client
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type ClientRejoinSuite struct{}
+
+var _ = Suite(&ClientRejoinSuite{})
+
+func (s *ClientRejoinSuite) Test_Rejoiner_waitsLongerEveryTimeAndGivesUp(c *C) {
+	r := &Rejoiner{}
+	crash := tor.ExitStatus{Code: -1, Duration: time.Second, Err: errors.New("signal: segmentation fault")}
+
+	delay, ok := r.Next(crash)
+	c.Assert(ok, Equals, true)
+	c.Assert(delay, Equals, 2*time.Second)
+
+	delay, _ = r.Next(crash)
+	c.Assert(delay, Equals, 4*time.Second)
+
+	delay, _ = r.Next(crash)
+	c.Assert(delay, Equals, 8*time.Second)
+
+	_, ok = r.Next(crash)
+	c.Assert(ok, Equals, false)
+
+	// A Mumble that ran for a while starts a new series of attempts
+	crash.Duration = time.Hour
+	delay, ok = r.Next(crash)
+	c.Assert(ok, Equals, true)
+	c.Assert(delay, Equals, 2*time.Second)
+}
+
+func (s *ClientRejoinSuite) Test_Rejoiner_doesntRejoinWhenMumbleWasQuit(c *C) {
+	r := &Rejoiner{}
+
+	_, ok := r.Next(tor.ExitStatus{})
+	c.Assert(ok, Equals, false)
+
+	_, ok = r.Next(tor.ExitStatus{Code: -1, Closed: true, Err: errors.New("signal: killed")})
+	c.Assert(ok, Equals, false)
+}
+
+func (s *ClientRejoinSuite) Test_HandedOff_isAQuickSuccessfulExit(c *C) {
+	c.Assert(HandedOff(tor.ExitStatus{Duration: time.Second}), Equals, true)
+	c.Assert(HandedOff(tor.ExitStatus{Duration: time.Hour}), Equals, false)
+	c.Assert(HandedOff(tor.ExitStatus{Duration: time.Second, Closed: true}), Equals, false)
+	c.Assert(HandedOff(tor.ExitStatus{Code: 1, Duration: time.Second, Err: errors.New("exit status 1")}), Equals, false)
+}
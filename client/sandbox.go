@@ -0,0 +1,137 @@
+package client
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// Mumble installed as a Flatpak or a Snap runs in a sandbox: it can't see
+// our temporary directories, it ignores a mumble.ini next to the binary,
+// and torsocks can't be preloaded into it, because the LD_PRELOAD library
+// from the host is not available, or not allowed, inside the sandbox.
+// Instead, we write the configuration file in a directory that the sandbox
+// can read and tell Mumble to use it with --config (Mumble 1.4 or newer),
+// and we make Mumble connect through the Tor SOCKS proxy by itself.
+
+const (
+	flatpakMumbleID      = "info.mumble.Mumble"
+	snapMumbleLauncher   = "/snap/bin/mumble"
+	snapLauncherDir      = "/snap/bin"
+	snapMumbleMetadata   = "/snap/mumble/current/meta/snap.yaml"
+	sandboxConfigDirName = "wahay"
+
+	// This is the SOCKS5 value of the proxy type in the Mumble settings
+	mumbleSocks5Proxy = 2
+)
+
+var errSandboxWithoutConfigOption = errors.New("the sandboxed Mumble is not known to support the --config option, " +
+	"so it can't be made to connect through Tor")
+
+type sandbox struct {
+	// name is the kind of sandbox, only used for logging
+	name string
+
+	// args are the arguments that go before the ones for Mumble
+	args []string
+
+	// configDir is a directory where Mumble can read its
+	// configuration from inside the sandbox
+	configDir string
+}
+
+// arguments returns all the arguments to run
+// Mumble with the given configuration file
+func (s *sandbox) arguments(configFile string, args []string) []string {
+	result := append([]string{}, s.args...)
+	result = append(result, "--config", configFile)
+	return append(result, args...)
+}
+
+func searchBinaryInFlatpak() (*binary, error) {
+	path, err := exec.LookPath("flatpak")
+	if err != nil {
+		return nil, nil
+	}
+
+	// This executes the flatpak command with arguments under control of the code
+	/* #nosec G204 */
+	info, err := exec.Command(path, "info", flatpakMumbleID).Output()
+	if err != nil {
+		return nil, nil
+	}
+
+	b := newSandboxedBinary(path, &sandbox{
+		name:      "flatpak",
+		args:      []string{"run", flatpakMumbleID},
+		configDir: config.WithHome(filepath.Join(".var", "app", flatpakMumbleID, "data", sandboxConfigDirName)),
+	})
+	b.version = versionFromField(info, "version")
+
+	return b, nil
+}
+
+func searchBinaryInSnap() (*binary, error) {
+	if !pathExists(snapMumbleLauncher) {
+		return nil, nil
+	}
+
+	b := newSandboxedBinary(snapMumbleLauncher, &sandbox{
+		name:      "snap",
+		configDir: config.WithHome(filepath.Join("snap", "mumble", "common", sandboxConfigDirName)),
+	})
+	b.version = versionFromFile(snapMumbleMetadata, "version")
+
+	return b, nil
+}
+
+func newSandboxedBinary(path string, s *sandbox) *binary {
+	log.WithFields(log.Fields{
+		"path":    path,
+		"sandbox": s.name,
+	}).Debug("Found a sandboxed Mumble")
+
+	return &binary{
+		path:    path,
+		isValid: true,
+		env:     []string{},
+		sandbox: s,
+	}
+}
+
+// isSnapLauncher tells if the path is the one of a program installed as a
+// Snap, that we can't copy or run with torsocks like a normal binary
+func isSnapLauncher(path string) bool {
+	return filepath.Dir(path) == snapLauncherDir
+}
+
+// mumbleProxy is the part of the Mumble configuration that makes it
+// connect through the Tor SOCKS proxy
+type mumbleProxy struct {
+	Type     int
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// newMumbleProxy uses random credentials to isolate
+// the circuits of Mumble, the same as torsocks does
+func newMumbleProxy(host string, port int) *mumbleProxy {
+	user := [32]byte{}
+	_ = config.RandomString(user[:])
+	password := [32]byte{}
+	_ = config.RandomString(password[:])
+
+	return &mumbleProxy{
+		Type:     mumbleSocks5Proxy,
+		Host:     host,
+		Port:     port,
+		Username: string(user[:]),
+		Password: string(password[:]),
+	}
+}
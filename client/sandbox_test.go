@@ -0,0 +1,64 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type ClientSandboxSuite struct{}
+
+var _ = Suite(&ClientSandboxSuite{})
+
+func (s *ClientSandboxSuite) Test_sandbox_arguments_pointsMumbleToItsConfiguration(c *C) {
+	sb := &sandbox{args: []string{"run", flatpakMumbleID}}
+
+	c.Assert(sb.arguments("/home/u/mumble.ini", []string{"mumble://host.onion"}), DeepEquals,
+		[]string{"run", flatpakMumbleID, "--config", "/home/u/mumble.ini", "mumble://host.onion"})
+}
+
+func (s *ClientSandboxSuite) Test_isSnapLauncher_onlyMatchesTheSnapBinaries(c *C) {
+	c.Assert(isSnapLauncher(snapMumbleLauncher), Equals, true)
+	c.Assert(isSnapLauncher("/usr/bin/mumble"), Equals, false)
+}
+
+func (s *ClientSandboxSuite) Test_saveProxyConfigFile_makesMumbleUseTheTorProxy(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-client-test")
+	c.Assert(err, IsNil)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+	cl.binary = &binary{sandbox: &sandbox{configDir: dir}}
+	c.Assert(cl.pathToConfig(), Equals, dir)
+	c.Assert(cl.writeConfigToFile(dir), IsNil)
+	c.Assert(cl.configFile, Equals, filepath.Join(dir, configFileName))
+
+	c.Assert(cl.saveProxyConfigFile("127.0.0.1", 9050), IsNil)
+
+	content, err := ioutil.ReadFile(cl.configFile)
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(content), "[net]\ntcponly=true\nproxytype=2\nproxyhost=127.0.0.1\nproxyport=9050\n"), Equals, true)
+
+	// Writing the certificate afterwards keeps the proxy
+	digest, err := cl.saveCertificateConfigFile()
+	c.Assert(err, IsNil)
+	c.Assert(digest, Matches, "[0-9a-f]{40}")
+
+	content, err = ioutil.ReadFile(cl.configFile)
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(content), "[net]\ntcponly=true\ncertificate="), Equals, true)
+	c.Assert(strings.Contains(string(content), "\nproxytype=2\nproxyhost=127.0.0.1\nproxyport=9050\n"), Equals, true)
+}
+
+func (s *ClientSandboxSuite) Test_startService_refusesSandboxesThatIgnoreTheConfigOption(c *C) {
+	for _, v := range []*mumbleVersion{nil, {1, 3, 4}} {
+		cl := newMumbleClient(rederMumbleIniConfig, readerMumbleDB, nil)
+		cl.binary = &binary{isValid: true, version: v, sandbox: &sandbox{}}
+
+		_, err := cl.startService(nil)
+		c.Assert(err, Equals, errSandboxWithoutConfigOption)
+	}
+}
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type mumbleVersion struct {
+	major, minor, patch int
+}
+
+func (v mumbleVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+func (v mumbleVersion) olderThan(other mumbleVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+	return v.patch < other.patch
+}
+
+// newerSeriesThan compares only the major and minor numbers, since
+// the patch versions of a series don't change what Wahay uses
+func (v mumbleVersion) newerSeriesThan(other mumbleVersion) bool {
+	if v.major != other.major {
+		return v.major > other.major
+	}
+	return v.minor > other.minor
+}
+
+var mumbleVersionPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// parseMumbleVersion finds the first version number in the given text,
+// like "Mumble version 1.4.230" or "Version: 1.3.4"
+func parseMumbleVersion(s string) *mumbleVersion {
+	m := mumbleVersionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+
+	v := &mumbleVersion{}
+	v.major, _ = strconv.Atoi(m[1])
+	v.minor, _ = strconv.Atoi(m[2])
+	v.patch, _ = strconv.Atoi(m[3])
+
+	return v
+}
+
+// versionFromField returns the version in a "name: value" line
+// of the given text, like the output of flatpak info or snap.yaml
+func versionFromField(data []byte, field string) *mumbleVersion {
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(strings.ToLower(line), field+":") {
+			return parseMumbleVersion(line[len(field)+1:])
+		}
+	}
+	return nil
+}
+
+// versionFromOutput returns the version in the first line
+// of the output that talks about a version
+func versionFromOutput(output []byte) *mumbleVersion {
+	s := bufio.NewScanner(bytes.NewReader(output))
+	for s.Scan() {
+		if !strings.Contains(strings.ToLower(s.Text()), "version") {
+			continue
+		}
+		if v := parseMumbleVersion(s.Text()); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+func versionFromFile(filename, field string) *mumbleVersion {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil
+	}
+	return versionFromField(data, field)
+}
+
+// mumbleFeature is something Wahay needs from Mumble that
+// not all the versions of Mumble support in the same way
+type mumbleFeature int
+
+const (
+	// featureIniKeys is reading the mumble.ini keys that Wahay writes:
+	// net/tcponly, the certificate, the shortcuts and the ui settings
+	featureIniKeys mumbleFeature = iota
+
+	// featureCertificateDB is the cert table in the database, where
+	// Wahay pins the certificate of the server before joining
+	featureCertificateDB
+
+	// featureSocksProxy is the net/proxy* settings, that a
+	// sandboxed Mumble uses instead of torsocks
+	featureSocksProxy
+
+	// featureConfigOption is the --config option, that lets a sandboxed
+	// Mumble use a configuration file that is not next to its binary
+	featureConfigOption
+
+	// featureRPC is controlling a running Mumble with "mumble rpc"
+	featureRPC
+
+	// featurePipeWire is using PipeWire as the sound system
+	featurePipeWire
+)
+
+type mumbleCompatibility struct {
+	feature     mumbleFeature
+	description string
+	since       mumbleVersion
+}
+
+// mumbleCompatibilityMatrix is the first version of Mumble that
+// supports each one of the things Wahay needs from it
+var mumbleCompatibilityMatrix = []mumbleCompatibility{
+	{featureIniKeys, "the configuration keys that Wahay writes", mumbleVersion{1, 2, 0}},
+	{featureCertificateDB, "the database of server certificates", mumbleVersion{1, 2, 0}},
+	{featureSocksProxy, "the SOCKS proxy settings", mumbleVersion{1, 2, 0}},
+	{featureConfigOption, "the --config option", mumbleVersion{1, 4, 0}},
+	{featureRPC, "the rpc command", mumbleVersion{1, 3, 0}},
+	{featurePipeWire, "PipeWire", mumbleVersion{1, 5, 0}},
+}
+
+var (
+	// minimumMumbleVersion is the oldest version
+	// of Mumble that Wahay can drive
+	minimumMumbleVersion = mumbleVersion{1, 2, 0}
+
+	// latestTestedMumbleVersion is the newest series
+	// of Mumble that Wahay is known to work with
+	latestTestedMumbleVersion = mumbleVersion{1, 4, 0}
+)
+
+var (
+	errUnknownMumbleVersion    = errors.New("the version of Mumble can't be detected, so some things might not work")
+	errUnknownSandboxedVersion = errors.New("the version of the Mumble installed as a Flatpak or a Snap can't be detected, " +
+		"so Wahay can't make sure it connects through Tor. Please install Mumble 1.4 or newer")
+)
+
+type incompatibleVersionError struct {
+	version mumbleVersion
+	feature string
+	since   mumbleVersion
+}
+
+func (e *incompatibleVersionError) Error() string {
+	return fmt.Sprintf("Mumble %s doesn't support %s. Please install Mumble %s or newer", e.version, e.feature, e.since)
+}
+
+type untestedVersionError struct {
+	version mumbleVersion
+}
+
+func (e *untestedVersionError) Error() string {
+	return fmt.Sprintf("Mumble %s is newer than the versions Wahay has been tested with, so some things might not work", e.version)
+}
+
+func compatibilityOf(f mumbleFeature) mumbleCompatibility {
+	for _, c := range mumbleCompatibilityMatrix {
+		if c.feature == f {
+			return c
+		}
+	}
+	panic(fmt.Sprintf("programmer error: unknown Mumble feature %d", f))
+}
+
+// supports tells if the version of Mumble supports the feature.
+// When the version is unknown, only the features that every
+// version we support has are considered supported
+func (b *binary) supports(f mumbleFeature) bool {
+	since := compatibilityOf(f).since
+	if b.version == nil {
+		return !minimumMumbleVersion.olderThan(since)
+	}
+	return !b.version.olderThan(since)
+}
+
+// requiredFeatures returns the features that
+// Wahay can't drive this Mumble without
+func (b *binary) requiredFeatures() []mumbleFeature {
+	result := []mumbleFeature{featureIniKeys, featureCertificateDB}
+	if b.sandbox != nil {
+		result = append(result, featureSocksProxy, featureConfigOption)
+	}
+	return result
+}
+
+// checkCompatibility returns an error if Wahay can't use this version
+// of Mumble, and warnings for the versions that might not work well
+func (b *binary) checkCompatibility() (warnings []error, err error) {
+	if b.version == nil {
+		// A sandboxed Mumble older than 1.4 ignores --config, and with
+		// it the Tor proxy, so we can't take the risk of guessing
+		if b.sandbox != nil {
+			return nil, errUnknownSandboxedVersion
+		}
+		warnings = append(warnings, errUnknownMumbleVersion)
+	}
+
+	for _, f := range b.requiredFeatures() {
+		if b.version != nil && !b.supports(f) {
+			c := compatibilityOf(f)
+			return nil, &incompatibleVersionError{version: *b.version, feature: c.description, since: c.since}
+		}
+	}
+
+	if b.version != nil && b.version.newerSeriesThan(latestTestedMumbleVersion) {
+		warnings = append(warnings, &untestedVersionError{version: *b.version})
+	}
+
+	return warnings, nil
+}
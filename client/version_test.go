@@ -0,0 +1,62 @@
+package client
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type ClientVersionSuite struct{}
+
+var _ = Suite(&ClientVersionSuite{})
+
+func (s *ClientVersionSuite) Test_versionFromOutput_readsWhatMumblePrints(c *C) {
+	output := []byte("QStandardPaths: XDG_RUNTIME_DIR not set, defaulting to '/tmp/runtime-1000'\nMumble version 1.4.230\n")
+
+	c.Assert(versionFromOutput(output), DeepEquals, &mumbleVersion{1, 4, 230})
+	c.Assert(versionFromOutput([]byte("Usage: mumble [options] [<url>]")), IsNil)
+}
+
+func (s *ClientVersionSuite) Test_versionFromField_readsFlatpakAndSnapMetadata(c *C) {
+	flatpak := []byte("Mumble - Voice chat\n\n          ID: info.mumble.Mumble\n     Version: 1.5.629\n      Branch: stable\n")
+	snap := []byte("name: mumble\nversion: 1.3.4\nsummary: Mumble\n")
+
+	c.Assert(versionFromField(flatpak, "version"), DeepEquals, &mumbleVersion{1, 5, 629})
+	c.Assert(versionFromField(snap, "version"), DeepEquals, &mumbleVersion{1, 3, 4})
+}
+
+func (s *ClientVersionSuite) Test_checkCompatibility_refusesVersionsThatCantBeDriven(c *C) {
+	old := &binary{version: &mumbleVersion{1, 1, 8}}
+	_, err := old.checkCompatibility()
+	c.Assert(err, ErrorMatches, "Mumble 1.1.8 doesn't support the configuration keys that Wahay writes.*")
+
+	sandboxed := &binary{version: &mumbleVersion{1, 3, 4}, sandbox: &sandbox{}}
+	_, err = sandboxed.checkCompatibility()
+	c.Assert(err, ErrorMatches, "Mumble 1.3.4 doesn't support the --config option.*")
+
+	_, err = (&binary{sandbox: &sandbox{}}).checkCompatibility()
+	c.Assert(err, Equals, errUnknownSandboxedVersion)
+
+	native := &binary{version: &mumbleVersion{1, 3, 4}}
+	warnings, err := native.checkCompatibility()
+	c.Assert(err, IsNil)
+	c.Assert(warnings, HasLen, 0)
+}
+
+func (s *ClientVersionSuite) Test_checkCompatibility_warnsAboutUnknownAndUntestedVersions(c *C) {
+	warnings, err := (&binary{}).checkCompatibility()
+	c.Assert(err, IsNil)
+	c.Assert(warnings, DeepEquals, []error{errUnknownMumbleVersion})
+
+	warnings, err = (&binary{version: &mumbleVersion{1, 5, 0}}).checkCompatibility()
+	c.Assert(err, IsNil)
+	c.Assert(warnings, HasLen, 1)
+	c.Assert(warnings[0], ErrorMatches, "Mumble 1.5.0 is newer than the versions Wahay has been tested with.*")
+
+	warnings, _ = (&binary{version: &mumbleVersion{1, 4, 287}}).checkCompatibility()
+	c.Assert(warnings, HasLen, 0)
+}
+
+func (s *ClientVersionSuite) Test_supports_isConservativeWithUnknownVersions(c *C) {
+	c.Assert((&binary{}).supports(featureSocksProxy), Equals, true)
+	c.Assert((&binary{}).supports(featureRPC), Equals, false)
+	c.Assert((&binary{version: &mumbleVersion{1, 3, 0}}).supports(featureRPC), Equals, true)
+}
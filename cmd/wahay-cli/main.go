@@ -0,0 +1,45 @@
+// Command wahay-cli runs the commands of Wahay that don't need a display,
+// like hosting or joining a meeting from a server. Unlike wahay, it's not
+// linked to GTK, so it also starts where GTK is not installed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/digitalautonomy/wahay/cli"
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// BuildCommit contains which commit the build was based on
+var BuildCommit = "UNKNOWN"
+
+// BuildShortCommit contains which commit in short format the build was based on
+var BuildShortCommit = "UNKNOWN"
+
+// BuildTag contains which tag - if any - the build was based on
+var BuildTag = "(no tag)"
+
+// BuildTimestamp contains the timestamp in Ecuador time zone when the build was made
+var BuildTimestamp = "UNKNOWN"
+
+func main() {
+	config.ProcessCommandLineArguments()
+
+	if *config.Version {
+		fmt.Printf("Wahay (commit: %s (%s) tag: %s built: %s)\n", BuildShortCommit, BuildCommit, BuildTag, BuildTimestamp)
+		return
+	}
+
+	config.InitLogging()
+
+	code, ok := cli.Execute(flag.Args())
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Usage: wahay-cli [options] <%s> [command options]\n", strings.Join(cli.Commands(), "|"))
+		os.Exit(2)
+	}
+
+	os.Exit(code)
+}
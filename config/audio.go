@@ -0,0 +1,121 @@
+package config
+
+// The sound systems that Mumble can use
+const (
+	AudioBackendPulseAudio = "PulseAudio"
+	AudioBackendALSA       = "ALSA"
+	AudioBackendJACK       = "JACK"
+	AudioBackendPipeWire   = "PipeWire"
+)
+
+// The ways Mumble decides when to send the voice of the participant
+const (
+	TransmitContinuous    = "continuous"
+	TransmitVoiceActivity = "voice-activity"
+	TransmitPushToTalk    = "push-to-talk"
+)
+
+// The audio qualities, in bits per second, that Mumble offers as presets
+const (
+	AudioQualityLow      = 16000
+	AudioQualityBalanced = 40000
+	AudioQualityHigh     = 72000
+)
+
+var (
+	// AudioBackends are the sound systems that can be chosen
+	AudioBackends = []string{AudioBackendPulseAudio, AudioBackendALSA, AudioBackendJACK, AudioBackendPipeWire}
+
+	// TransmitModes are the ways of sending the voice that can be chosen
+	TransmitModes = []string{TransmitPushToTalk, TransmitVoiceActivity, TransmitContinuous}
+
+	// AudioQualities are the audio qualities that can be chosen
+	AudioQualities = []int{AudioQualityLow, AudioQualityBalanced, AudioQualityHigh}
+
+	// PushToTalkKeys are the names, as X11 key symbols,
+	// of the keys that can be used for push-to-talk
+	PushToTalkKeys = []string{
+		"Control_R",
+		"Control_L",
+		"Alt_R",
+		"Shift_R",
+		"Super_R",
+		"Menu",
+		"Caps_Lock",
+		"Scroll_Lock",
+		"Pause",
+		"F12",
+	}
+)
+
+// AudioPreferences are the audio settings that Wahay
+// gives to Mumble every time it's run
+type AudioPreferences struct {
+	Backend      string
+	InputDevice  string
+	OutputDevice string
+	Transmit     string
+
+	// VoiceThreshold is the percentage of the microphone
+	// level from where Mumble considers that we are talking
+	VoiceThreshold int
+
+	PushToTalkKey string
+	Quality       int
+}
+
+// DefaultAudioPreferences returns the audio settings that Wahay uses when
+// nothing else has been configured: push right control to talk through
+// PulseAudio, with the default devices
+func DefaultAudioPreferences() AudioPreferences {
+	return AudioPreferences{
+		Backend:        AudioBackendPulseAudio,
+		Transmit:       TransmitPushToTalk,
+		VoiceThreshold: 50,
+		PushToTalkKey:  PushToTalkKeys[0],
+		Quality:        AudioQualityLow,
+	}
+}
+
+// GetAudioPreferences returns the audio settings for Mumble.
+// The ones that are not valid have their default value
+func (a *ApplicationConfig) GetAudioPreferences() AudioPreferences {
+	p := a.Audio
+	d := DefaultAudioPreferences()
+
+	if !containsString(AudioBackends, p.Backend) {
+		p.Backend = d.Backend
+	}
+
+	if !containsString(TransmitModes, p.Transmit) {
+		p.Transmit = d.Transmit
+	}
+
+	if p.VoiceThreshold <= 0 || p.VoiceThreshold > 100 {
+		p.VoiceThreshold = d.VoiceThreshold
+	}
+
+	if !containsString(PushToTalkKeys, p.PushToTalkKey) {
+		p.PushToTalkKey = d.PushToTalkKey
+	}
+
+	if p.Quality <= 0 {
+		p.Quality = d.Quality
+	}
+
+	return p
+}
+
+// SetAudioPreferences sets the audio settings for Mumble
+func (a *ApplicationConfig) SetAudioPreferences(p AudioPreferences) {
+	a.Audio = p
+}
+
+func containsString(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,10 @@ package config
 
 import (
 	"flag"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // DefaultHost is where Tor is hosted
@@ -30,10 +34,29 @@ var (
 	DebugFunctionCalls = flag.Bool("debug-function-calls", false, "trace function calls in logging")
 	// Version contains the command line argument given for version
 	Version = flag.Bool("version", false, "display version information and exit")
+	// Profile contains the command line argument given for the profile to use
+	Profile = flag.String("profile", "", "the name of the profile to use - it's created if it doesn't exist")
 )
 
 // ProcessCommandLineArguments will parse the command line, check that
 // required values are given and exit otherwise
 func ProcessCommandLineArguments() {
 	flag.Parse()
+
+	if len(*Profile) > 0 && ValidateProfileName(*Profile) != nil {
+		fmt.Fprintf(os.Stderr, "wahay: invalid profile %q: %s\n", *Profile, ErrInvalidProfileName)
+		os.Exit(2)
+	}
+}
+
+// InitLogging sets the logging level given in the command line
+func InitLogging() {
+	log.SetLevel(log.InfoLevel)
+	if *Debug {
+		log.SetLevel(log.DebugLevel)
+	}
+	if *Trace {
+		log.SetLevel(log.TraceLevel)
+	}
+	log.SetReportCaller(*DebugFunctionCalls)
 }
@@ -2,7 +2,6 @@ package config
 
 import (
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -23,21 +22,30 @@ type ApplicationConfig struct {
 	persistentMode   bool
 	encryptedFile    bool
 	encryptionParams *EncryptionParameters
+	profile          string
 
 	// The fields to save as the JSON representation of the configuration
 	UniqueConfigurationID string
 	AsSuperUser           bool
 	AutoJoin              bool
+	WaitingRoom           bool
+	EventFeed             bool
+	WebSocket             bool
 	PathTor               string
 	PathTorsocks          string
 	LogsEnabled           bool
 	RawLogFile            string
 	PathMumble            string
 	PortMumble            string
+	PersistentIdentity    bool
+	IdentityCertificate   string
+	StrictCertificates    bool
+	Audio                 AudioPreferences
+	ScheduledMeetings     []*ScheduledMeeting
 }
 
 var (
-	errInvalidConfigFile = errors.New("failed to parse config file")
+	errConfigFileUnreadable = errors.New("the configuration file can't be read")
 )
 
 // New creates a new instance of the application config struct
@@ -66,7 +74,9 @@ func (a *ApplicationConfig) DetectPersistence() (string, error) {
 }
 
 // LoadFromFile loads the content of a specific file and import it
-// into the configuration instance.
+// into the configuration instance. A file written by a newer version
+// of Wahay is not invalid, but it can't be loaded: ErrConfigFileTooNew
+// is returned, and the file should be left as it is.
 func (a *ApplicationConfig) LoadFromFile(filename string, k KeySupplier) (invalid bool, repeat bool, err error) {
 	if !a.initialized {
 		return false, false, errors.New("required configuration-init not executed")
@@ -74,7 +84,7 @@ func (a *ApplicationConfig) LoadFromFile(filename string, k KeySupplier) (invali
 
 	if a.IsPersistentConfiguration() {
 		err = a.loadFromFile(filename, k)
-		if err == errorEncryptionBadFile || err == errInvalidConfigFile {
+		if err == errorEncryptionBadFile || err == errConfigFileUnreadable || errors.Is(err, errConfigFileCorrupted) {
 			invalid = true
 			return
 		}
@@ -89,7 +99,7 @@ func (a *ApplicationConfig) LoadFromFile(filename string, k KeySupplier) (invali
 }
 
 func (a *ApplicationConfig) getRealConfigFile() string {
-	dir := Dir()
+	dir := a.dir()
 	encryptedFile := filepath.Join(dir, appEncryptedConfigFile)
 	if FileExists(encryptedFile) {
 		a.SetShouldEncrypt(true)
@@ -121,7 +131,8 @@ func (a *ApplicationConfig) InitDefault() {
 	a.AsSuperUser = true
 	a.AutoJoin = true
 	a.LogsEnabled = false
-	a.RawLogFile = GetDefaultLogFile()
+	a.RawLogFile = a.GetDefaultLogFile()
+	a.StrictCertificates = true
 }
 
 // WhenLoaded will ensure that the function f is not called until the configuration has been loaded
@@ -173,7 +184,7 @@ func (a *ApplicationConfig) tryLoad(k KeySupplier) error {
 
 	contents, err = ReadFileOrTemporaryBackup(a.filename)
 	if err != nil {
-		return errInvalidConfigFile
+		return errConfigFileUnreadable
 	}
 
 	isEncrypted := isDataEncrypted(contents)
@@ -189,11 +200,7 @@ func (a *ApplicationConfig) tryLoad(k KeySupplier) error {
 		return errorEncryptionBadFile
 	}
 
-	if err = json.Unmarshal(contents, a); err != nil {
-		return errInvalidConfigFile
-	}
-
-	return nil
+	return a.deserialize(contents)
 }
 
 // Save will save the application configuration
@@ -217,6 +224,7 @@ func (a *ApplicationConfig) Save(k KeySupplier) error {
 		return err
 	}
 
+	var upgrade *keyUpgrade
 	if a.ShouldEncrypt() {
 		if a.encryptionParams == nil {
 			p := newEncryptionParameters()
@@ -226,18 +234,29 @@ func (a *ApplicationConfig) Save(k KeySupplier) error {
 			a.encryptionParams.regenerateNonce()
 		}
 
-		contents, err = encryptConfigContent(string(contents), a.encryptionParams, k)
+		contents, upgrade, err = encryptConfigContentUpgrading(string(contents), a.encryptionParams, k)
 		if err != nil {
 			return err
 		}
 	}
 
-	return SafeWrite(a.filename, contents, 0600)
+	err = SafeWrite(a.filename, contents, 0600)
+	if err != nil {
+		return err
+	}
+
+	// From now on, the keys for the upgraded parameters are used
+	if upgrade != nil {
+		a.encryptionParams = &upgrade.params
+		return k.CacheFromResult(upgrade.result)
+	}
+
+	return nil
 }
 
 // EnsureDestination check the destination for copying the configuration file
 func (a *ApplicationConfig) EnsureDestination() {
-	dir := Dir()
+	dir := a.dir()
 	EnsureDir(dir, 0700)
 
 	if len(a.filename) == 0 {
@@ -296,12 +315,6 @@ func (a *ApplicationConfig) doAfterSave(f func()) {
 	a.afterSave = append(a.afterSave, f)
 }
 
-//TODO: This is where we generate a new JSON representation and serialize it.
-//We are currently serializing our internal representation (ApplicationConfig) directly.
-func (a *ApplicationConfig) serialize() ([]byte, error) {
-	return json.MarshalIndent(a, "", "\t")
-}
-
 // GetAutoJoin returns the setting value to autojoin
 func (a *ApplicationConfig) GetAutoJoin() bool {
 	return a.AutoJoin
@@ -322,6 +335,69 @@ func (a *ApplicationConfig) SetAutoJoinSuperUser(v bool) {
 	a.AsSuperUser = v
 }
 
+// GetWaitingRoom returns the setting value to make participants wait in a lobby
+func (a *ApplicationConfig) GetWaitingRoom() bool {
+	return a.WaitingRoom
+}
+
+// SetWaitingRoom sets the specified value to make participants wait in a lobby
+func (a *ApplicationConfig) SetWaitingRoom(v bool) {
+	a.WaitingRoom = v
+}
+
+// GetWebSocket returns the setting value to let participants join from a browser
+func (a *ApplicationConfig) GetWebSocket() bool {
+	return a.WebSocket
+}
+
+// SetWebSocket sets the specified value to let participants join from a browser
+func (a *ApplicationConfig) SetWebSocket(v bool) {
+	a.WebSocket = v
+}
+
+// GetEventFeed returns the setting value to publish the events of hosted meetings
+func (a *ApplicationConfig) GetEventFeed() bool {
+	return a.EventFeed
+}
+
+// SetEventFeed sets the specified value to publish the events of hosted meetings
+func (a *ApplicationConfig) SetEventFeed(v bool) {
+	a.EventFeed = v
+}
+
+// GetPersistentIdentity returns the setting value to use the same client certificate in every meeting
+func (a *ApplicationConfig) GetPersistentIdentity() bool {
+	return a.PersistentIdentity
+}
+
+// SetPersistentIdentity sets the specified value to use the same client certificate in every meeting
+func (a *ApplicationConfig) SetPersistentIdentity(v bool) {
+	a.PersistentIdentity = v
+}
+
+// GetIdentityCertificate returns the client certificate and private key, in PEM format,
+// that identify the participant when the persistent identity is enabled
+func (a *ApplicationConfig) GetIdentityCertificate() string {
+	return a.IdentityCertificate
+}
+
+// SetIdentityCertificate sets the client certificate and private key, in PEM format
+func (a *ApplicationConfig) SetIdentityCertificate(v string) {
+	a.IdentityCertificate = v
+}
+
+// GetStrictCertificates returns the setting value to not join the meetings
+// whose certificate can't be fetched or doesn't match the one of their server
+func (a *ApplicationConfig) GetStrictCertificates() bool {
+	return a.StrictCertificates
+}
+
+// SetStrictCertificates sets the specified value to not join the meetings
+// whose certificate can't be fetched or doesn't match the one of their server
+func (a *ApplicationConfig) SetStrictCertificates(v bool) {
+	a.StrictCertificates = v
+}
+
 // IsPersistentConfiguration returns the setting value to persist the configuration file in the device
 func (a *ApplicationConfig) IsPersistentConfiguration() bool {
 	return a.persistentMode
@@ -412,11 +488,6 @@ func (a *ApplicationConfig) GetPortMumble() string {
 	return a.PortMumble
 }
 
-// GetDefaultLogFile returns the default path for the log file
-func GetDefaultLogFile() string {
-	return filepath.Join(Dir(), GetDefaultLogFileName())
-}
-
 // GetDefaultLogFileName returns the default filename for the log file
 func GetDefaultLogFileName() string {
 	return appLogFile
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/scrypt"
 )
 
@@ -20,6 +21,19 @@ type EncryptionResult struct {
 	key   []byte
 	mac   []byte
 	valid bool
+
+	// upgrade has the keys for stronger parameters, when the ones
+	// used for the result are weaker than the current defaults
+	upgrade *keyUpgrade
+}
+
+// keyUpgrade keeps the keys derived from the same password with new
+// parameters, so the file can be encrypted with them on the next save
+// without keeping the password around. The keys for the old parameters
+// are still used until that save, see encryptConfigContentUpgrading
+type keyUpgrade struct {
+	params EncryptionParameters
+	result EncryptionResult
 }
 
 func (r *EncryptionResult) isValid() bool {
@@ -58,6 +72,7 @@ type keySupplierWrap struct {
 	sync.Mutex
 	haveKeys          bool
 	key, mac          []byte
+	upgrade           *keyUpgrade
 	getKeys           func(p EncryptionParameters, lastAttemptFailed bool) EncryptionResult
 	lastAttemptFailed bool
 }
@@ -83,12 +98,14 @@ func (k *keySupplierWrap) GenerateKey(p EncryptionParameters) EncryptionResult {
 
 		k.setKey(r.getKey())
 		k.setMacKey(r.getMacKey())
+		k.upgrade = r.upgrade
 		k.haveKeys = true
 	}
 
 	result.setKey(k.getKey())
 	result.setMacKey(k.getMacKey())
 	result.setValid(true)
+	result.upgrade = k.upgrade
 
 	return result
 }
@@ -100,6 +117,7 @@ func (k *keySupplierWrap) Invalidate() {
 	k.haveKeys = false
 	k.key = []byte{}
 	k.mac = []byte{}
+	k.upgrade = nil
 }
 
 func (k *keySupplierWrap) LastAttemptFailed() {
@@ -113,6 +131,7 @@ func (k *keySupplierWrap) CacheFromResult(r EncryptionResult) error {
 
 	k.setKey(r.getKey())
 	k.setMacKey(r.getMacKey())
+	k.upgrade = r.upgrade
 	k.haveKeys = true
 
 	return nil
@@ -134,14 +153,42 @@ func (k *keySupplierWrap) setMacKey(mac []byte) {
 	k.mac = mac
 }
 
-// EncryptionParameters contains the parameters used for scrypting
-// the password and encrypting the configuration file
+// Key derivation algorithms that can be used to
+// get the keys from the master password
+const (
+	// KeyDerivationScrypt uses scrypt. The files written before the
+	// algorithm was kept in the parameters use it
+	KeyDerivationScrypt = "scrypt"
+
+	// KeyDerivationArgon2id uses Argon2id
+	KeyDerivationArgon2id = "argon2id"
+)
+
+// The current parameters for each key derivation algorithm. When they
+// change, the files with weaker parameters are upgraded on the next save
+const (
+	scryptN = 262144 // 2 ** 18
+	scryptR = 8
+	scryptP = 1
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024 // 64 MiB
+	argon2Threads = 4
+)
+
+// EncryptionParameters contains the parameters used for deriving the keys
+// from the password and encrypting the configuration file. N, R and P
+// are only used by scrypt, and Time, Memory and Threads by Argon2id
 type EncryptionParameters struct {
-	Nonce string
-	Salt  string
-	N     int
-	R     int
-	P     int
+	Algorithm string `json:",omitempty"`
+	Nonce     string
+	Salt      string
+	N         int
+	R         int
+	P         int
+	Time      uint32 `json:",omitempty"`
+	Memory    uint32 `json:",omitempty"`
+	Threads   uint8  `json:",omitempty"`
 
 	// Similarly to ApplicationConfig, EncryptionParameters should
 	// be just a JSON representation of whatever we use internally
@@ -152,6 +199,7 @@ type EncryptionParameters struct {
 
 // TODO: Similarly to ApplicationConfig, this should be where we generate a new JSON representation and serialize it.
 func (p *EncryptionParameters) serialize() {
+	p.Algorithm = p.keyDerivation()
 	p.Nonce = hex.EncodeToString(p.nonceInternal)
 	p.Salt = hex.EncodeToString(p.saltInternal)
 }
@@ -179,6 +227,29 @@ func (p *EncryptionParameters) check() bool {
 	return err == nil
 }
 
+func (p *EncryptionParameters) keyDerivation() string {
+	if len(p.Algorithm) == 0 {
+		return KeyDerivationScrypt
+	}
+	return p.Algorithm
+}
+
+func isSupportedKeyDerivation(algorithm string) bool {
+	return algorithm == KeyDerivationScrypt || algorithm == KeyDerivationArgon2id
+}
+
+// isOutdated returns true if the parameters are weaker than
+// the current ones for the same key derivation algorithm
+func (p *EncryptionParameters) isOutdated() bool {
+	switch p.keyDerivation() {
+	case KeyDerivationScrypt:
+		return p.N < scryptN || p.R < scryptR || p.P < scryptP
+	case KeyDerivationArgon2id:
+		return p.Time < argon2Time || p.Memory < argon2Memory || p.Threads < argon2Threads
+	}
+	return false
+}
+
 type encryptedData struct {
 	Params EncryptionParameters
 	Data   string
@@ -203,7 +274,7 @@ func (a *ApplicationConfig) turnOnEncryption() {
 
 	if !strings.HasSuffix(a.filename, encrytptedFileExtension) {
 		a.removeOldFileOnNextSave()
-		a.filename = filepath.Join(Dir(), appEncryptedConfigFile)
+		a.filename = filepath.Join(a.dir(), appEncryptedConfigFile)
 	}
 }
 
@@ -212,17 +283,32 @@ func (a *ApplicationConfig) turnOffEncryption() {
 	defer a.ioLock.Unlock()
 
 	a.removeOldFileOnNextSave()
-	a.filename = filepath.Join(Dir(), appConfigFile)
+	a.filename = filepath.Join(a.dir(), appConfigFile)
 }
 
 // Helper function for creating a default params for encrypt the
 // configuration file
 func newEncryptionParameters() EncryptionParameters {
-	res := EncryptionParameters{
-		N: 262144, // 2 ** 18
-		R: 8,
-		P: 1,
+	return newEncryptionParametersFor(KeyDerivationScrypt)
+}
+
+// newEncryptionParametersFor returns the current parameters
+// for the given key derivation algorithm
+func newEncryptionParametersFor(algorithm string) EncryptionParameters {
+	res := EncryptionParameters{Algorithm: algorithm}
+
+	switch algorithm {
+	case KeyDerivationArgon2id:
+		res.Time = argon2Time
+		res.Memory = argon2Memory
+		res.Threads = argon2Threads
+	default:
+		res.Algorithm = KeyDerivationScrypt
+		res.N = scryptN
+		res.R = scryptR
+		res.P = scryptP
 	}
+
 	res.regenerateNonce()
 	res.saltInternal = genRand(saltLen)
 	return res
@@ -247,6 +333,38 @@ func encryptConfigContent(content string, p *EncryptionParameters, k KeySupplier
 		return nil, errors.New("invalid password, aborting")
 	}
 
+	return encryptWithKeys(content, p, r)
+}
+
+// encryptConfigContentUpgrading works like encryptConfigContent, but if the
+// keys come with an upgrade to stronger parameters, the content is encrypted
+// with the upgraded ones. The upgrade is returned, and it must only be cached
+// in the key supplier once the content has been written, since until then
+// the file still has the old parameters
+func encryptConfigContentUpgrading(content string, p *EncryptionParameters, k KeySupplier) ([]byte, *keyUpgrade, error) {
+	r := k.GenerateKey(*p)
+
+	if !r.isValid() {
+		return nil, nil, errors.New("invalid password, aborting")
+	}
+
+	if r.upgrade == nil {
+		data, err := encryptWithKeys(content, p, r)
+		return data, nil, err
+	}
+
+	u := *r.upgrade
+	u.params.regenerateNonce()
+
+	data, err := encryptWithKeys(content, &u.params, u.result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, &u, nil
+}
+
+func encryptWithKeys(content string, p *EncryptionParameters, r EncryptionResult) ([]byte, error) {
 	cipherText := encryptData(r.getKey(), r.getMacKey(), p.nonceInternal, content)
 
 	p.serialize()
@@ -264,6 +382,7 @@ var (
 	errorEncryptionBadFile       = errors.New("invalid or corrupted file")
 	errorEncryptionNoEncrypted   = errors.New("the configuration file data is not encrypted")
 	errorEncryptionNoPassword    = errors.New("no password supplied to decrypt the config file")
+	errorEncryptionBadParameters = errors.New("invalid key derivation parameters")
 )
 
 func encryptData(key, macKey, nonce []byte, plain string) []byte {
@@ -278,6 +397,10 @@ func decryptConfigContent(content []byte, k KeySupplier) ([]byte, *EncryptionPar
 		return nil, nil, errorEncryptionNoEncrypted
 	}
 
+	if !isSupportedKeyDerivation(data.Params.keyDerivation()) {
+		return nil, nil, ErrConfigFileTooNew
+	}
+
 	r := k.GenerateKey(data.Params)
 	if !r.isValid() {
 		return nil, nil, errorEncryptionNoPassword
@@ -289,8 +412,11 @@ func decryptConfigContent(content []byte, k KeySupplier) ([]byte, *EncryptionPar
 	}
 
 	res, err := decryptData(r.getKey(), r.getMacKey(), data.Params.nonceInternal, cypherText)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return res, &data.Params, err
+	return res, &data.Params, nil
 }
 
 func decryptData(key, macKey, nonce, cipherText []byte) ([]byte, error) {
@@ -333,10 +459,42 @@ func parseEncryptedData(content []byte) (d *encryptedData, err error) {
 }
 
 // GenerateKeysBasedOnPassword takes a password and encryption parameters and
-// generates an AES key and a MAC key using SCrypt
+// generates an AES key and a MAC key using the key derivation algorithm of
+// the parameters. If the parameters are weaker than the current ones, the
+// keys for the current ones are generated too, to upgrade the file
 func GenerateKeysBasedOnPassword(password string, params EncryptionParameters) EncryptionResult {
+	r := deriveKeys(password, params)
+
+	if r.isValid() && params.isOutdated() {
+		p := newEncryptionParametersFor(params.keyDerivation())
+		u := deriveKeys(password, p)
+		if u.isValid() {
+			r.upgrade = &keyUpgrade{params: p, result: u}
+		}
+	}
+
+	return r
+}
+
+func deriveKeys(password string, params EncryptionParameters) EncryptionResult {
 	r := EncryptionResult{valid: true}
-	res, err := scrypt.Key([]byte(password), params.saltInternal, params.N, params.R, params.P, aesKeyLen+macKeyLen)
+
+	var res []byte
+	var err error
+
+	switch params.keyDerivation() {
+	case KeyDerivationScrypt:
+		res, err = scrypt.Key([]byte(password), params.saltInternal, params.N, params.R, params.P, aesKeyLen+macKeyLen)
+	case KeyDerivationArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			err = errorEncryptionBadParameters
+		} else {
+			res = argon2.IDKey([]byte(password), params.saltInternal, params.Time, params.Memory, params.Threads, aesKeyLen+macKeyLen)
+		}
+	default:
+		err = errorEncryptionBadParameters
+	}
+
 	if err != nil {
 		r.valid = false
 		return r
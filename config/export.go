@@ -0,0 +1,202 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"time"
+)
+
+// The settings can be exported to a single file, to take them to another
+// computer. The file is encrypted the same way as the configuration file,
+// but with a passphrase chosen only for the export, and it has everything
+// in the configuration: the preferences, the identity and the scheduled
+// meetings with their onion keys.
+
+var (
+	// ErrWrongPassphrase is returned when the settings file can't be
+	// decrypted with the given passphrase
+	ErrWrongPassphrase = errors.New("the passphrase is not correct")
+
+	// ErrInvalidSettingsFile is returned when the file is not
+	// a settings file exported by Wahay
+	ErrInvalidSettingsFile = errors.New("the file doesn't have settings exported by Wahay")
+
+	errEmptyPassphrase = errors.New("the passphrase can't be empty")
+)
+
+// settingsExportFile is the content of an exported settings file,
+// before encrypting it. The settings are written the same way
+// as in the configuration file, so they have a version too
+type settingsExportFile struct {
+	Exported time.Time
+	Settings json.RawMessage
+}
+
+// ImportedSettings are the settings read from an exported
+// file, that haven't been imported yet
+type ImportedSettings struct {
+	exported time.Time
+	settings *configFile
+}
+
+func passphraseKeySupplier(passphrase string) KeySupplier {
+	return CreateKeySupplier(func(p EncryptionParameters, _ bool) EncryptionResult {
+		return GenerateKeysBasedOnPassword(passphrase, p)
+	})
+}
+
+// ExportSettings returns the content of a file with all the settings,
+// encrypted with the given passphrase
+func (a *ApplicationConfig) ExportSettings(passphrase string) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, errEmptyPassphrase
+	}
+
+	settings, err := a.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(&settingsExportFile{
+		Exported: time.Now(),
+		Settings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p := newEncryptionParameters()
+	return encryptConfigContent(string(content), &p, passphraseKeySupplier(passphrase))
+}
+
+// ReadSettingsExport decrypts the content of an exported settings file.
+// The settings are upgraded if they were exported by an older version of
+// Wahay, and ErrConfigFileTooNew is returned if they were exported by a
+// newer one
+func ReadSettingsExport(data []byte, passphrase string) (*ImportedSettings, error) {
+	if !isDataEncrypted(data) {
+		return nil, ErrInvalidSettingsFile
+	}
+
+	content, _, err := decryptConfigContent(data, passphraseKeySupplier(passphrase))
+	if err == ErrConfigFileTooNew {
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	f := &settingsExportFile{}
+	err = json.Unmarshal(content, f)
+	if err != nil || len(f.Settings) == 0 {
+		return nil, ErrInvalidSettingsFile
+	}
+
+	settings, err := parseConfigFile(f.Settings)
+	if err == ErrConfigFileTooNew {
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, ErrInvalidSettingsFile
+	}
+
+	return &ImportedSettings{
+		exported: f.Exported,
+		settings: settings,
+	}, nil
+}
+
+// Exported returns when the settings were exported
+func (s *ImportedSettings) Exported() time.Time {
+	return s.exported
+}
+
+// ScheduledMeetings returns the scheduled meetings in the file
+func (s *ImportedSettings) ScheduledMeetings() []*ScheduledMeeting {
+	result := []*ScheduledMeeting{}
+	for _, m := range s.settings.ScheduledMeetings {
+		result = append(result, m.meeting())
+	}
+	return result
+}
+
+// OnionKeys returns how many scheduled meetings in the file have their onion key
+func (s *ImportedSettings) OnionKeys() int {
+	n := 0
+	for _, m := range s.settings.ScheduledMeetings {
+		if len(m.OnionKey) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// HasIdentity returns true if the file has a persistent identity
+func (s *ImportedSettings) HasIdentity() bool {
+	return len(s.settings.IdentityCertificate) > 0
+}
+
+// MissingPaths returns the paths in the file that don't exist in this
+// computer. They are not imported, and the current ones are kept
+func (s *ImportedSettings) MissingPaths() []string {
+	result := []string{}
+	for _, p := range s.importablePaths() {
+		if len(p) > 0 && !pathExistsHere(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (s *ImportedSettings) importablePaths() []string {
+	return []string{
+		s.settings.PathTor,
+		s.settings.PathTorsocks,
+		s.settings.PathMumble,
+		s.settings.RawLogFile,
+	}
+}
+
+func pathExistsHere(p string) bool {
+	return FileExists(p) || FileExists(filepath.Dir(p))
+}
+
+func importedPath(current, imported string) string {
+	if len(imported) == 0 || pathExistsHere(imported) {
+		return imported
+	}
+	return current
+}
+
+// ImportSettings replaces the current settings with the imported ones.
+// The ID of this configuration is kept, the paths are only taken if they
+// exist in this computer, and the scheduled meetings are added to the
+// current ones, replacing the ones with the same ID
+func (a *ApplicationConfig) ImportSettings(s *ImportedSettings) {
+	f := s.settings
+
+	a.AsSuperUser = f.AsSuperUser
+	a.AutoJoin = f.AutoJoin
+	a.WaitingRoom = f.WaitingRoom
+	a.EventFeed = f.EventFeed
+	a.WebSocket = f.WebSocket
+	a.LogsEnabled = f.LogsEnabled
+	a.PortMumble = f.PortMumble
+	a.PersistentIdentity = f.PersistentIdentity
+	a.IdentityCertificate = f.IdentityCertificate
+	a.StrictCertificates = f.StrictCertificates
+	a.Audio = f.Audio.preferences()
+
+	a.PathTor = importedPath(a.PathTor, f.PathTor)
+	a.PathTorsocks = importedPath(a.PathTorsocks, f.PathTorsocks)
+	a.PathMumble = importedPath(a.PathMumble, f.PathMumble)
+	a.RawLogFile = importedPath(a.RawLogFile, f.RawLogFile)
+
+	for _, m := range s.ScheduledMeetings() {
+		a.RemoveScheduledMeeting(m.ID)
+		a.AddScheduledMeeting(m)
+	}
+}
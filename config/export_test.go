@@ -0,0 +1,74 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConfigExportSuite struct{}
+
+var _ = Suite(&ConfigExportSuite{})
+
+func (s *ConfigExportSuite) Test_ExportSettings_canBeImportedWithThePassphrase(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-export")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	tor := filepath.Join(dir, "tor")
+	c.Assert(ioutil.WriteFile(tor, []byte{}, 0700), IsNil)
+
+	a := New()
+	a.UniqueConfigurationID = "exported"
+	a.AutoJoin = true
+	a.PathTor = tor
+	a.PathMumble = "/not/in/this/computer/mumble"
+	a.IdentityCertificate = "-----BEGIN CERTIFICATE-----"
+	a.ScheduledMeetings = []*ScheduledMeeting{
+		{ID: "abc", Title: "Weekly", Start: time.Date(2020, 5, 4, 10, 0, 0, 0, time.UTC), OnionKey: "key"},
+		{ID: "def", Title: "Monthly"},
+	}
+
+	data, err := a.ExportSettings("a passphrase")
+	c.Assert(err, IsNil)
+
+	_, err = ReadSettingsExport(data, "another passphrase")
+	c.Assert(err, Equals, ErrWrongPassphrase)
+
+	imported, err := ReadSettingsExport(data, "a passphrase")
+	c.Assert(err, IsNil)
+	c.Assert(imported.ScheduledMeetings(), HasLen, 2)
+	c.Assert(imported.OnionKeys(), Equals, 1)
+	c.Assert(imported.HasIdentity(), Equals, true)
+	c.Assert(imported.MissingPaths(), DeepEquals, []string{"/not/in/this/computer/mumble"})
+
+	b := New()
+	b.UniqueConfigurationID = "local"
+	b.PathMumble = "/usr/bin/mumble"
+	b.ScheduledMeetings = []*ScheduledMeeting{
+		{ID: "abc", Title: "Old title"},
+		{ID: "ghi", Title: "Local"},
+	}
+
+	b.ImportSettings(imported)
+	c.Assert(b.UniqueConfigurationID, Equals, "local")
+	c.Assert(b.AutoJoin, Equals, true)
+	c.Assert(b.PathTor, Equals, tor)
+	c.Assert(b.PathMumble, Equals, "/usr/bin/mumble")
+	c.Assert(b.IdentityCertificate, Equals, "-----BEGIN CERTIFICATE-----")
+	c.Assert(b.ScheduledMeetings, HasLen, 3)
+	c.Assert(b.GetScheduledMeeting("abc").Title, Equals, "Weekly")
+	c.Assert(b.GetScheduledMeeting("abc").OnionKey, Equals, "key")
+	c.Assert(b.GetScheduledMeeting("ghi").Title, Equals, "Local")
+}
+
+func (s *ConfigExportSuite) Test_ReadSettingsExport_rejectsOtherFiles(c *C) {
+	_, err := ReadSettingsExport([]byte(`{"AutoJoin": true}`), "a passphrase")
+	c.Assert(err, Equals, ErrInvalidSettingsFile)
+
+	_, err = New().ExportSettings("")
+	c.Assert(err, Equals, errEmptyPassphrase)
+}
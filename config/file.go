@@ -1,9 +1,11 @@
 package config
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"syscall"
 )
 
 const (
@@ -44,6 +46,30 @@ func EnsureDir(dirname string, perm os.FileMode) {
 	}
 }
 
+// ErrNotPrivateDir is returned when a directory that should
+// only be usable by the current user is not
+var ErrNotPrivateDir = errors.New("the directory is not private to the current user")
+
+// EnsurePrivateDir creates a directory if not exists, and makes sure that
+// it's a real directory, owned by the current user and not accessible by
+// anybody else. It's meant for directories in shared places, like the
+// temporary directory, where somebody else could have created it first
+func EnsurePrivateDir(dirname string) error {
+	EnsureDir(dirname, 0700)
+
+	fi, err := os.Lstat(dirname)
+	if err != nil {
+		return err
+	}
+
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !fi.IsDir() || !ok || int(st.Uid) != os.Getuid() || fi.Mode().Perm()&0077 != 0 {
+		return ErrNotPrivateDir
+	}
+
+	return nil
+}
+
 const tmpExtension = ".000~"
 
 // SafeWrite is a helper function to write content on specific file
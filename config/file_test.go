@@ -0,0 +1,32 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConfigFileSuite struct{}
+
+var _ = Suite(&ConfigFileSuite{})
+
+func (s *ConfigFileSuite) Test_EnsurePrivateDir_onlyAcceptsDirectoriesOfTheCurrentUser(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-private")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	private := filepath.Join(dir, "private")
+	c.Assert(EnsurePrivateDir(private), IsNil)
+	c.Assert(EnsurePrivateDir(private), IsNil)
+
+	shared := filepath.Join(dir, "shared")
+	c.Assert(os.Mkdir(shared, 0777), IsNil)
+	c.Assert(os.Chmod(shared, 0777), IsNil)
+	c.Assert(EnsurePrivateDir(shared), Equals, ErrNotPrivateDir)
+
+	link := filepath.Join(dir, "link")
+	c.Assert(os.Symlink(private, link), IsNil)
+	c.Assert(EnsurePrivateDir(link), Equals, ErrNotPrivateDir)
+}
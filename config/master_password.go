@@ -0,0 +1,102 @@
+package config
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+var (
+	// ErrWrongMasterPassword is returned when the current
+	// master password given is not the right one
+	ErrWrongMasterPassword = errors.New("the current master password is not correct")
+
+	errMasterPasswordNotEncrypted = errors.New("the configuration file is not encrypted")
+	errUnknownKeyDerivation       = errors.New("unknown key derivation algorithm")
+)
+
+// CanChangeMasterPassword returns true if the configuration
+// file is encrypted with a master password that can be changed
+func (a *ApplicationConfig) CanChangeMasterPassword() bool {
+	return a.IsPersistentConfiguration() && a.ShouldEncrypt() && a.encryptionParams != nil
+}
+
+// KeyDerivation returns the algorithm used to derive
+// the keys from the master password
+func (a *ApplicationConfig) KeyDerivation() string {
+	if a.encryptionParams == nil {
+		return KeyDerivationScrypt
+	}
+	return a.encryptionParams.keyDerivation()
+}
+
+// ChangeMasterPassword encrypts the configuration file with a new master
+// password, deriving the keys with the given algorithm. The file is
+// replaced only after the new one has been written, and then the key
+// supplier keeps the new keys for the rest of the session
+func (a *ApplicationConfig) ChangeMasterPassword(current, password, algorithm string, k KeySupplier) error {
+	if !a.CanChangeMasterPassword() {
+		return errMasterPasswordNotEncrypted
+	}
+
+	if !isSupportedKeyDerivation(algorithm) {
+		return errUnknownKeyDerivation
+	}
+
+	if len(password) == 0 {
+		return errorEncryptionNoPassword
+	}
+
+	a.ioLock.Lock()
+	defer a.ioLock.Unlock()
+
+	if !a.isMasterPassword(current, k) {
+		return ErrWrongMasterPassword
+	}
+
+	p := newEncryptionParametersFor(algorithm)
+	r := deriveKeys(password, p)
+	if !r.isValid() {
+		return errorEncryptionBadParameters
+	}
+
+	a.onBeforeSave()
+	defer a.onAfterSave()
+
+	a.EnsureDestination()
+
+	contents, err := a.serialize()
+	if err != nil {
+		return err
+	}
+
+	newKeys := CreateKeySupplier(func(EncryptionParameters, bool) EncryptionResult {
+		return r
+	})
+
+	contents, err = encryptConfigContent(string(contents), &p, newKeys)
+	if err != nil {
+		return err
+	}
+
+	err = SafeWrite(a.filename, contents, 0600)
+	if err != nil {
+		return err
+	}
+
+	a.encryptionParams = &p
+
+	return k.CacheFromResult(r)
+}
+
+// isMasterPassword returns true if the keys derived from the password
+// are the ones the configuration file is encrypted with
+func (a *ApplicationConfig) isMasterPassword(password string, k KeySupplier) bool {
+	current := k.GenerateKey(*a.encryptionParams)
+	if !current.isValid() {
+		return false
+	}
+
+	r := deriveKeys(password, *a.encryptionParams)
+
+	return r.isValid() && subtle.ConstantTimeCompare(r.getKey(), current.getKey()) == 1
+}
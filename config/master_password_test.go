@@ -0,0 +1,109 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConfigMasterPasswordSuite struct{}
+
+var _ = Suite(&ConfigMasterPasswordSuite{})
+
+func (s *ConfigMasterPasswordSuite) Test_encryptConfigContentUpgrading_upgradesWeakParameters(c *C) {
+	weak := newEncryptionParameters()
+	weak.N = 1024
+	weak.Algorithm = ""
+
+	k := passphraseKeySupplier("secret")
+	old, err := encryptConfigContent(`{"AutoJoin": true}`, &weak, k)
+	c.Assert(err, IsNil)
+
+	k = passphraseKeySupplier("secret")
+	content, p, err := decryptConfigContent(old, k)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, `{"AutoJoin": true}`)
+	c.Assert(p.N, Equals, 1024)
+
+	// Until the upgraded file is written, the old one can be read again
+	_, _, err = decryptConfigContent(old, k)
+	c.Assert(err, IsNil)
+
+	data, upgrade, err := encryptConfigContentUpgrading(string(content), p, k)
+	c.Assert(err, IsNil)
+	c.Assert(upgrade, NotNil)
+	c.Assert(upgrade.params.keyDerivation(), Equals, KeyDerivationScrypt)
+	c.Assert(upgrade.params.N, Equals, scryptN)
+	c.Assert(upgrade.params.isOutdated(), Equals, false)
+	c.Assert(string(data), Matches, `(?s).*"Algorithm": "scrypt".*`)
+
+	_, _, err = decryptConfigContent(old, k)
+	c.Assert(err, IsNil)
+
+	// Once it's written, the upgraded keys are the ones used
+	c.Assert(k.CacheFromResult(upgrade.result), IsNil)
+
+	content, _, err = decryptConfigContent(data, k)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, `{"AutoJoin": true}`)
+
+	content, _, err = decryptConfigContent(data, passphraseKeySupplier("secret"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, `{"AutoJoin": true}`)
+
+	_, upgrade, err = encryptConfigContentUpgrading(string(content), &upgrade.params, k)
+	c.Assert(err, IsNil)
+	c.Assert(upgrade, IsNil)
+}
+
+func (s *ConfigMasterPasswordSuite) Test_decryptConfigContent_refusesUnknownAlgorithms(c *C) {
+	p := newEncryptionParametersFor(KeyDerivationArgon2id)
+	p.Algorithm = "balloon"
+
+	k := CreateKeySupplier(func(EncryptionParameters, bool) EncryptionResult {
+		return GenerateKeysBasedOnPassword("secret", newEncryptionParameters())
+	})
+	data, err := encryptConfigContent("{}", &p, k)
+	c.Assert(err, IsNil)
+
+	_, _, err = decryptConfigContent(data, passphraseKeySupplier("secret"))
+	c.Assert(err, Equals, ErrConfigFileTooNew)
+}
+
+func (s *ConfigMasterPasswordSuite) Test_ChangeMasterPassword_reencryptsTheFile(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-config")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	orig := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", orig)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	a := New()
+	a.SetPersistentConfiguration(true)
+	a.SetShouldEncrypt(true)
+	a.AutoJoin = true
+
+	k := passphraseKeySupplier("old password")
+	c.Assert(a.Save(k), IsNil)
+	c.Assert(a.KeyDerivation(), Equals, KeyDerivationScrypt)
+
+	err = a.ChangeMasterPassword("wrong password", "new password", KeyDerivationArgon2id, k)
+	c.Assert(err, Equals, ErrWrongMasterPassword)
+
+	err = a.ChangeMasterPassword("old password", "new password", KeyDerivationArgon2id, k)
+	c.Assert(err, IsNil)
+	c.Assert(a.KeyDerivation(), Equals, KeyDerivationArgon2id)
+
+	b := New()
+	b.SetShouldEncrypt(true)
+	c.Assert(b.loadFromFile(a.filename, passphraseKeySupplier("old password")), Equals, errorEncryptionDecryptFailed)
+	c.Assert(b.loadFromFile(a.filename, passphraseKeySupplier("new password")), IsNil)
+	c.Assert(b.AutoJoin, Equals, true)
+	c.Assert(b.KeyDerivation(), Equals, KeyDerivationArgon2id)
+
+	// The session keeps working with the new password
+	c.Assert(a.Save(k), IsNil)
+	c.Assert(b.loadFromFile(a.filename, passphraseKeySupplier("new password")), IsNil)
+}
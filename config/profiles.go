@@ -0,0 +1,129 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// Profiles keep apart the settings of the different uses of Wahay, so the
+// names, the identities and the scheduled meetings of one of them are never
+// seen from another one. Every profile has its own directory inside the
+// profiles directory, with its own configuration file, that can be encrypted
+// with its own master password, and its own log file. The default profile
+// has no name, and it's kept in Dir() as it was before profiles existed.
+
+const (
+	profilesDirName       = "profiles"
+	maxProfileNameLength  = 64
+	defaultProfileDirPerm = 0700
+)
+
+var (
+	// ErrInvalidProfileName is returned when the name of a profile
+	// can't be used as the name of its directory
+	ErrInvalidProfileName = errors.New("the name of a profile can only have letters, numbers, spaces, dots, dashes and underscores, and it can't start with a dot or a space")
+
+	// ErrProfileExists is returned when creating a profile that already exists
+	ErrProfileExists = errors.New("the profile already exists")
+
+	validProfileName = regexp.MustCompile(`^[\p{L}\p{N}_-][\p{L}\p{N} ._-]*$`)
+)
+
+// ValidateProfileName returns ErrInvalidProfileName
+// if the name can't be used for a profile
+func ValidateProfileName(name string) error {
+	if len(name) > maxProfileNameLength || !validProfileName.MatchString(name) {
+		return ErrInvalidProfileName
+	}
+	return nil
+}
+
+// ProfilesDir returns the directory where the named profiles are kept
+func ProfilesDir() string {
+	return filepath.Join(Dir(), profilesDirName)
+}
+
+// ProfileDir returns the directory of the profile with the given
+// name. The directory of the default profile is Dir()
+func ProfileDir(name string) string {
+	if len(name) == 0 {
+		return Dir()
+	}
+	return filepath.Join(ProfilesDir(), name)
+}
+
+// ListProfiles returns the names of the profiles, sorted. The default
+// profile is not included, because it has no name
+func ListProfiles() []string {
+	entries, err := ioutil.ReadDir(ProfilesDir())
+	if err != nil {
+		return nil
+	}
+
+	result := []string{}
+	for _, e := range entries {
+		if e.IsDir() && ValidateProfileName(e.Name()) == nil {
+			result = append(result, e.Name())
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// CreateProfile creates a new profile without any settings. They
+// are asked for the first time Wahay starts with the profile
+func CreateProfile(name string) error {
+	err := ValidateProfileName(name)
+	if err != nil {
+		return err
+	}
+
+	dir := ProfileDir(name)
+	if FileExists(dir) {
+		return ErrProfileExists
+	}
+
+	EnsureDir(dir, defaultProfileDirPerm)
+	if !FileExists(dir) {
+		return errors.New("the directory of the profile can't be created")
+	}
+
+	return nil
+}
+
+// SetProfile sets the profile whose configuration file is used, and
+// creates it if it doesn't exist. It has to be called before
+// DetectPersistence. The empty name is the default profile
+func (a *ApplicationConfig) SetProfile(name string) error {
+	if len(name) > 0 {
+		err := CreateProfile(name)
+		if err != nil && err != ErrProfileExists {
+			return err
+		}
+	}
+
+	a.profile = name
+
+	return nil
+}
+
+// GetProfile returns the name of the profile in use, that
+// is empty for the default profile
+func (a *ApplicationConfig) GetProfile() string {
+	return a.profile
+}
+
+// dir returns the directory of the profile in use
+func (a *ApplicationConfig) dir() string {
+	return ProfileDir(a.profile)
+}
+
+// GetDefaultLogFile returns the default path for the log file of the profile in use
+func (a *ApplicationConfig) GetDefaultLogFile() string {
+	return filepath.Join(a.dir(), GetDefaultLogFileName())
+}
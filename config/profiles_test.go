@@ -0,0 +1,65 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+type ConfigProfilesSuite struct{}
+
+var _ = Suite(&ConfigProfilesSuite{})
+
+func (s *ConfigProfilesSuite) Test_ValidateProfileName_onlyAcceptsNamesForDirectories(c *C) {
+	c.Assert(ValidateProfileName("work"), IsNil)
+	c.Assert(ValidateProfileName("Activist group_2.0"), IsNil)
+	c.Assert(ValidateProfileName("trabajo-ñandú"), IsNil)
+
+	c.Assert(ValidateProfileName(""), Equals, ErrInvalidProfileName)
+	c.Assert(ValidateProfileName(".hidden"), Equals, ErrInvalidProfileName)
+	c.Assert(ValidateProfileName(" work"), Equals, ErrInvalidProfileName)
+	c.Assert(ValidateProfileName(".."), Equals, ErrInvalidProfileName)
+	c.Assert(ValidateProfileName("../work"), Equals, ErrInvalidProfileName)
+	c.Assert(ValidateProfileName("a/b"), Equals, ErrInvalidProfileName)
+}
+
+func (s *ConfigProfilesSuite) Test_profiles_haveTheirOwnConfigurationFile(c *C) {
+	dir, err := ioutil.TempDir("", "wahay-profiles")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	orig := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", orig)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	c.Assert(ListProfiles(), HasLen, 0)
+	c.Assert(CreateProfile("work"), IsNil)
+	c.Assert(CreateProfile("activism"), IsNil)
+	c.Assert(CreateProfile("work"), Equals, ErrProfileExists)
+	c.Assert(ListProfiles(), DeepEquals, []string{"activism", "work"})
+
+	a := New()
+	c.Assert(a.SetProfile("../work"), Equals, ErrInvalidProfileName)
+	c.Assert(a.SetProfile("work"), IsNil)
+	a.SetPersistentConfiguration(true)
+	a.AutoJoin = true
+	c.Assert(a.Save(nil), IsNil)
+	c.Assert(a.filename, Equals, filepath.Join(dir, "wahay", "profiles", "work", "config.json"))
+
+	other := New()
+	c.Assert(other.SetProfile("activism"), IsNil)
+	filename, _ := other.DetectPersistence()
+	c.Assert(filename, Equals, "")
+	c.Assert(other.IsPersistentConfiguration(), Equals, false)
+
+	work := New()
+	c.Assert(work.SetProfile("work"), IsNil)
+	filename, _ = work.DetectPersistence()
+	c.Assert(filename, Equals, a.filename)
+	c.Assert(work.GetDefaultLogFile(), Equals, filepath.Join(dir, "wahay", "profiles", "work", "application.log"))
+
+	c.Assert(New().SetProfile("school"), IsNil)
+	c.Assert(ListProfiles(), DeepEquals, []string{"activism", "school", "work"})
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduledMeeting is a meeting that the host has announced
+// before starting it. The onion key is kept so that the
+// meeting uses the same address that was sent to the participants
+type ScheduledMeeting struct {
+	ID       string
+	Title    string
+	Start    time.Time
+	Duration time.Duration
+	OnionKey string
+	Port     string
+	Password string
+}
+
+// End returns the time when the meeting is expected to finish
+func (m *ScheduledMeeting) End() time.Time {
+	return m.Start.Add(m.Duration)
+}
+
+// GetScheduledMeetings returns the scheduled meetings, sorted by their start time
+func (a *ApplicationConfig) GetScheduledMeetings() []*ScheduledMeeting {
+	result := make([]*ScheduledMeeting, len(a.ScheduledMeetings))
+	copy(result, a.ScheduledMeetings)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result
+}
+
+// GetScheduledMeeting returns the scheduled meeting with the given ID, or nil
+func (a *ApplicationConfig) GetScheduledMeeting(id string) *ScheduledMeeting {
+	for _, m := range a.ScheduledMeetings {
+		if m.ID == id {
+			return m
+		}
+	}
+	return nil
+}
+
+// AddScheduledMeeting adds a new scheduled meeting
+func (a *ApplicationConfig) AddScheduledMeeting(m *ScheduledMeeting) {
+	if len(m.ID) == 0 {
+		id := [16]byte{}
+		_ = RandomString(id[:])
+		m.ID = string(id[:])
+	}
+	a.ScheduledMeetings = append(a.ScheduledMeetings, m)
+}
+
+// RemoveScheduledMeeting removes the scheduled meeting with the given ID
+func (a *ApplicationConfig) RemoveScheduledMeeting(id string) {
+	for i, m := range a.ScheduledMeetings {
+		if m.ID == id {
+			a.ScheduledMeetings = append(a.ScheduledMeetings[:i], a.ScheduledMeetings[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The configuration file has its own representation, apart from
+// ApplicationConfig, so the internal structures can change without
+// breaking the files that are already written. Every file says which
+// version of the schema it follows. When the schema changes, the
+// version goes up and a migration is added to configMigrations, so
+// the files written by older versions of Wahay are upgraded on load.
+
+// configVersion is the version of the schema that this version of Wahay writes
+const configVersion = 1
+
+var (
+	errConfigFileCorrupted = errors.New("the configuration file is corrupted")
+
+	// ErrConfigFileTooNew is returned when loading a configuration file
+	// written by a newer version of Wahay, that this one can't read
+	ErrConfigFileTooNew = errors.New("the configuration file was written by a newer version of Wahay")
+)
+
+// configMigration upgrades the raw content of a configuration
+// file from one version of the schema to the next one
+type configMigration func(map[string]interface{}) error
+
+// configMigrations has in each position the migration
+// that upgrades a file from that version to the next one
+var configMigrations = []configMigration{
+	migrateConfigFromUnversioned,
+}
+
+// migrateConfigFromUnversioned upgrades the files written before the schema had
+// a version. They are the internal configuration written as it was, and the
+// first version of the schema kept the same fields, so nothing has to change
+func migrateConfigFromUnversioned(map[string]interface{}) error {
+	return nil
+}
+
+// configFile is the content of the configuration file
+type configFile struct {
+	Version               int
+	UniqueConfigurationID string
+	AsSuperUser           bool
+	AutoJoin              bool
+	WaitingRoom           bool
+	EventFeed             bool
+	WebSocket             bool
+	PathTor               string
+	PathTorsocks          string
+	LogsEnabled           bool
+	RawLogFile            string
+	PathMumble            string
+	PortMumble            string
+	PersistentIdentity    bool
+	IdentityCertificate   string
+	StrictCertificates    bool
+	Audio                 audioPreferencesFile
+	ScheduledMeetings     []scheduledMeetingFile
+}
+
+type audioPreferencesFile struct {
+	Backend        string
+	InputDevice    string
+	OutputDevice   string
+	Transmit       string
+	VoiceThreshold int
+	PushToTalkKey  string
+	Quality        int
+}
+
+type scheduledMeetingFile struct {
+	ID       string
+	Title    string
+	Start    time.Time
+	Duration time.Duration
+	OnionKey string
+	Port     string
+	Password string
+}
+
+func newConfigFile(a *ApplicationConfig) *configFile {
+	f := &configFile{
+		Version:               configVersion,
+		UniqueConfigurationID: a.UniqueConfigurationID,
+		AsSuperUser:           a.AsSuperUser,
+		AutoJoin:              a.AutoJoin,
+		WaitingRoom:           a.WaitingRoom,
+		EventFeed:             a.EventFeed,
+		WebSocket:             a.WebSocket,
+		PathTor:               a.PathTor,
+		PathTorsocks:          a.PathTorsocks,
+		LogsEnabled:           a.LogsEnabled,
+		RawLogFile:            a.RawLogFile,
+		PathMumble:            a.PathMumble,
+		PortMumble:            a.PortMumble,
+		PersistentIdentity:    a.PersistentIdentity,
+		IdentityCertificate:   a.IdentityCertificate,
+		StrictCertificates:    a.StrictCertificates,
+		Audio:                 newAudioPreferencesFile(a.Audio),
+	}
+
+	for _, m := range a.ScheduledMeetings {
+		f.ScheduledMeetings = append(f.ScheduledMeetings, newScheduledMeetingFile(m))
+	}
+
+	return f
+}
+
+// The nested types are copied field by field, so changing the
+// types of the application never changes the file by accident
+
+func newAudioPreferencesFile(p AudioPreferences) audioPreferencesFile {
+	return audioPreferencesFile{
+		Backend:        p.Backend,
+		InputDevice:    p.InputDevice,
+		OutputDevice:   p.OutputDevice,
+		Transmit:       p.Transmit,
+		VoiceThreshold: p.VoiceThreshold,
+		PushToTalkKey:  p.PushToTalkKey,
+		Quality:        p.Quality,
+	}
+}
+
+func (f audioPreferencesFile) preferences() AudioPreferences {
+	return AudioPreferences{
+		Backend:        f.Backend,
+		InputDevice:    f.InputDevice,
+		OutputDevice:   f.OutputDevice,
+		Transmit:       f.Transmit,
+		VoiceThreshold: f.VoiceThreshold,
+		PushToTalkKey:  f.PushToTalkKey,
+		Quality:        f.Quality,
+	}
+}
+
+func newScheduledMeetingFile(m *ScheduledMeeting) scheduledMeetingFile {
+	return scheduledMeetingFile{
+		ID:       m.ID,
+		Title:    m.Title,
+		Start:    m.Start,
+		Duration: m.Duration,
+		OnionKey: m.OnionKey,
+		Port:     m.Port,
+		Password: m.Password,
+	}
+}
+
+func (f scheduledMeetingFile) meeting() *ScheduledMeeting {
+	return &ScheduledMeeting{
+		ID:       f.ID,
+		Title:    f.Title,
+		Start:    f.Start,
+		Duration: f.Duration,
+		OnionKey: f.OnionKey,
+		Port:     f.Port,
+		Password: f.Password,
+	}
+}
+
+func (f *configFile) applyTo(a *ApplicationConfig) {
+	a.UniqueConfigurationID = f.UniqueConfigurationID
+	a.AsSuperUser = f.AsSuperUser
+	a.AutoJoin = f.AutoJoin
+	a.WaitingRoom = f.WaitingRoom
+	a.EventFeed = f.EventFeed
+	a.WebSocket = f.WebSocket
+	a.PathTor = f.PathTor
+	a.PathTorsocks = f.PathTorsocks
+	a.LogsEnabled = f.LogsEnabled
+	a.RawLogFile = f.RawLogFile
+	a.PathMumble = f.PathMumble
+	a.PortMumble = f.PortMumble
+	a.PersistentIdentity = f.PersistentIdentity
+	a.IdentityCertificate = f.IdentityCertificate
+	a.StrictCertificates = f.StrictCertificates
+	a.Audio = f.Audio.preferences()
+
+	a.ScheduledMeetings = nil
+	for _, m := range f.ScheduledMeetings {
+		a.ScheduledMeetings = append(a.ScheduledMeetings, m.meeting())
+	}
+}
+
+// parseConfigFile reads the content of a configuration file
+// of any version, upgrading it to the current one
+func parseConfigFile(contents []byte) (*configFile, error) {
+	var raw map[string]interface{}
+
+	d := json.NewDecoder(bytes.NewReader(contents))
+	d.UseNumber()
+	err := d.Decode(&raw)
+	if err != nil || raw == nil {
+		return nil, errConfigFileCorrupted
+	}
+
+	version, err := configVersionOf(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > configVersion {
+		return nil, ErrConfigFileTooNew
+	}
+
+	for v := version; v < configVersion; v++ {
+		err = configMigrations[v](raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: the upgrade from version %d failed: %s", errConfigFileCorrupted, v, err)
+		}
+		raw["Version"] = v + 1
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, errConfigFileCorrupted
+	}
+
+	f := &configFile{}
+	err = json.Unmarshal(data, f)
+	if err != nil {
+		return nil, errConfigFileCorrupted
+	}
+
+	return f, nil
+}
+
+// configVersionOf returns the version of the schema of the raw content
+// of a configuration file. The files without version are the version 0
+func configVersionOf(raw map[string]interface{}) (int, error) {
+	v, ok := raw["Version"]
+	if !ok {
+		return 0, nil
+	}
+
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, errConfigFileCorrupted
+	}
+
+	version, err := n.Int64()
+	if err != nil || version < 0 {
+		return 0, errConfigFileCorrupted
+	}
+
+	return int(version), nil
+}
+
+func (a *ApplicationConfig) deserialize(contents []byte) error {
+	f, err := parseConfigFile(contents)
+	if err != nil {
+		return err
+	}
+
+	f.applyTo(a)
+
+	return nil
+}
+
+func (a *ApplicationConfig) serialize() ([]byte, error) {
+	return json.MarshalIndent(newConfigFile(a), "", "\t")
+}
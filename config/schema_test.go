@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ConfigSchemaSuite struct{}
+
+var _ = Suite(&ConfigSchemaSuite{})
+
+func (s *ConfigSchemaSuite) Test_serialize_canBeReadBack(c *C) {
+	a := New()
+	a.AutoJoin = true
+	a.PathTor = "/usr/bin/tor"
+	a.StrictCertificates = true
+	a.Audio = DefaultAudioPreferences()
+	a.ScheduledMeetings = []*ScheduledMeeting{
+		{
+			ID:       "abc",
+			Title:    "Weekly",
+			Start:    time.Date(2020, 5, 4, 10, 0, 0, 0, time.UTC),
+			Duration: time.Hour,
+			OnionKey: "ED25519-V3:key",
+			Port:     "64738",
+			Password: "secret",
+		},
+	}
+
+	data, err := a.serialize()
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Matches, `(?s)\{\s*"Version": 1,.*`)
+
+	b := New()
+	c.Assert(b.deserialize(data), IsNil)
+	c.Assert(b.AutoJoin, Equals, true)
+	c.Assert(b.PathTor, Equals, "/usr/bin/tor")
+	c.Assert(b.StrictCertificates, Equals, true)
+	c.Assert(b.Audio, DeepEquals, DefaultAudioPreferences())
+	c.Assert(b.ScheduledMeetings, HasLen, 1)
+	c.Assert(*b.ScheduledMeetings[0], DeepEquals, *a.ScheduledMeetings[0])
+}
+
+func (s *ConfigSchemaSuite) Test_deserialize_upgradesFilesWithoutVersion(c *C) {
+	a := New()
+	err := a.deserialize([]byte(`{
+		"UniqueConfigurationID": "1234",
+		"AutoJoin": true,
+		"PortMumble": "64738",
+		"ScheduledMeetings": [{"ID": "abc", "Duration": 3600000000000}]
+	}`))
+
+	c.Assert(err, IsNil)
+	c.Assert(a.UniqueConfigurationID, Equals, "1234")
+	c.Assert(a.AutoJoin, Equals, true)
+	c.Assert(a.PortMumble, Equals, "64738")
+	c.Assert(a.ScheduledMeetings[0].Duration, Equals, time.Hour)
+}
+
+func (s *ConfigSchemaSuite) Test_deserialize_runsTheMigrationsInOrder(c *C) {
+	orig := configMigrations
+	defer func() { configMigrations = orig }()
+
+	var applied []interface{}
+	configMigrations = []configMigration{
+		func(raw map[string]interface{}) error {
+			applied = append(applied, raw["Version"])
+			raw["PathTor"] = raw["TorPath"]
+			return nil
+		},
+	}
+
+	a := New()
+	c.Assert(a.deserialize([]byte(`{"TorPath": "/opt/tor"}`)), IsNil)
+	c.Assert(applied, DeepEquals, []interface{}{nil})
+	c.Assert(a.PathTor, Equals, "/opt/tor")
+
+	configMigrations = []configMigration{
+		func(map[string]interface{}) error { return errors.New("bad file") },
+	}
+	err := a.deserialize([]byte(`{}`))
+	c.Assert(errors.Is(err, errConfigFileCorrupted), Equals, true)
+}
+
+func (s *ConfigSchemaSuite) Test_deserialize_tellsCorruptedAndNewerFilesApart(c *C) {
+	a := New()
+
+	c.Assert(a.deserialize([]byte(`{"AutoJoin": tru`)), Equals, errConfigFileCorrupted)
+	c.Assert(a.deserialize([]byte(`[1, 2]`)), Equals, errConfigFileCorrupted)
+	c.Assert(a.deserialize([]byte(`{"Version": "one"}`)), Equals, errConfigFileCorrupted)
+	c.Assert(a.deserialize([]byte(`{"Version": 1, "AutoJoin": "yes"}`)), Equals, errConfigFileCorrupted)
+
+	a.PathTor = "/usr/bin/tor"
+	c.Assert(a.deserialize([]byte(`{"Version": 2, "PathTor": "/other/tor"}`)), Equals, ErrConfigFileTooNew)
+	c.Assert(a.PathTor, Equals, "/usr/bin/tor")
+}
@@ -76,6 +76,16 @@ func XdgDataHome() string {
 	return xdgOrWithHome("XDG_DATA_HOME", ".local/share")
 }
 
+// XdgRuntimeDir returns the standardized XDG Runtime directory. Since
+// there is no sensible default for it, we use the temporary directory
+func XdgRuntimeDir() string {
+	x := os.Getenv("XDG_RUNTIME_DIR")
+	if x == "" {
+		x = os.TempDir()
+	}
+	return x
+}
+
 // XdgDataDirs returns the standardized XDG Data directory
 func XdgDataDirs() []string {
 	x := os.Getenv("XDG_DATA_DIRS")
@@ -184,19 +184,20 @@ var _escData = map[string]*_escFile{
 
 	"/config_files/wahay.desktop": {
 		local:   "config_files/wahay.desktop",
-		size:    221,
+		size:    257,
 		modtime: 1489449600,
 		compressed: `
 IyEvdXNyL2Jpbi9lbnYgeGRnLW9wZW4KW0Rlc2t0b3AgRW50cnldClR5cGU9QXBwbGljYXRpb24KVmVy
 c2lvbj0xLjAKRW5jb2Rpbmc9VVRGLTgKTmFtZT1fX05BTUVfXwpDb21tZW50PVNlY3VyZSBhbmQgRGVj
-ZW50cmFsaXplZCBDb25mZXJlbmNlIENhbGwgQXBwbGljYXRpb24KRXhlYz1fX0VYRUNfXwpJY29uPV9f
-SUNPTl9fClRlcm1pbmFsPWZhbHNlCkNhdGVnb3JpZXM9SW50ZXJuZXQ=
+ZW50cmFsaXplZCBDb25mZXJlbmNlIENhbGwgQXBwbGljYXRpb24KRXhlYz1fX0VYRUNfXyAldQpJY29u
+PV9fSUNPTl9fClRlcm1pbmFsPWZhbHNlCkNhdGVnb3JpZXM9SW50ZXJuZXQKTWltZVR5cGU9eC1zY2hl
+bWUtaGFuZGxlci93YWhheTs=
 `,
 	},
 
 	"/definitions/ConfigureMeetingWindow.xml": {
 		local:   "definitions/ConfigureMeetingWindow.xml",
-		size:    23574,
+		size:    25515,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
@@ -491,107 +492,140 @@ b2dnbGVkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
 IDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3By
 b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
 PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj40PC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHls
-ZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2luZG93LWNvbnRlbnQiLz4KICAgICAgICAgICAg
-PC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
-b3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4K
-ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibE1lc3NhZ2UiPgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRvdWJsZV9idWZmZXJlZCI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
-VGhlIG1lZXRpbmcgSUQgaGFzIGJlZW4gY29waWVkIHRvIHRoZSBjbGlwYm9hcmQ8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwt
-c3VjY2VzcyIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
-bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5U
-cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+Mjwv
-cHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hp
-bGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
-YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
-IDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
-aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
-Y3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWdu
-Ij5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
-ICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgog
-ICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ2FuY2Vs
-Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
-bGU9InllcyI+Q2FuY2VsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Nh
-bmNlbCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tbWQiLz4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNz
-IG5hbWU9ImJ0bi1pbnZpc2libGUiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAg
-ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
-ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAg
-ICAgPGNsYXNzIG5hbWU9ImFjdGlvbnMtbGVmdCIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+
-CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
-ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
-YXNzPSJHdGtCdXR0b24iIGlkPSJidG5TdGFydE1lZXRpbmciPgogICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TdGFydCBtZWV0aW5nPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
-Y3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+U3RhcnQgYSBuZXcg
-bWVldGluZyBcdTAwMjYgam9pbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-IDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fc3RhcnRfbWVldGluZyIgc3dhcHBlZD0i
-bm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxjbGFzcyBuYW1lPSJidG4tcHJpbWFyeSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxj
-bGFzcyBuYW1lPSJidG4tbWQiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0i
-YnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAg
-ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwv
+ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImNoa1dhaXRp
+bmdSb29tIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPk1ha2UgcGFydGljaXBhbnRzIHdhaXQgaW4gYSBsb2JieTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+
+UGFydGljaXBhbnRzIHdpbGwgd2FpdCBpbiBhIGxvYmJ5IHVudGlsIHlvdSBhZG1pdCB0aGVtIGludG8g
+dGhlIG1lZXRpbmcuIFRoaXMgcmVxdWlyZXMgam9pbmluZyBhcyBzdXBlciB1c2VyPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX2Noa1dh
+aXRpbmdSb29tX3RvZ2dsZWQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBp
+ZD0iY2hrV2ViU29ja2V0Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPkxldCBwYXJ0aWNpcGFudHMgam9pbiBmcm9tIFRvciBCcm93c2VyPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5QYXJ0aWNpcGFudHMgdXNpbmcgYSB3ZWIgYmFzZWQgTXVtYmxlIGNsaWVudCBp
+biBUb3IgQnJvd3NlciB3aWxsIGJlIGFibGUgdG8gam9pbiB3aXRob3V0IGluc3RhbGxpbmcgTXVtYmxl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRs
+ZXI9Im9uX2Noa1dlYlNvY2tldF90b2dnbGVkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgIDwv
 b2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
 bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNr
-X3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
-b24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ3aW5kb3ctYWN0
-aW9ucyIvPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJib3JkZXJlZCIvPgogICAgICAgICAgICA8
-L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
-b3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4K
-ICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2luZG93LWNv
+bnRlbnQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+PHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tp
+bmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+
+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIg
+aWQ9ImxibE1lc3NhZ2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRvdWJsZV9idWZm
+ZXJlZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVs
+IiB0cmFuc2xhdGFibGU9InllcyI+VGhlIG1lZXRpbmcgSUQgaGFzIGJlZW4gY29waWVkIHRvIHRoZSBj
+bGlwYm9hcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFi
+bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAg
+ICA8Y2xhc3MgbmFtZT0ibGFiZWwtc3VjY2VzcyIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAg
+ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+PC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgog
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+QnV0dG9uIiBpZD0iYnRuQ2FuY2VsIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q2FuY2VsPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWdu
+Ij5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNs
+aWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tbWQi
+Lz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1pbnZpc2libGUiLz4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxl
+PgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImFjdGlvbnMtbGVmdCIvPgogICAgICAg
+ICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5TdGFydE1lZXRpbmciPgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0i
+eWVzIj5TdGFydCBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFi
+bGU9InllcyI+U3RhcnQgYSBuZXcgbWVldGluZyBcdTAwMjYgam9pbjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fc3Rh
+cnRfbWVldGluZyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tcHJpbWFyeSIvPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tbWQiLz4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+
+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2No
+aWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxj
+bGFzcyBuYW1lPSJ3aW5kb3ctYWN0aW9ucyIvPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJib3Jk
+ZXJlZCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tp
+bmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0
+Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -1253,113 +1287,331 @@ b2JqZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 
 	"/definitions/CurrentHostMeetingWindow.xml": {
 		local:   "definitions/CurrentHostMeetingWindow.xml",
-		size:    6238,
+		size:    19317,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
 bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
-My4xMiIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a0FwcGxpY2F0aW9uV2luZG93IiBpZD0iaG9zdE1lZXRp
-bmdXaW5kb3ciPgogICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgPHByb3BlcnR5IG5hbWU9InJlc2l6YWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5
-IG5hbWU9Im1vZGFsIj5UcnVlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3dfcG9z
-aXRpb24iPm1vdXNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxv
-ZzwvcHJvcGVydHk+CiAgICA8c2lnbmFsIG5hbWU9ImRlc3Ryb3kiIGhhbmRsZXI9Im9uX2Nsb3NlX3dp
-bmRvd19zaWduYWwiIHN3YXBwZWQ9Im5vIi8+CiAgICA8Y2hpbGQgdHlwZT0idGl0bGViYXIiPgogICAg
-ICA8cGxhY2Vob2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFz
-cz0iR3RrQm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
-CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5
-IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
-c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+
-dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxUaXBQdXNoIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRpcDogUHVzaCByaWdodCBjb250cm9sIHRvIHRh
-bGs8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xh
-c3MgbmFtZT0idGV4dCIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29i
-amVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
-aWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
-biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxk
-PgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9InRvcCIvPgogICAg
-ICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
-PC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgog
+My4xMiIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a0xpc3RTdG9yZSIgaWQ9InN0YXRzU3RvcmUiPgogICAg
+PGNvbHVtbnM+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgbmFtZSAtLT4KICAgICAgPGNvbHVtbiB0eXBl
+PSJnY2hhcmFycmF5Ii8+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgcGluZyAtLT4KICAgICAgPGNvbHVt
+biB0eXBlPSJnY2hhcmFycmF5Ii8+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgbG9zcyAtLT4KICAgICAg
+PGNvbHVtbiB0eXBlPSJnY2hhcmFycmF5Ii8+CiAgICA8L2NvbHVtbnM+CiAgPC9vYmplY3Q+CiAgPG9i
+amVjdCBjbGFzcz0iR3RrQXBwbGljYXRpb25XaW5kb3ciIGlkPSJob3N0TWVldGluZ1dpbmRvdyI+CiAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkg
+bmFtZT0icmVzaXphYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ibW9kYWwi
+PlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRvd19wb3NpdGlvbiI+bW91c2U8
+L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InR5cGVfaGludCI+ZGlhbG9nPC9wcm9wZXJ0eT4K
+ICAgIDxzaWduYWwgbmFtZT0iZGVzdHJveSIgaGFuZGxlcj0ib25fY2xvc2Vfd2luZG93X3NpZ25hbCIg
+c3dhcHBlZD0ibm8iLz4KICAgIDxjaGlsZCB0eXBlPSJ0aXRsZWJhciI+CiAgICAgIDxwbGFjZWhvbGRl
+ci8+CiAgICA8L2NoaWxkPgogICAgPGNoaWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgog
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50
+YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJv
+cGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
+YWJlbCIgaWQ9ImxibFRpcFB1c2giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
+cmFuc2xhdGFibGU9InllcyI+VGlwOiBQdXNoIHJpZ2h0IGNvbnRyb2wgdG8gdGFsazwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ0ZXh0
+Ii8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
+ICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+IDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0idG9wIi8+CiAgICAgICAgICAgIDwvc3R5
+bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAg
+ICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkludml0ZU90aGVycyI+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5JbnZp
+dGUgb3RoZXJzPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2Rl
+ZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2Vk
+IiBoYW5kbGVyPSJvbl9pbnZpdGVfb3RoZXJzIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAg
+PHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLWludmlzaWJsZSIvPgogICAg
+ICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLW1kIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxl
+PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xh
+c3MgbmFtZT0iY29udGVudCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNh
+bDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj42PC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0dyaWQi
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJvd19zcGFjaW5nIj40PC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjb2x1bW5fc3BhY2luZyI+MTA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
+YWJlbCIgaWQ9ImxibFN0YXRzUGFydGljaXBhbnRzIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBhcnRpY2lwYW50czo8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVs
+IiBpZD0ibGJsU3RhdHNQYXJ0aWNpcGFudHNWYWx1ZSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ibGVmdF9hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0b3BfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9w
+YWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAg
+ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxTdGF0c1VwdGltZSI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5VcHRpbWU6PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0
+X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRv
+cF9hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibFN0YXRzVXB0aW1lVmFsdWUiPgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0i
+bGJsU3RhdHNUcmFmZmljIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJs
+YWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRyYWZmaWM6PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2giPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgog
+ICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibFN0YXRzVHJhZmZp
+Y1ZhbHVlIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWdu
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+
+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2gi
+PjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3Bh
+Y2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtTY3JvbGxlZFdpbmRvdyI+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJtaW5fY29udGVudF9oZWlnaHQiPjEwMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ic2hhZG93X3R5cGUiPmluPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXciIGlkPSJ0cmVlU3Rh
+dHMiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1vZGVsIj5zdGF0c1N0
+b3JlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQgaW50ZXJuYWwtY2hpbGQ9InNl
+bGVjdGlvbiI+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUcmVlU2VsZWN0
+aW9uIi8+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUcmVlVmlld0NvbHVtbiIg
+aWQ9ImNvbHVtblN0YXRzTmFtZSI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ0aXRsZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBhcnRpY2lwYW50PC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtDZWxsUmVuZGVyZXJUZXh0Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0
+dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQi
+PjA8L2F0dHJpYnV0ZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAg
+ICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iY29s
+dW1uU3RhdHNQaW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxl
+IiB0cmFuc2xhdGFibGU9InllcyI+UGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDZWxsUmVu
+ZGVyZXJUZXh0Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQiPjE8L2F0dHJpYnV0ZT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAg
+ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a1RyZWVWaWV3Q29sdW1uIiBpZD0iY29sdW1uU3RhdHNMb3NzIj4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9Inll
+cyI+UGFja2V0IGxvc3M8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2VsbFJlbmRlcmVyVGV4dCIv
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ0ZXh0Ij4yPC9hdHRyaWJ1dGU+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJj
+b250ZW50Ii8+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0V4cGFuZGVyIiBpZD0iZXhwQ2hhdCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52
+ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+
+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsQ2hhdFN0YXR1cyI+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Db25uZWN0aW5nIHRv
+IHRoZSBjaGF0Li4uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+d3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Inhh
+bGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAg
+ICAgICAgICAgPGNsYXNzIG5hbWU9InN0YXR1cyIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+
+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1Njcm9sbGVkV2lu
+ZG93IiBpZD0ic2NyQ2hhdCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhlaWdo
+dF9yZXF1ZXN0Ij4xNTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaHNjcm9sbGJhcl9wb2xpY3kiPm5ldmVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ic2hhZG93X3R5cGUiPmluPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUZXh0Vmll
+dyIgaWQ9InR4dENoYXQiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
+aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZWRpdGFibGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9IndyYXBfbW9kZSI+d29yZC1jaGFyPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfbWFyZ2luIj42PC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJpZ2h0X21hcmdpbiI+NjwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjdXJzb3JfdmlzaWJsZSI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCb3giPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjY8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0iZW50Q2hhdE1lc3NhZ2UiPgogICAgICAgICAgICAg
 ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5JbnZp
-dGVPdGhlcnMiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
-bGU9InllcyI+SW52aXRlIG90aGVyczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwg
-bmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25faW52aXRlX290aGVycyIgc3dhcHBlZD0ibm8iLz4KICAg
-ICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1pbnZp
-c2libGUiLz4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1tZCIvPgogICAgICAgICAg
-ICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2lu
-Zz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAg
-ICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRlbnQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZW5zaXRpdmUiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlcl90
+ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+V3JpdGUgYSBtZXNzYWdlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJhY3RpdmF0ZSIgaGFuZGxlcj0ib25fY2hhdF9zZW5k
+IiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNoYXRT
+ZW5kIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
+dGFibGU9InllcyI+U2VuZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9u
+X2NoYXRfc2VuZCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJsYWJlbCI+CiAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxDaGF0Ij4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNoYXQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAg
+ICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNoYXQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
 ICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
-b3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxk
-PgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
-X2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJob21vZ2Vu
-ZW91cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2Jq
-ZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5GaW5pc2hNZWV0aW5nIj4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkZpbmlzaDwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+MjAwPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRh
-YmxlPSJ5ZXMiPkVuZCB0aGlzIG1lZXRpbmcgZm9yIGFsbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iaW1hZ2VfcG9zaXRpb24iPmJvdHRvbTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2ZpbmlzaF9tZWV0aW5nIiBz
-d2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xh
-c3MgbmFtZT0iY29udHJvbC1maW5pc2gtY2FsbCIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAg
-ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0icGFkZGluZyI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
-ICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
-R3RrQnV0dG9uIiBpZD0iYnRuTGVhdmVNZWV0aW5nIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkxlYXZlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4yMDA8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+TGVh
-dmUgdGhpcyBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJp
-bWFnZV9wb3NpdGlvbiI+dG9wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
-Y2xpY2tlZCIgaGFuZGxlcj0ib25fbGVhdmVfbWVldGluZyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAg
-ICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtbGVhdmUt
-Y2FsbCIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFkZGluZyI+MTA8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
-IDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnV0dG9ucyIvPgogICAgICAgICAgICA8
-L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
-b3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4K
-ICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgogICAgICA8Y2xhc3MgbmFtZT0i
-bWVldGluZy1jb250cm9scyIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+PSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJv
+cGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhvbW9nZW5l
+b3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkZpbmlzaE1lZXRpbmciPgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RmluaXNoPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4yMDA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFi
+bGU9InllcyI+RW5kIHRoaXMgbWVldGluZyBmb3IgYWxsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJpbWFnZV9wb3NpdGlvbiI+Ym90dG9tPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fZmluaXNoX21lZXRpbmciIHN3
+YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFz
+cyBuYW1lPSJjb250cm9sLWZpbmlzaC1jYWxsIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwYWRkaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icGFja190eXBlIj5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCdXR0b24iIGlkPSJidG5MZWF2ZU1lZXRpbmciPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+TGVhdmU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjIwMDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5MZWF2
+ZSB0aGlzIG1lZXRpbmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imlt
+YWdlX3Bvc2l0aW9uIj50b3A8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJj
+bGlja2VkIiBoYW5kbGVyPSJvbl9sZWF2ZV9tZWV0aW5nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAg
+ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1sZWF2ZS1j
+YWxsIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWRkaW5nIj4xMDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+PHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidXR0b25zIi8+CiAgICAgICAgICAgIDwv
+c3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj40PC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgog
+ICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgICA8c3R5bGU+CiAgICAgIDxjbGFzcyBuYW1lPSJt
+ZWV0aW5nLWNvbnRyb2xzIi8+CiAgICA8L3N0eWxlPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -1668,7 +1920,7 @@ amVjdD4KPC9pbnRlcmZhY2U+Cg==
 
 	"/definitions/CurrentMeetingWindow.xml": {
 		local:   "definitions/CurrentMeetingWindow.xml",
-		size:    4851,
+		size:    13397,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
@@ -1697,61 +1949,204 @@ ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9w
 ZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
 eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
 PSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
-ICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9InRv
-cCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFj
-a2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+
-CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
-dGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlk
-PSJidG5MZWF2ZU1lZXRpbmciPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
-cmFuc2xhdGFibGU9InllcyI+TGVhdmU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9IndpZHRoX3JlcXVlc3QiPjE1MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5MZWF2ZSB0aGlzIG1lZXRp
-bmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVy
-PSJvbl9sZWF2ZV9tZWV0aW5nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgPHN0eWxlPgog
-ICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1sZWF2ZS1jYWxsIi8+CiAgICAgICAg
-ICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNr
-aW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAg
-ICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnV0dG9ucyIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAg
-ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8
-L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2Jq
-ZWN0PgogICAgPC9jaGlsZD4KICAgIDxzdHlsZT4KICAgICAgPGNsYXNzIG5hbWU9Im1lZXRpbmctY29u
-dHJvbHMiLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CiAgPG9iamVjdCBjbGFzcz0iR3RrTWVzc2Fn
-ZURpYWxvZyIgaWQ9ImxlYXZlTWVldGluZyI+CiAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYm9yZGVyX3dpZHRoIj43PC9wcm9wZXJ0
-eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJtb2RhbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0id2luZG93
-X3Bvc2l0aW9uIj5jZW50ZXItb24tcGFyZW50PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0
-eXBlX2hpbnQiPmRpYWxvZzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHJhbnNpZW50X2Zv
-ciI+Y3VycmVudE1lZXRpbmdXaW5kb3c8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImF0dGFj
-aGVkX3RvIj5jdXJyZW50TWVldGluZ1dpbmRvdzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0i
-bWVzc2FnZV90eXBlIj5xdWVzdGlvbjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iYnV0dG9u
-cyI+eWVzLW5vPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0ZXh0IiB0cmFuc2xhdGFibGU9
-InllcyI+QXJlIHlvdSBzdXJlIHlvdSB3YW50IHRvIGxlYXZlIHRoaXMgbWVldGluZz88L3Byb3BlcnR5
-PgogICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+Qnkg
-Y2xpY2tpbmcgWWVzLCB5b3Ugd2lsbCBsZWF2ZSB0aGlzIG1lZXRpbmcuPC9wcm9wZXJ0eT4KICAgIDxj
-aGlsZCBpbnRlcm5hbC1jaGlsZD0idmJveCI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxj
-aGlsZCBpbnRlcm5hbC1jaGlsZD0iYWN0aW9uX2FyZWEiPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0i
-R3RrQnV0dG9uQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
-L3Byb3BlcnR5PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
-dGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAg
-ICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
+ICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrTGFiZWwiIGlkPSJsYmxNZWV0aW5nU3RhdHVzIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNvbm5lY3RlZDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJzdGF0dXMiLz4KICAg
+ICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
+PHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxl
+PgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ0b3AiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8
+L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRXhwYW5kZXIiIGlkPSJleHBDaGF0Ij4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxDaGF0U3Rh
+dHVzIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkNvbm5lY3RpbmcgdG8gdGhlIGNoYXQuLi48L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ic3RhdHVzIi8+CiAg
+ICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
+eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrU2Nyb2xsZWRXaW5kb3ciIGlkPSJzY3JDaGF0Ij4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iaGVpZ2h0X3JlcXVlc3QiPjE1MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoc2Nyb2xsYmFyX3BvbGljeSI+bmV2ZXI8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzaGFkb3dfdHlwZSI+aW48
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a1RleHRWaWV3IiBpZD0idHh0Q2hhdCI+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJlZGl0YWJsZSI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcF9tb2RlIj53b3JkLWNo
+YXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9t
+YXJnaW4iPjY8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cmlnaHRfbWFyZ2luIj42PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImN1cnNvcl92aXNpYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8
+L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ic3BhY2luZyI+NjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJlbnRD
+aGF0TWVzc2FnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNl
+bnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5Xcml0ZSBhIG1l
+c3NhZ2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImFjdGl2
+YXRlIiBoYW5kbGVyPSJvbl9jaGF0X3NlbmQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFz
+cz0iR3RrQnV0dG9uIiBpZD0iYnRuQ2hhdFNlbmQiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TZW5kPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2Vuc2l0aXZlIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2
+ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwg
+bmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY2hhdF9zZW5kIiBzd2FwcGVkPSJubyIvPgogICAgICAg
+ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
+ICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxk
+IHR5cGU9ImxhYmVsIj4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9Imxi
+bENoYXQiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9Inll
+cyI+Q2hhdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY2hhdCIvPgog
+ICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+
+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmciPjY8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94IiBpZD0iYm94TWVldGluZ0NvbnRyb2xz
+Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj42PC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJob21vZ2VuZW91cyI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1Rv
+Z2dsZUJ1dHRvbiIgaWQ9ImJ0bk11dGUiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPk11dGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPlR1cm4geW91ciBtaWNyb3Bob25lIG9mZiBvciBvbjwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHNpZ25hbCBuYW1lPSJ0b2dnbGVkIiBoYW5kbGVyPSJvbl9tdXRlX3RvZ2dsZWQiIHN3
+YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAg
+ICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtdG9nZ2xlIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHls
+ZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVG9nZ2xlQnV0
+dG9uIiBpZD0iYnRuRGVhZmVuIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFi
+ZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5EZWFmZW48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5
+ZXMiPlN0b3Agb3Igc3RhcnQgaGVhcmluZyB0aGUgb3RoZXIgcGFydGljaXBhbnRzPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX2RlYWZl
+bl90b2dnbGVkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLXRvZ2dsZSIvPgogICAgICAgICAgICAg
+ICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkxlYXZlTWVldGluZyI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5MZWF2ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+MTUwPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRh
+YmxlPSJ5ZXMiPkxlYXZlIHRoaXMgbWVldGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2ln
+bmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2xlYXZlX21lZXRpbmciIHN3YXBwZWQ9Im5vIi8+
+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250
+cm9sLWxlYXZlLWNhbGwiLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
+b24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGls
+ZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidXR0b25zIi8+
+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5n
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAg
+ICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgogICAg
+ICA8Y2xhc3MgbmFtZT0ibWVldGluZy1jb250cm9scyIvPgogICAgPC9zdHlsZT4KICA8L29iamVjdD4K
+ICA8b2JqZWN0IGNsYXNzPSJHdGtNZXNzYWdlRGlhbG9nIiBpZD0ibGVhdmVNZWV0aW5nIj4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1l
+PSJib3JkZXJfd2lkdGgiPjc8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InJlc2l6YWJsZSI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Im1vZGFsIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3dfcG9zaXRpb24iPmNlbnRlci1vbi1wYXJlbnQ8L3Byb3Bl
+cnR5PgogICAgPHByb3BlcnR5IG5hbWU9InR5cGVfaGludCI+ZGlhbG9nPC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0cmFuc2llbnRfZm9yIj5jdXJyZW50TWVldGluZ1dpbmRvdzwvcHJvcGVydHk+
+CiAgICA8cHJvcGVydHkgbmFtZT0iYXR0YWNoZWRfdG8iPmN1cnJlbnRNZWV0aW5nV2luZG93PC9wcm9w
+ZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJtZXNzYWdlX3R5cGUiPnF1ZXN0aW9uPC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJidXR0b25zIj55ZXMtbm88L3Byb3BlcnR5PgogICAgPHByb3BlcnR5
+IG5hbWU9InRleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5BcmUgeW91IHN1cmUgeW91IHdhbnQgdG8gbGVh
+dmUgdGhpcyBtZWV0aW5nPzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ic2Vjb25kYXJ5X3Rl
+eHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5CeSBjbGlja2luZyBZZXMsIHlvdSB3aWxsIGxlYXZlIHRoaXMg
+bWVldGluZy48L3Byb3BlcnR5PgogICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJ2Ym94Ij4KICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJhY3Rpb25fYXJlYSI+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25Cb3giPgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmpl
+Y3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
@@ -1917,629 +2312,226 @@ CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 
 	"/definitions/GlobalSettings.xml": {
 		local:   "definitions/GlobalSettings.xml",
-		size:    57947,
+		size:    105520,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
 bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
-My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a1dpbmRvdyIgaWQ9InNldHRpbmdzV2luZG93Ij4KICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4zMDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5
-IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxl
-IiB0cmFuc2xhdGFibGU9InllcyI+U2V0dGluZ3M8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9
-Im1vZGFsIj5UcnVlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3dfcG9zaXRpb24i
-PmNlbnRlcjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVmYXVsdF93aWR0aCI+MjAwPC9w
-cm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJpY29uX25hbWUiPnByZWZlcmVuY2VzLXN5c3RlbTwv
-cHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHlwZV9oaW50Ij5kaWFsb2c8L3Byb3BlcnR5Pgog
-ICAgPHByb3BlcnR5IG5hbWU9InNraXBfdGFza2Jhcl9oaW50Ij5UcnVlPC9wcm9wZXJ0eT4KICAgIDxw
-cm9wZXJ0eSBuYW1lPSJ1cmdlbmN5X2hpbnQiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
-bWU9ImRlbGV0YWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHNpZ25hbCBuYW1lPSJkZXN0cm95IiBo
-YW5kbGVyPSJvbl9kZXN0cm95IiBzd2FwcGVkPSJubyIvPgogICAgPGNoaWxkIHR5cGU9InRpdGxlYmFy
-Ij4KICAgICAgPHBsYWNlaG9sZGVyLz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
-ICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtOb3RlYm9vayI+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJzaG93X2JvcmRlciI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAg
-ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJtYXJnaW5fbGVmdCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im1hcmdpbl9yaWdodCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im1hcmdpbl90b3AiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRnJhbWUiPgogICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsX3hhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsX3lhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNoYWRvd190eXBlIj5ub25lPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
-YXNzPSJHdGtBbGlnbm1lbnQiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
-b3giPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
-Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJjaGtBdXRvam9pbiI+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
-bnNsYXRhYmxlPSJ5ZXMiPkF1dG9tYXRpY2FsbHkgam9pbiBhIG1lZXRpbmc8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a0FkanVzdG1lbnQiIGlkPSJhZGpWb2ljZVRocmVzaG9s
+ZCI+CiAgICA8cHJvcGVydHkgbmFtZT0ibG93ZXIiPjE8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
+bWU9InVwcGVyIj4xMDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InZhbHVlIj41MDwvcHJv
+cGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ic3RlcF9pbmNyZW1lbnQiPjU8L3Byb3BlcnR5PgogICAg
+PHByb3BlcnR5IG5hbWU9InBhZ2VfaW5jcmVtZW50Ij4xMDwvcHJvcGVydHk+CiAgPC9vYmplY3Q+CiAg
+PG9iamVjdCBjbGFzcz0iR3RrV2luZG93IiBpZD0ic2V0dGluZ3NXaW5kb3ciPgogICAgPHByb3BlcnR5
+IG5hbWU9IndpZHRoX3JlcXVlc3QiPjMwMDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
+X2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idGl0bGUiIHRyYW5zbGF0
+YWJsZT0ieWVzIj5TZXR0aW5nczwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ibW9kYWwiPlRy
+dWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRvd19wb3NpdGlvbiI+Y2VudGVyPC9w
+cm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0X3dpZHRoIj4yMDA8L3Byb3BlcnR5Pgog
+ICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+cHJlZmVyZW5jZXMtc3lzdGVtPC9wcm9wZXJ0eT4K
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxvZzwvcHJvcGVydHk+CiAgICA8cHJvcGVy
+dHkgbmFtZT0ic2tpcF90YXNrYmFyX2hpbnQiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
+bWU9InVyZ2VuY3lfaGludCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVsZXRh
+YmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8c2lnbmFsIG5hbWU9ImRlc3Ryb3kiIGhhbmRsZXI9Im9u
+X2Rlc3Ryb3kiIHN3YXBwZWQ9Im5vIi8+CiAgICA8Y2hpbGQgdHlwZT0idGl0bGViYXIiPgogICAgICA8
+cGxhY2Vob2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrQm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgIDxjaGls
+ZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a05vdGVib29rIj4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNo
+b3dfYm9yZGVyIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
+bl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2lu
+X3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2lu
+X3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9i
+b3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRh
+dGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtGcmFtZSI+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ibGFiZWxfeGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibGFiZWxfeWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ic2hhZG93X3R5cGUiPm5vbmU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Fs
+aWdubWVudCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0
+aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImNoa0F1dG9qb2luIj4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9
+InllcyI+QXV0b21hdGljYWxseSBqb2luIGEgbWVldGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+QWxsb3cgdGhlIGhvc3QgdG8gYXV0
+b21hdGljYWxseSBqb2luIGEgbmV3bHkgY3JlYXRlZCBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxp
+Z24iPjAuNTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xl
+X29wdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxBdXRvam9pbiI+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5DaGVjayB0aGlzIG9wdGlvbiB0byBhdXRvbWF0aWNhbGx5IGpvaW4gZXZlcnkg
+bWVldGluZyB5b3UgaG9zdDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24i
+PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtaGVs
+cCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtDaGVja0J1dHRvbiIgaWQ9ImNoa0V2ZW50RmVlZCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlB1Ymxpc2gg
+dGhlIGV2ZW50cyBvZiBteSBtZWV0aW5ncyBsb2NhbGx5PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxz
 ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2Rl
-ZmF1bHQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkFsbG93IHRoZSBo
-b3N0IHRvIGF1dG9tYXRpY2FsbHkgam9pbiBhIG5ld2x5IGNyZWF0ZWQgbWVldGluZzwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24i
-PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ieWFsaWduIj4wLjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZHJhd19pbmRpY2F0b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9
-Im9uX3RvZ2dsZV9vcHRpb24iIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5n
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJs
-QXV0b2pvaW4iPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
-YmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q2hlY2sgdGhpcyBvcHRpb24gdG8gYXV0b21hdGljYWxseSBq
-b2luIGV2ZXJ5IG1lZXRpbmcgeW91IGhvc3Q8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJj
-b250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+YW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5MZXQgb3RoZXIgcHJvZ3JhbXMgb24g
+dGhpcyBjb21wdXRlciBmb2xsb3cgd2hhdCBoYXBwZW5zIGluIHRoZSBtZWV0aW5ncyB5b3UgaG9zdDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJtYXJnaW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wLjU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZHJhd19pbmRpY2F0
+b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2ln
+bmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX3RvZ2dsZV9vcHRpb24iIHN3YXBwZWQ9Im5vIi8+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
+Ij4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsRXZlbnRGZWVkIj4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBhcnRpY2lw
+YW50cyBqb2luaW5nIGFuZCBsZWF2aW5nLCBtdXRlIGNoYW5nZXMgYW5kIGNoYXQgbWVzc2FnZXMgYXJl
+IHdyaXR0ZW4gdG8gYSBzb2NrZXQgaW4geW91ciBydW50aW1lIGRpcmVjdG9yeS4gUHJvZ3JhbXMgcmVh
+ZGluZyBpdCBuZWVkIHRoZSB0b2tlbiBzdG9yZWQgbmV4dCB0byBpdDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNl
+bGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
 ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZm9ybS1sZWdlbmQtY29udGVudCIv
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkIHR5cGU9ImxhYmVsIj4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsSG9zdGluZ0dyb3VwIj4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4xMDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPkhvc3Rpbmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxh
+dHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0id2VpZ2h0
+IiB2YWx1ZT0iYm9sZCIvPgogICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3Mg
+bmFtZT0iZm9ybS1sZWdlbmQtdGl0bGUiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4K
+ICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5h
+bWU9ImZvcm0tbGVnZW5kIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
 ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
-PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxj
-bGFzcyBuYW1lPSJmb3JtLWxlZ2VuZC1jb250ZW50Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
-ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQgdHlwZT0ibGFiZWwi
-PgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxIb3N0
-aW5nR3JvdXAiPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
-VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im1hcmdpbl9ib3R0b20iPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SG9zdGluZzwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ3ZWlnaHQiIHZhbHVlPSJib2xkIi8+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJmb3JtLWxlZ2VuZC10aXRsZSIvPgog
-ICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmpl
-Y3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+
-CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZm9ybS1sZWdlbmQiLz4KICAgICAgICAg
-ICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
-RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
-Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
-PC9jaGlsZD4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5h
-bWU9IndpbmRvdy1jb250ZW50Ii8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAg
-IDwvb2JqZWN0PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQgdHlwZT0idGFi
-Ij4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InRhYkdlbmVyYWwiPgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+R2VuZXJh
-bDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiX2ZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxj
-aGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-PGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImNoa1BlcnNpc3RlbnRDb25maWd1cmF0aW9u
-Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
-bGU9InllcyI+S2VlcCBjb25maWd1cmF0aW9uIGZpbGUgd2hlbiBXYWhheSBjbG9zZXM8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNf
-b25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkFsbG93
-IHRoZSBob3N0IHRvIGF1dG9tYXRpY2FsbHkgam9pbiBhIG5ld2x5IGNyZWF0ZWQgbWVldGluZzwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRyYXdfaW5kaWNh
-dG9yIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJ0
-b2dnbGVkIiBoYW5kbGVyPSJvbl90b2dnbGVfb3B0aW9uIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAg
-ICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9
-ImxhYmVsLWNoZWNrYm94Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAg
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8
-L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsU3RvcmVDb25maWdEZXNjcmlwdGlvbiI+CiAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imhh
-bGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5XaGVuIHRoaXMgb3B0aW9uIGlzIGNoZWNr
-ZWQsIHRoZSBjb25maWd1cmF0aW9uIHNldHRpbmdzIHdpbGwgYmUgc3RvcmVkIGluIHRoZSBkZXZpY2Uu
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0
-YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ3aWR0aF9jaGFycyI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ0cmFja192aXNpdGVkX2xpbmtzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJj
-b250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAg
-ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2No
-aWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
-ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgog
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNh
-bDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
-ICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJjaGtFbmNyeXB0RmlsZSI+CiAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
-PkVuY3J5cHQgdGhlIGNvbmZpZ3VyYXRpb24gZmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNl
-aXZlc19kZWZhdWx0Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ieWFsaWduIj4wLjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iZHJhd19pbmRpY2F0b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRsZXI9Im9uX3RvZ2dsZV9vcHRpb24i
-IHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtY2hlY2tib3giLz4KICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
-ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
-aW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
-ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAg
-ICAgIDxjbGFzcyBuYW1lPSJ3aW5kb3ctY29udGVudCIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4K
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9w
-YWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQgdHlwZT0idGFiIj4K
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InRhYlNlY3VyaXR5Ij4KICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNlY3VyaXR5
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiX2ZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+
 CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAg
 ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
 ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
-PSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
-VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
-dGtMYWJlbCIgaWQ9ImxibERlYnVnV2FybmluZyI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+MTAwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
-bnNsYXRhYmxlPSJ5ZXMiPkJlIHZlcnkgY2FyZWZ1bC4gVGhpcyBpbmZvcm1hdGlvbiBpcyBzZW5zaXRp
-dmUgYW5kIGNvdWxkIHBvdGVudGlhbGx5IGNvbnRhaW4gdmVyeSBwcml2YXRlIGluZm9ybWF0aW9uLiBP
-bmx5IHR1cm4gb24gdGhlc2Ugc2V0dGluZ3MgaWYgeW91IGFic29sdXRlbHkgbmVlZCBpdCBmb3IgZGVi
-dWdnaW5nLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2tfdmlzaXRlZF9saW5rcyI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtaGVscCIvPgogICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9j
-aGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAg
-PHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
-bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9z
-aXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
-ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
-ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0xhYmVsIiBpZD0ibGJsUHJvZmlsZUdyb3VwIj4KICAgICAgICAgICAgICAgICAgICAgICAg
 PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
 ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tCdXR0b24iIGlkPSJjaGtFbmFibGVMb2dnaW5n
-Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
-YXRhYmxlPSJ5ZXMiPkxvZyBkZWJ1ZyBpbmZvPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cmVjZWl2ZXNfZGVmYXVsdCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkFsbG93IHRo
-ZSBob3N0IHRvIGF1dG9tYXRpY2FsbHkgam9pbiBhIG5ld2x5IGNyZWF0ZWQgbWVldGluZzwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24i
-PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRy
-YXdfaW5kaWNhdG9yIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxz
-aWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlX29wdGlvbiIgc3dhcHBlZD0ibm8i
-Lz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPGNsYXNzIG5hbWU9ImxhYmVsLWNoZWNrYm94Ii8+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibERlYnVnTG9nRmlsZURlc2NyaXB0aW9uIj4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
-bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
-PkxvZyBkZWJ1ZyBvdXRwdXQgdG8gdGhlIHNlbGVjdGVkIGxvZyBmaWxlLiBJZiBubyBmaWxlIGlzIHNl
-bGVjdGVkIHRoZW4gdGhlIGxvZyBvdXRwdXQgd2lsbCBiZSB3cml0dGVuIHRvIHRoZSBkZWZhdWx0IGxv
-ZyBmaWxlLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfY2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRyYWNrX3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24i
-PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Inlh
-bGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWhlbHAiLz4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2lu
-Zz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2Fs
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsRGVidWdMb2dGaWxlIj4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlJhdyBsb2cgZmlsZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24i
-PjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1sYWJlbCIvPgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVj
-dD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAg
-ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4xMDwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0icmF3TG9nRmlsZSI+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJzZWNvbmRhcnlfaWNvbl9zdG9jayI+Z3RrLWZpbGU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iaWNvbi1wcmVzcyIgaGFuZGxlcj0i
-b25fcmF3TG9nRmlsZV9pY29uX3ByZXNzX2V2ZW50IiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxjbGFzcyBuYW1lPSJmb3JtLWNvbnRyb2wiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0
-blJhd0xvZ0ZpbGUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ic2Vuc2l0aXZlIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1
-bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJtYXJnaW5fbGVmdCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fcmF3TG9nRmlsZV9idXR0b25f
-Y2xpY2tlZF9ldmVudCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
-dGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InN0b2NrIj5ndGstZmluZDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBp
-ZD0ibGJsRGVidWdMb2dGaWxlQnJvd3NlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+QnJv
-d3NlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVj
-dD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xh
-c3MgbmFtZT0iYnRuLXNtIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxEZWJ1Z0xvZ0ZpbGVXYXJuaW5nIj4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIg
-dHJhbnNsYXRhYmxlPSJ5ZXMiPklmIHlvdSBzZXQgdGhpcyBvcHRpb24gdG8gYSBmaWxlIG5hbWUsIGxv
-dyBsZXZlbCBpbmZvcm1hdGlvbiB3aWxsIGJlIGxvZ2dlZCB0aGVyZS48L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWdu
-Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtaGVscCIvPgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVj
-dD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAg
-ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
-ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
-ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
-ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
-eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
-c2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
-ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNs
-YXNzIG5hbWU9IndpbmRvdy1jb250ZW50Ii8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAg
-ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
-CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAg
-ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0idGFiRGVidWciPgogICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RGVidWdnaW5nPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idGFiX2ZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8
-L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAg
-ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
-bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
-cmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFy
-Z2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFy
-Z2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
-bl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmll
-bnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
-ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFi
-ZWwiIGlkPSJsYmxNdW1ibGVMb2NhdGlvbiI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5FeGVjdXRhYmxlIE11
-bWJsZSBsb2NhdGlvbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtbGFiZWwi
-Lz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
-ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
-Qm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJt
-YXJnaW5fYm90dG9tIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9Im11bWJs
-ZUJpbmFyeUxvY2F0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV9pY29uX3N0b2NrIj5ndGstZmlsZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXJf
-dGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4LiAvaG9tZS91c2VyL211bWJsZS9tdW1ibGU8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJpY29uLXByZXNzIiBo
-YW5kbGVyPSJvbl9tdW1ibGVCaW5hcnlMb2NhdGlvbl9pY29uX3ByZXNzIiBzd2FwcGVkPSJubyIvPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8Y2xhc3MgbmFtZT0iZm9ybS1jb250cm9sIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
-Y2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkJyb3dzZU11bWJsZUJpbmFyeSI+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9j
-bGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX211bWJs
-ZUJpbmFyeUxvY2F0aW9uX2NsaWNrZWRfZXZlbnQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
-Y2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtJbWFnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3RvY2si
-Pmd0ay1maW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2Jq
-ZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
-bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxNdW1ibGVCaW5hcnlC
-cm93c2UiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJl
-bCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkJyb3dzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-YWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4i
-Lz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1zbSIvPgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLWludmlzaWJsZSIvPgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
-L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
-ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
-ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tp
-bmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibE11bWJs
-ZUJpbmFyeURlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5zdGFydDwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
-PklmIHlvdSB3YW50IHRvIHVzZSB5b3VyIG93biBNdW1ibGUgaW5zdGFuY2UsIHBsZWFzZSBlbnRlciB0
-aGUgbG9jYXRpb24gd2hlcmUgTXVtYmxlIGlzIGF2YWlsYWJsZSBpbiB0aGUgc3lzdGVtLjwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhf
-Y2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-dHJhY2tfdmlzaXRlZF9saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4xMDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
+ZXMiPlByb2ZpbGVzPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGF0
+dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ3ZWlnaHQi
+IHZhbHVlPSJib2xkIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBu
+YW1lPSJmb3JtLWxlZ2VuZC10aXRsZSIvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgog
+ICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibEN1cnJlbnRQcm9maWxlIj4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFs
+aWduIj5zdGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0id2lkdGhfY2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
 ICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
 ICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
 ICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1o
@@ -2547,345 +2539,1541 @@ ZWxwIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAg
 ICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
 ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
 ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAg
 ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
-ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
-aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
-Y3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsTXVtYmxlUG9ydCI+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0
-YWJsZT0ieWVzIj5NdW1ibGUgc2VydmljZSBwb3J0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWxhYmVsIi8+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
-CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9Im11bWJsZVBvcnQiPgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXJfdGV4
-dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4LiA5ODAwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJpbnB1dF9wdXJwb3NlIj5kaWdpdHM8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJkZWxldGUtdGV4dCIgaGFuZGxl
-cj0ib25fcG9ydE11bWJsZV9kZWxldGVfdGV4dCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iaW5zZXJ0LXRleHQiIGhhbmRsZXI9Im9uX3BvcnRNdW1i
-bGVfaW5zZXJ0X3RleHQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJmb3JtLWNvbnRy
-b2wiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
-b24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsUG9y
-dE11bWJsZU1lc3NhZ2UiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBvcnQgb3V0IG9m
-IHJhbmdlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ0
-ZXh0LWRhbmdlciIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2lu
-Zz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
-Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
-PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InNwYWNpbmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0i
+ZW50TmV3UHJvZmlsZU5hbWUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
+Pk5hbWUgb2YgdGhlIG5ldyBwcm9maWxlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImZvcm0t
+Y29udHJvbC1mb250Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
+bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIg
+aWQ9ImJ0bkNyZWF0ZVByb2ZpbGUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9
+Im9uX2NyZWF0ZV9wcm9maWxlIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
+YWJlbCIgaWQ9ImxibENyZWF0ZVByb2ZpbGUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
+dGFibGU9InllcyI+Q3JlYXRlIHByb2ZpbGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5h
+bWU9ImJ0bi1zbSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAg
 ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
 ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
-YWJlbCIgaWQ9ImxibE11bWJsZVBvcnRIZWxwIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+YWJlbCIgaWQ9ImxibFByb2ZpbGVNZXNzYWdlIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5zdGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFt
+ZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2luZG93LWNv
+bnRlbnQiLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0idGFiR2VuZXJhbCI+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5HZW5lcmFsPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ0YWJfZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bWFyZ2luX2xlZnQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJt
+YXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJt
+YXJnaW5fdG9wIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFy
+Z2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9y
+aWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0NoZWNrQnV0dG9uIiBpZD0iY2hrUGVyc2lzdGVudENvbmZpZ3VyYXRpb24iPgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5LZWVw
+IGNvbmZpZ3VyYXRpb24gZmlsZSB3aGVuIFdhaGF5IGNsb3NlczwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2
+ZXNfZGVmYXVsdCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+QWxsb3cgdGhlIGhvc3QgdG8g
+YXV0b21hdGljYWxseSBqb2luIGEgbmV3bHkgY3JlYXRlZCBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZHJhd19pbmRpY2F0b3IiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9InRvZ2dsZWQiIGhhbmRs
+ZXI9Im9uX3RvZ2dsZV9vcHRpb24iIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtY2hlY2ti
+b3giLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrTGFiZWwiIGlkPSJsYmxTdG9yZUNvbmZpZ0Rlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5zdGFydDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9w
+Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJl
+bCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPldoZW4gdGhpcyBvcHRpb24gaXMgY2hlY2tlZCwgdGhlIGNvbmZp
+Z3VyYXRpb24gc2V0dGluZ3Mgd2lsbCBiZSBzdG9yZWQgaW4gdGhlIGRldmljZS48L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX2NoYXJz
+Ij4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRyYWNr
+X3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
+c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtaGVscCIv
+PgogICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtDaGVja0J1dHRvbiIgaWQ9ImNoa0VuY3J5cHRGaWxlIj4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RW5jcnlwdCB0aGUg
+Y29uZmlndXJhdGlvbiBmaWxlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
 cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+cHJvcGVydHkgbmFtZT0ic2Vuc2l0aXZlIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Inhh
+bGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5
+YWxpZ24iPjAuNTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxz
+aWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlX29wdGlvbiIgc3dhcHBlZD0ibm8i
+Lz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJsYWJlbC1jaGVja2JveCIvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3N0
+eWxlPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
 ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
-SWYgeW91IHdhbnQgdG8gc2V0IHVwIGEgY3VzdG9tIHBvcnQgdG8gcnVuIHRoZSBNdW1ibGUgc2Vydmlj
-ZSwgcGxlYXNlIGEgcG9ydCBudW1iZXIgYmV0d2VlbiAxIGFuZCA2NTUzNTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAg
-IDxjbGFzcyBuYW1lPSJjb250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHls
+ICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNoYW5nZU1hc3RlclBhc3N3b3JkIj4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZW5zaXRpdmUiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9jaGFuZ2VfbWFzdGVyX3Bhc3N3
+b3JkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibENo
+YW5nZU1hc3RlclBhc3N3b3JkIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
+ZXMiPkNoYW5nZSBtYXN0ZXIgcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5h
+bWU9ImJ0bi1zbSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2lu
+Zz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
+YWJlbCIgaWQ9ImxibE1hc3RlclBhc3N3b3JkTWVzc2FnZSI+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFz
+cyBuYW1lPSJjb250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAg
+ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+
+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlv
+biI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAg
+ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0iY2hrUGVyc2lzdGVu
+dElkZW50aXR5Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
+cmFuc2xhdGFibGU9InllcyI+VXNlIHRoZSBzYW1lIGlkZW50aXR5IGluIGV2ZXJ5IG1lZXRpbmc8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Zm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMi
+PkFsbG93IHRoZSBob3N0cyB0byByZWNvZ25pemUgeW91IHdoZW4geW91IGpvaW4gdGhlaXIgbWVldGlu
+Z3MgYWdhaW48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+eGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJkcmF3X2luZGljYXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxz
+aWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlX29wdGlvbiIgc3dhcHBlZD0ibm8i
+Lz4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJsYWJlbC1jaGVja2JveCIvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3N0
+eWxlPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibElkZW50aXR5RGVzY3JpcHRp
+b24iPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+
+MTAwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJoYWxpZ24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+V2hlbiB0aGlzIG9wdGlv
+biBpcyBjaGVja2VkLCB5b3Ugd2lsbCBqb2luIHRoZSBtZWV0aW5ncyB3aXRoIHRoZSBzYW1lIGNlcnRp
+ZmljYXRlLCBzbyB0aGUgaG9zdHMgY2FuIHJlY29nbml6ZSB5b3UuIE90aGVyd2lzZSwgYSBuZXcgY2Vy
+dGlmaWNhdGUgaXMgY3JlYXRlZCBldmVyeSB0aW1lIHlvdSBqb2luIGEgbWVldGluZy4gVGhlIGNlcnRp
+ZmljYXRlIGlzIGtlcHQgaW4gdGhlIGNvbmZpZ3VyYXRpb24gZmlsZSwgc28geW91IHNob3VsZCBhbHNv
+IGVuY3J5cHQgaXQuPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ3aWR0aF9jaGFycyI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9s
+LWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0xhYmVsIiBpZD0ibGJsSWRlbnRpdHlGaW5nZXJwcmludCI+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXBfbW9k
+ZSI+Y2hhcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3
+aWR0aF9jaGFycyI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWxhYmVsIi8+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
+ICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3Rv
+cCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic3Bh
+Y2luZyI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuRXhwb3J0SWRl
+bnRpdHkiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9u
+X2V4cG9ydF9pZGVudGl0eSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFi
+ZWwiIGlkPSJsYmxFeHBvcnRJZGVudGl0eSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0
+YWJsZT0ieWVzIj5FeHBvcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBu
+YW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1z
+bSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+ICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuSW1w
+b3J0SWRlbnRpdHkiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRs
+ZXI9Im9uX2ltcG9ydF9pZGVudGl0eSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0i
+R3RrTGFiZWwiIGlkPSJsYmxJbXBvcnRJZGVudGl0eSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5JbXBvcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxj
+bGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9
+ImJ0bi1zbSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0i
+YnRuUm90YXRlSWRlbnRpdHkiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InNlbnNpdGl2ZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQi
+IGhhbmRsZXI9Im9uX3JvdGF0ZV9pZGVudGl0eSIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
+bGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxSb3RhdGVJZGVudGl0eSI+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFi
+ZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5DcmVhdGUgYSBuZXcgaWRlbnRpdHk8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1zbSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibElkZW50aXR5TWVzc2FnZSI+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTA8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAg
+PC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cG9zaXRpb24iPjQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2li
+bGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0i
+Y2hrU3RyaWN0Q2VydGlmaWNhdGVzIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+T25seSBqb2luIG1lZXRpbmdzIHdob3NlIGNlcnRp
+ZmljYXRlIGNhbiBiZSBjaGVja2VkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlw
+X3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5Eb24ndCBqb2luIGEgbWVldGluZyBpZiBpdHMgY2VydGlm
+aWNhdGUgY2FuJ3QgYmUgZmV0Y2hlZCBvciBpcyBub3QgdGhlIG9uZSBpdHMgc2VydmVyIHVzZXM8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJkcmF3X2luZGlj
+YXRvciI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
+dG9nZ2xlZCIgaGFuZGxlcj0ib25fdG9nZ2xlX29wdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1l
+PSJsYWJlbC1jaGVja2JveCIvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAg
+ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+PC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibFN0cmljdENlcnRpZmljYXRlc0Rlc2NyaXB0aW9u
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEw
+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iaGFsaWduIj5zdGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkJlZm9yZSBqb2luaW5nIGEg
+bWVldGluZywgV2FoYXkgZmV0Y2hlcyB0aGUgY2VydGlmaWNhdGUgb2YgaXRzIHNlcnZlciwgc28gTXVt
+YmxlIGNhbiB0cnVzdCBpdC4gV2hlbiB0aGlzIG9wdGlvbiBpcyBjaGVja2VkIGFuZCB0aGUgY2VydGlm
+aWNhdGUgY2FuJ3QgYmUgZmV0Y2hlZCwgb3IgdGhlIHNlcnZlciBwcmVzZW50cyBhIGRpZmZlcmVudCBv
+bmUsIFdhaGF5IGRvZXNuJ3Qgam9pbiB0aGUgbWVldGluZy4gT3RoZXJ3aXNlLCBNdW1ibGUgYXNrcyB5
+b3Ugd2hldGhlciB0byB0cnVzdCB0aGUgc2VydmVyLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfY2hhcnMiPjE8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
+Y2xhc3MgbmFtZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+
+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
+ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4z
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFz
+cz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5f
+dG9wIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVu
+dGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxTZXR0aW5nc1Ry
+YW5zZmVyIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJoYWxpZ24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+TW92ZSB5b3VyIHNldHRpbmdzIHRvIGFub3Ro
+ZXIgY29tcHV0ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWxhYmVsIi8+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0i
+bGJsU2V0dGluZ3NUcmFuc2ZlckRlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5zdGFydDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPlRoZSBzZXR0aW5ncyBhcmUgZXhwb3J0ZWQgdG8gYSBzaW5nbGUgZmlsZSwgZW5j
+cnlwdGVkIHdpdGggYSBwYXNzcGhyYXNlIHRoYXQgeW91IGNob29zZS4gVGhlIGZpbGUgaGFzIHlvdXIg
+cHJlZmVyZW5jZXMsIHlvdXIgaWRlbnRpdHkgYW5kIHlvdXIgc2NoZWR1bGVkIG1lZXRpbmdzLCB3aXRo
+IHRoZSBrZXlzIG9mIHRoZWlyIG9uaW9uIGFkZHJlc3Nlcywgc28ga2VlcCBpdCBzYWZlLjwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhf
+Y2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+eGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8
+Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNwYWNpbmci
+PjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkV4cG9ydFNldHRpbmdz
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9leHBvcnRfc2V0dGluZ3Mi
+IHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsRXhwb3J0
+U2V0dGluZ3MiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+RXhwb3J0
+IHNldHRpbmdzPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRu
+Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tc20iLz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkltcG9ydFNldHRp
+bmdzIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9pbXBvcnRfc2V0dGlu
+Z3MiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsSW1w
+b3J0U2V0dGluZ3MiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW1w
+b3J0IHNldHRpbmdzPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0i
+YnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tc20iLz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
+ICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9w
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxT
+ZXR0aW5nc1RyYW5zZmVyTWVzc2FnZSI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250
+cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+NDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ3aW5kb3ctY29udGVudCIv
+PgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICA8Y2hpbGQgdHlwZT0idGFiIj4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtM
+YWJlbCIgaWQ9InRhYlNlY3VyaXR5Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIg
+dHJhbnNsYXRhYmxlPSJ5ZXMiPlNlY3VyaXR5PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVj
+dD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiX2Zp
+bGwiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
+b3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRp
+b24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNh
+bDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibERlYnVnV2FybmluZyI+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+MTAw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkJlIHZlcnkgY2FyZWZ1bC4g
+VGhpcyBpbmZvcm1hdGlvbiBpcyBzZW5zaXRpdmUgYW5kIGNvdWxkIHBvdGVudGlhbGx5IGNvbnRhaW4g
+dmVyeSBwcml2YXRlIGluZm9ybWF0aW9uLiBPbmx5IHR1cm4gb24gdGhlc2Ugc2V0dGluZ3MgaWYgeW91
+IGFic29sdXRlbHkgbmVlZCBpdCBmb3IgZGVidWdnaW5nLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2tfdmlzaXRl
+ZF9saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRy
+b2wtaGVscCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJn
+aW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2hlY2tC
+dXR0b24iIGlkPSJjaGtFbmFibGVMb2dnaW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkxvZyBkZWJ1ZyBpbmZvPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4dCIg
+dHJhbnNsYXRhYmxlPSJ5ZXMiPkFsbG93IHRoZSBob3N0IHRvIGF1dG9tYXRpY2FsbHkgam9pbiBhIG5l
+d2x5IGNyZWF0ZWQgbWVldGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImRyYXdfaW5kaWNhdG9yIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0idG9nZ2xlZCIgaGFuZGxlcj0ib25f
+dG9nZ2xlX29wdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxz
+dHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImxhYmVsLWNoZWNr
+Ym94Ii8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibERl
+YnVnTG9nRmlsZURlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+c3RhcnQ8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90
+b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkxvZyBkZWJ1ZyBvdXRwdXQgdG8gdGhlIHNlbGVjdGVk
+IGxvZyBmaWxlLiBJZiBubyBmaWxlIGlzIHNlbGVjdGVkIHRoZW4gdGhlIGxvZyBvdXRwdXQgd2lsbCBi
+ZSB3cml0dGVuIHRvIHRoZSBkZWZhdWx0IGxvZyBmaWxlLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfY2hhcnMi
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRy
+YWNrX3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBu
+YW1lPSJjb250cm9sLWhlbHAiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVs
+IiBpZD0ibGJsRGVidWdMb2dGaWxlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlJhdyBs
+b2cgZmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0i
+Y29udHJvbC1sYWJlbCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
+aWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJtYXJnaW5fYm90dG9tIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Vu
+dHJ5IiBpZD0icmF3TG9nRmlsZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWNvbmRhcnlfaWNvbl9zdG9jayI+
+Z3RrLWZpbGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwg
+bmFtZT0iaWNvbi1wcmVzcyIgaGFuZGxlcj0ib25fcmF3TG9nRmlsZV9pY29uX3ByZXNzX2V2ZW50IiBz
+d2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJmb3JtLWNvbnRyb2wiLz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0blJhd0xvZ0ZpbGUiPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2Vuc2l0aXZlIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFu
+ZGxlcj0ib25fcmF3TG9nRmlsZV9idXR0b25fY2xpY2tlZF9ldmVudCIgc3dhcHBlZD0ibm8iLz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIj4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InN0b2NrIj5ndGstZmluZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBh
+Y2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsRGVidWdMb2dGaWxlQnJvd3NlIj4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
+YmVsIiB0cmFuc2xhdGFibGU9InllcyI+QnJvd3NlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLXNtIi8+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
+ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxEZWJ1Z0xv
+Z0ZpbGVXYXJuaW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPklmIHlvdSBzZXQgdGhp
+cyBvcHRpb24gdG8gYSBmaWxlIG5hbWUsIGxvdyBsZXZlbCBpbmZvcm1hdGlvbiB3aWxsIGJlIGxvZ2dl
+ZCB0aGVyZS48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9
+ImNvbnRyb2wtaGVscCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
+aWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxz
+dHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9IndpbmRvdy1jb250ZW50Ii8+CiAgICAg
+ICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+IDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBp
+ZD0idGFiRGVidWciPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
+bGU9InllcyI+RGVidWdnaW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idGFiX2ZpbGwiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3gi
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0
+aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxNdW1ibGVMb2NhdGlvbiI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5FeGVjdXRhYmxlIE11bWJsZSBsb2NhdGlvbjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PGNsYXNzIG5hbWU9ImNvbnRyb2wtbGFiZWwiLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHls
 ZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgPHBh
 Y2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
 PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
 dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
-b24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
-ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
-MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwv
-Y2hpbGQ+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1l
-PSJ3aW5kb3ctY29udGVudCIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8
-L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
-ICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQgdHlwZT0idGFiIj4KICAgICAgICAgICAgICA8
-b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9InRhYk11bWJsZSI+CiAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5NdW1ibGU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ0YWJfZmlsbCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4K
-ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+
-CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
-ICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3gi
-PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAg
-ICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibE1l
-c3NhZ2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9
-InllcyI+Q29uZmlndXJhdGlvbiBzZXR0aW5ncyB3aWxsIGJlIGxvc3QgaW4gdGhlIG5leHQgc2Vzc2lv
-bjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFz
-cyBuYW1lPSJsYWJlbC1zZXR0aW5ncy13YXJuaW5nIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgog
-ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
-ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgog
+b24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
+ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4xMDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtFbnRyeSIgaWQ9Im11bWJsZUJpbmFyeUxvY2F0aW9uIj4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlY29uZGFyeV9p
+Y29uX3N0b2NrIj5ndGstZmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icGxhY2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4LiAvaG9t
+ZS91c2VyL211bWJsZS9tdW1ibGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHNpZ25hbCBuYW1lPSJpY29uLXByZXNzIiBoYW5kbGVyPSJvbl9tdW1ibGVCaW5hcnlMb2NhdGlvbl9p
+Y29uX3ByZXNzIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxl
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZm9ybS1jb250cm9sIi8+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkJyb3dzZU11
+bWJsZUJpbmFyeSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
+aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9s
+ZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9
+ImNsaWNrZWQiIGhhbmRsZXI9Im9uX211bWJsZUJpbmFyeUxvY2F0aW9uX2NsaWNrZWRfZXZlbnQiIHN3
+YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtJbWFnZSI+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ic3RvY2siPmd0ay1maW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+TGFiZWwiIGlkPSJsYmxNdW1ibGVCaW5hcnlCcm93c2UiPgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkJyb3dzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAg
 ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+
-CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
-ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRu
-Q2FuY2VsU2V0dGluZ3MiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIg
-dHJhbnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Nsb3NlX3dpbmRv
-dyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAg
-ICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAg
-ICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRu
-U2F2ZVNldHRpbmdzIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
-YW5zbGF0YWJsZT0ieWVzIj5TYXZlIGNoYW5nZXM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9zYXZlIiBz
-d2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAg
-ICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJi
-dG4tcHJpbWFyeSIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAg
-IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFj
-a2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
-ICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJhY3Rpb25zIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxl
-PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InBhZGRpbmciPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1l
-PSJ3aW5kb3ctYWN0aW9ucyIvPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJib3JkZXJlZCIvPgog
-ICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PGNsYXNzIG5hbWU9ImJ0bi1zbSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3Mg
+bmFtZT0iYnRuLWludmlzaWJsZSIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
 ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9j
-aGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0PgogIDxvYmplY3QgY2xh
-c3M9Ikd0a1dpbmRvdyIgaWQ9IndpbkRlbGV0ZUNvbmZpZ0ZpbGVDb25maXJtIj4KICAgIDxwcm9wZXJ0
-eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2Rh
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0id2luZG93X3Bvc2l0aW9uIj5jZW50
-ZXI8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImRlZmF1bHRfd2lkdGgiPjMwMDwvcHJvcGVy
-dHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHlwZV9oaW50Ij5kaWFsb2c8L3Byb3BlcnR5PgogICAgPHBy
-b3BlcnR5IG5hbWU9InNraXBfdGFza2Jhcl9oaW50Ij5UcnVlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ1cmdlbmN5X2hpbnQiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImRl
-bGV0YWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgPGNoaWxkIHR5cGU9InRpdGxlYmFyIj4KICAgICAg
-PHBsYWNlaG9sZGVyLz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9
-Ikd0a0JveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hp
-bGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
-YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
-bl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmln
-aHQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjIw
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwv
-cHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
-dGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
-YXNzPSJHdGtMYWJlbCIgaWQ9ImxibFNldHRpbmdzV2FybmluZyI+CiAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkludmFsaWQg
-Y29uZmlndXJhdGlvbiBmaWxlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+
-CiAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9IndlaWdodCIgdmFsdWU9ImJvbGQi
-Lz4KICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgPHN0
-eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImxhYmVsLXRpdGxlIi8+CiAgICAg
-ICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNs
+YXNzPSJHdGtMYWJlbCIgaWQ9ImxibE11bWJsZUJpbmFyeURlc2NyaXB0aW9uIj4KICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5z
+dGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJn
+aW5fdG9wIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPklmIHlvdSB3YW50IHRvIHVzZSB5b3VyIG93biBNdW1i
+bGUgaW5zdGFuY2UsIHBsZWFzZSBlbnRlciB0aGUgbG9jYXRpb24gd2hlcmUgTXVtYmxlIGlzIGF2YWls
+YWJsZSBpbiB0aGUgc3lzdGVtLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfY2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2tfdmlzaXRlZF9saW5rcyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8Y2xhc3MgbmFtZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwv
+c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
 ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
 bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
 bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
 aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
 ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxDb25maWdGaWxlQ29ycnVwdGVkIj4KICAgICAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+MTAwPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+dCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJv
+cmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
 ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9
-InllcyI+V2UgaGF2ZSBkZXRlY3RlZCB0aGF0IHRoZSBjb25maWd1cmF0aW9uIGZpbGUgaXMgaW52YWxp
-ZCBvciBjb3JydXB0ZWQuIERvIHlvdSB3YW50IHRvIG1ha2UgYSBjb3B5IChiYWNrdXApIG9mIGl0IGFu
-ZCBjb250aW51ZT88L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3
-cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2Vs
-ZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-InRyYWNrX3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+
-CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtdGV4dCIvPgogICAgICAgICAg
-ICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
-ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
-YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
-MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwv
-Y2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
-c3M9Ikd0a0xhYmVsIiBpZD0ibGJsQ29uZmlnRmlsZUNvcnJ1cHRlZEhlbHAiPgogICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRp
+b24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsTXVtYmxl
+UG9ydCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5NdW1ibGUgc2VydmljZSBwb3J0PC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3Rh
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWxh
+YmVsIi8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9Im11bWJs
+ZVBvcnQiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0icGxhY2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV4LiA5ODAwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJpbnB1dF9w
+dXJwb3NlIj5kaWdpdHM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25h
+bCBuYW1lPSJkZWxldGUtdGV4dCIgaGFuZGxlcj0ib25fcG9ydE11bWJsZV9kZWxldGVfdGV4dCIgc3dh
+cHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iaW5zZXJ0
+LXRleHQiIGhhbmRsZXI9Im9uX3BvcnRNdW1ibGVfaW5zZXJ0X3RleHQiIHN3YXBwZWQ9Im5vIi8+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxjbGFzcyBuYW1lPSJmb3JtLWNvbnRyb2wiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsUG9ydE11bWJsZU1lc3NhZ2UiPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjEwPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPlBvcnQgb3V0IG9mIHJhbmdlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ0ZXh0LWRhbmdlciIvPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibE11bWJsZVBvcnRIZWxwIj4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPnN0
+YXJ0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
+bl90b3AiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SWYgeW91IHdhbnQgdG8gc2V0IHVwIGEgY3VzdG9tIHBv
+cnQgdG8gcnVuIHRoZSBNdW1ibGUgc2VydmljZSwgcGxlYXNlIGEgcG9ydCBudW1iZXIgYmV0d2VlbiAx
+IGFuZCA2NTUzNTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHN0eWxl
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJjb250cm9sLWhlbHAiLz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwv
+cGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0xhYmVsIiBpZD0ibGJsQXVkaW9Hcm91cCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5BdWRpbzwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGln
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxp
+Z24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImNvbnRyb2wtbGFiZWwiLz4KICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrR3JpZCI+CiAgICAgICAgICAg
 ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
-SWYgeW91IGJhY2t1cCB0aGUgY29uZmlndXJhdGlvbiBmaWxlLCB3ZSB3aWxsIHJlc2V0IHRoZSBzZXR0
-aW5ncyBhbmQgY29udGludWUgbm9ybWFsbHkuIElmIHRoZSBjb25maWd1cmF0aW9uIGZpbGUgaXMgZW5j
-cnlwdGVkLCB0aGVuIHdlIHdpbGwgYXNrIHlvdSBmb3IgYSBwYXNzd29yZCB0byBlbmNyeXB0IHRoZSBu
-ZXcgc2V0dGluZ3MgZmlsZS48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InRyYWNrX3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
-c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtdGV4dCIvPgogICAg
-ICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9InRleHQtZGFuZ2VyIi8+CiAgICAgICAgICAgICAg
-ICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAg
-IDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGls
-ZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5n
-PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNs
-YXNzIG5hbWU9IndpbmRvdy1jb250ZW50Ii8+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8
-L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
-YW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
-PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
-c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+
-dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
-cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
-bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPmNlbnRl
-cjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBj
-bGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ29uZmlnRmlsZUNvcnJ1cHRlZENhbmNlbCI+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Tm8sIGNh
-bmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
-dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVj
-ZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25h
-bCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9jYW5jZWwiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAg
-ICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0biIv
-PgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
-ICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAg
-IDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNvbmZpZ0ZpbGVDb3JydXB0ZWRCYWNrdXAi
-PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
-ZXMiPlllcywgYmFjayBpdCB1cCAmYW1wOyBjb250aW51ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icm93X3NwYWNpbmciPjY8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY29sdW1uX3NwYWNp
+bmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsQXVkaW9CYWNrZW5k
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNvdW5kIHN5c3RlbTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJs
+ZWZ0X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idG9wX2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+Q29tYm9Cb3hUZXh0IiBpZD0iY21iQXVkaW9CYWNrZW5kIj4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoZXhwYW5kIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2hhbmdlZCIgaGFu
+ZGxlcj0ib25fYXVkaW9fYmFja2VuZF9jaGFuZ2VkIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4xPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3BfYXR0
+YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibEF1
+ZGlvSW5wdXREZXZpY2UiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW5wdXQgZGV2aWNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxp
+Z24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3BfYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9ImVudEF1ZGlvSW5wdXREZXZpY2UiPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cGxhY2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkRlZmF1bHQgZGV2aWNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGNsYXNzIG5hbWU9ImZvcm0tY29udHJvbCIvPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxBdWRpb091dHB1dERldmljZSI+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5PdXRwdXQgZGV2aWNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3BfYXR0
+YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9ImVudEF1
+ZGlvT3V0cHV0RGV2aWNlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImhleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyX3RleHQiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5EZWZhdWx0IGRldmljZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJmb3JtLWNv
+bnRyb2wiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9hdHRhY2giPjE8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRh
+Y2giPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsVHJh
+bnNtaXRNb2RlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNp
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRyYW5zbWl0PC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0b3BfYXR0YWNoIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtDb21ib0JveFRleHQiIGlkPSJjbWJUcmFuc21pdE1vZGUiPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhleHBhbmQiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjaGFuZ2Vk
+IiBoYW5kbGVyPSJvbl90cmFuc21pdF9tb2RlX2NoYW5nZWQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9hdHRhY2gi
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRv
+cF9hdHRhY2giPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0i
+bGJsVm9pY2VUaHJlc2hvbGQiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Vm9pY2UgYWN0
+aXZpdHkgdGhyZXNob2xkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3BfYXR0YWNoIj40PC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtTcGluQnV0dG9uIiBpZD0ic3BuVm9pY2VUaHJlc2hv
+bGQiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iaGV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iaW5wdXRfcHVycG9zZSI+ZGlnaXRzPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJhZGp1c3RtZW50Ij5hZGpWb2ljZVRocmVz
+aG9sZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bnVtZXJpYyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2giPjQ8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsUHVzaFRvVGFsa0tleSI+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFi
+ZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QdXNoLXRvLXRhbGsga2V5PC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0
+YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ0b3BfYXR0YWNoIj41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tp
+bmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtDb21ib0Jv
+eFRleHQiIGlkPSJjbWJQdXNoVG9UYWxrS2V5Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
 ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9k
-ZWxldGUiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAg
-ICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0biIvPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNz
-IG5hbWU9ImJ0bi1kYW5nZXIiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0
+X2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idG9wX2F0dGFjaCI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9w
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFi
+ZWwiIGlkPSJsYmxBdWRpb1F1YWxpdHkiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UXVh
+bGl0eTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+eGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+NjwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrQ29tYm9Cb3hUZXh0IiBpZD0iY21iQXVkaW9RdWFsaXR5Ij4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJo
+ZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+NjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2No
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
+dGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibEF1ZGlvSGVscCI+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imhh
+bGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibWFyZ2luX3RvcCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5NdW1ibGUgaXMgZ2l2ZW4gdGhlc2Ugc2V0
+dGluZ3MgZXZlcnkgdGltZSBpdCBydW5zLCBzbyB0aGUgY2hhbmdlcyB5b3UgbWFrZSB0byB0aGVtIGlu
+c2lkZSBNdW1ibGUgYXJlIG5vdCBrZXB0LjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id2lkdGhfY2hhcnMiPjE8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3Mg
+bmFtZT0iY29udHJvbC1oZWxwIi8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAg
+ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+Mjwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9IndpbmRv
+dy1jb250ZW50Ii8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZCB0eXBlPSJ0YWIiPgogICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0xhYmVsIiBpZD0idGFiTXVtYmxlIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPk11bWJsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InRhYl9maWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+ICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2No
+aWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsTWVzc2FnZSI+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5D
+b25maWd1cmF0aW9uIHNldHRpbmdzIHdpbGwgYmUgbG9zdCBpbiB0aGUgbmV4dCBzZXNzaW9uPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9
+ImxhYmVsLXNldHRpbmdzLXdhcm5pbmciLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5DYW5jZWxT
+ZXR0aW5ncyI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
+dGFibGU9InllcyI+Q2FuY2VsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY2xvc2Vfd2luZG93IiBzd2Fw
+cGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAg
 ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
 ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
 ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0
-PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
-cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
-PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+
-ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAg
-ICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9IndpbmRvdy1hY3Rpb25zIi8+
-CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJvcmRlcmVkIi8+CiAgICAgICAgICAgIDwvc3R5bGU+
-CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
-Ij4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8
-L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5TYXZlU2V0
+dGluZ3MiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRh
+YmxlPSJ5ZXMiPlNhdmUgY2hhbmdlczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX3NhdmUiIHN3YXBwZWQ9
+Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNs
+YXNzIG5hbWU9ImJ0biIvPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1wcmlt
+YXJ5Ii8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAg
+ICAgICAgPGNsYXNzIG5hbWU9ImFjdGlvbnMiLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAg
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icGFkZGluZyI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8
+L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9IndpbmRv
+dy1hY3Rpb25zIi8+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJvcmRlcmVkIi8+CiAgICAgICAg
+ICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgog
+ICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmplY3Q+CiAgPG9iamVjdCBjbGFzcz0iR3Rr
+V2luZG93IiBpZD0id2luRGVsZXRlQ29uZmlnRmlsZUNvbmZpcm0iPgogICAgPHByb3BlcnR5IG5hbWU9
+ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Im1vZGFsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ3aW5kb3dfcG9zaXRpb24iPmNlbnRlcjwvcHJv
+cGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVmYXVsdF93aWR0aCI+MzAwPC9wcm9wZXJ0eT4KICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxvZzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkg
+bmFtZT0ic2tpcF90YXNrYmFyX2hpbnQiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9
+InVyZ2VuY3lfaGludCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZGVsZXRhYmxl
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICA8Y2hpbGQgdHlwZT0idGl0bGViYXIiPgogICAgICA8cGxhY2Vo
+b2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94
+Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgIDxjaGlsZD4KICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQi
+PjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MjA8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjIwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4yMDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0xhYmVsIiBpZD0ibGJsU2V0dGluZ3NXYXJuaW5nIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+SW52YWxpZCBjb25maWd1
+cmF0aW9uIGZpbGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJz
+ZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+eWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlcz4KICAgICAg
+ICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0id2VpZ2h0IiB2YWx1ZT0iYm9sZCIvPgogICAg
+ICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAg
+ICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtdGl0bGUiLz4KICAgICAgICAgICAg
+ICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2No
+aWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtMYWJlbCIgaWQ9ImxibENvbmZpZ0ZpbGVDb3JydXB0ZWQiPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ3aWR0aF9yZXF1ZXN0Ij4xMDA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5X
+ZSBoYXZlIGRldGVjdGVkIHRoYXQgdGhlIGNvbmZpZ3VyYXRpb24gZmlsZSBpcyBpbnZhbGlkIG9yIGNv
+cnJ1cHRlZC4gRG8geW91IHdhbnQgdG8gbWFrZSBhIGNvcHkgKGJhY2t1cCkgb2YgaXQgYW5kIGNvbnRp
+bnVlPzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2tf
+dmlzaXRlZF9saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJsYWJlbC10ZXh0Ii8+CiAgICAgICAgICAgICAgICAg
+ICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxw
+YWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+TGFiZWwiIGlkPSJsYmxDb25maWdGaWxlQ29ycnVwdGVkSGVscCI+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjEwMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5JZiB5b3Ug
+YmFja3VwIHRoZSBjb25maWd1cmF0aW9uIGZpbGUsIHdlIHdpbGwgcmVzZXQgdGhlIHNldHRpbmdzIGFu
+ZCBjb250aW51ZSBub3JtYWxseS4gSWYgdGhlIGNvbmZpZ3VyYXRpb24gZmlsZSBpcyBlbmNyeXB0ZWQs
+IHRoZW4gd2Ugd2lsbCBhc2sgeW91IGZvciBhIHBhc3N3b3JkIHRvIGVuY3J5cHQgdGhlIG5ldyBzZXR0
+aW5ncyBmaWxlLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Indy
+YXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxl
+Y3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dHJhY2tfdmlzaXRlZF9saW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4K
+ICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJsYWJlbC10ZXh0Ii8+CiAgICAgICAgICAg
+ICAgICAgICAgICA8Y2xhc3MgbmFtZT0idGV4dC1kYW5nZXIiLz4KICAgICAgICAgICAgICAgICAgICA8
+L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFt
+ZT0id2luZG93LWNvbnRlbnQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNh
+bDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjIwPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4yMDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJH
+dGtCdXR0b24iIGlkPSJidG5Db25maWdGaWxlQ29ycnVwdGVkQ2FuY2VsIj4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5ObywgY2FuY2VsPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19k
+ZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9
+ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NhbmNlbCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAg
+ICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAg
+ICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
+bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ29uZmlnRmlsZUNvcnJ1cHRlZEJhY2t1cCI+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+WWVz
+LCBiYWNrIGl0IHVwICZhbXA7IGNvbnRpbnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2RlbGV0ZSIg
+c3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAg
+ICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0i
+YnRuLWRhbmdlciIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAg
+ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFja190eXBlIj5lbmQ8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+IDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2luZG93LWFjdGlvbnMiLz4KICAgICAg
+ICAgICAgICA8Y2xhc3MgbmFtZT0iYm9yZGVyZWQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
+b3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0
+PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 `,
 	},
 
@@ -4666,7 +5854,7 @@ L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 
 	"/definitions/InvitePeopleWindow.xml": {
 		local:   "definitions/InvitePeopleWindow.xml",
-		size:    19340,
+		size:    26350,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
@@ -4912,86 +6100,340 @@ ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
 bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
 ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4x
 PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9j
-aGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNr
-aW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFj
-a2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4K
-ICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
-a0JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0i
-bGJsTWVzc2FnZSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idHJhY2tfdmlzaXRlZF9s
-aW5rcyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAg
-ICAgICA8Y2xhc3MgbmFtZT0ibGFiZWwtc3VjY2VzcyIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4K
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
-ICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3gi
-PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+aGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFz
+cz0iR3RrQm94IiBpZD0iYm94UVJDb2RlIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJoYWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9Im1hcmdpbl90b3AiPjMwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0ic3BhY2luZyI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0ltYWdlIiBpZD0iaW1nUVJD
+b2RlIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24i
+PnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InNwYWNpbmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsUVJDb2Rl
+Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBhcnRpY2lwYW50cyBjYW4gc2NhbiB0aGlz
+IGNvZGUgaW5zdGVhZCBvZiB0eXBpbmcgdGhlIG1lZXRpbmcgSUQ8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1heF93aWR0aF9jaGFycyI+MzA8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGln
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0iY2hrUVJQYXNzd29yZCI+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5J
+bmNsdWRlIHRoZSBwYXNzd29yZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZHJhd19p
+bmRpY2F0b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHNpZ25h
+bCBuYW1lPSJ0b2dnbGVkIiBoYW5kbGVyPSJvbl9xcl9wYXNzd29yZF90b2dnbGVkIiBzd2FwcGVkPSJu
+byIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCdXR0b24iIGlkPSJidG5TYXZlUVJDb2RlIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNhdmUgUVIgYXMgUE5HPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPnN0YXJ0PC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25f
+c2F2ZV9xcl9jb2RlIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHN0
+eWxlPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iaW52aXRlLXdpbmRv
+dy1idG4iLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9z
+aXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0
+PgogICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwv
+cGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3By
+b3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+
+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0
+aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibE1lc3NhZ2UiPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InRyYWNrX3Zpc2l0ZWRfbGlua3MiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImxhYmVsLXN1Y2Nlc3Mi
+Lz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
+PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVy
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MjA8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MjA8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjIwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNvcHlNZWV0aW5nSUQiPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNvcHkgTWVldGluZyBJRDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNf
+ZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwg
+bmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY29weV9tZWV0aW5nX2lkIiBzd2FwcGVkPSJubyIvPgog
+ICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1l
+PSJpbnZpdGUtd2luZG93LWJ0biIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNvcHlJ
+bnZpdGF0aW9uIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5Db3B5IEludml0YXRpb248L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
 ICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxj
-aGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ29w
-eU1lZXRpbmdJRCI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
-c2xhdGFibGU9InllcyI+Q29weSBNZWV0aW5nIElEPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4xMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9j
-b3B5X21lZXRpbmdfaWQiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgog
-ICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Imludml0ZS13aW5kb3ctYnRuIi8+CiAgICAg
-ICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
-ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
-bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
-bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
-aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
-ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
-dCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ29weUludml0YXRpb24iPgogICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNvcHkgSW52aXRhdGlv
-bjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
-PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2
-ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWdu
-YWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fY29weV9pbnZpdGF0aW9uIiBzd2FwcGVkPSJubyIv
-PgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBu
-YW1lPSJpbnZpdGUtd2luZG93LWJ0biIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAg
-ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJm
-aWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
-biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxk
-PgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Imludml0ZS13aW5k
-b3ctYm90dG9tIi8+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAg
-ICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJv
-cGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgIDwv
-cGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9v
-YmplY3Q+CjwvaW50ZXJmYWNlPgo=
+ICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjEwPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Nv
+cHlfaW52aXRhdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAg
+ICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iaW52aXRlLXdpbmRvdy1idG4iLz4KICAgICAg
+ICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
+ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
+b24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAg
+ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5QZXJzb25hbEludml0YXRpb25zIj4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5QZXJzb25hbCBJ
+bnZpdGF0aW9uczwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fcGVyc29uYWxfaW52aXRhdGlvbnMi
+IHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAg
+ICAgICAgPGNsYXNzIG5hbWU9Imludml0ZS13aW5kb3ctYnRuIi8+CiAgICAgICAgICAgICAgICAgICAg
+PC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0
+dG9uIiBpZD0iYnRuRXhwb3J0Q2FsZW5kYXIiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkFkZCB0byBDYWxlbmRhcjwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBfdGV4
+dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNhdmUgdGhlIG1lZXRpbmcgYXMgYW4gaUNhbGVuZGFyICguaWNz
+KSBmaWxlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2lu
+X2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFy
+Z2luX3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJj
+bGlja2VkIiBoYW5kbGVyPSJvbl9leHBvcnRfY2FsZW5kYXIiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAg
+ICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Imludml0
+ZS13aW5kb3ctYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9w
+YWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAg
+ICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iaW52aXRlLXdpbmRvdy1ib3R0b20i
+Lz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9p
+bnRlcmZhY2U+Cg==
+`,
+	},
+
+	"/definitions/KnockWindow.xml": {
+		local:   "definitions/KnockWindow.xml",
+		size:    7738,
+		modtime: 1489449600,
+		compressed: `
+PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
+bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
+My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a1dpbmRvdyIgaWQ9ImRpYWxvZyI+CiAgICA8cHJvcGVy
+dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idGl0
+bGUiIHRyYW5zbGF0YWJsZT0ieWVzIj5XYWl0aW5nIHJvb208L3Byb3BlcnR5PgogICAgPHByb3BlcnR5
+IG5hbWU9InJlc2l6YWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRv
+d19wb3NpdGlvbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0X3dp
+ZHRoIj40NDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9Imljb25fbmFtZSI+ZGlhbG9nLXF1
+ZXN0aW9uPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxvZzwvcHJv
+cGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ic2tpcF90YXNrYmFyX2hpbnQiPlRydWU8L3Byb3BlcnR5
+PgogICAgPHByb3BlcnR5IG5hbWU9InVyZ2VuY3lfaGludCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJv
+cGVydHkgbmFtZT0iZGVsZXRhYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8Y2hpbGQgdHlwZT0idGl0
+bGViYXIiPgogICAgICA8cGxhY2Vob2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAg
+PG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5
+PgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibWFyZ2luX2xlZnQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJtYXJnaW5fdG9wIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsVGl0bGUi
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjEw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5Tb21lYm9keSB3YW50cyB0byBqb2luIHRoZSBtZWV0aW5nPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0
+ZSBuYW1lPSJ3ZWlnaHQiIHZhbHVlPSJib2xkIi8+CiAgICAgICAgICAgICAgICAgICAgPC9hdHRyaWJ1
+dGVzPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFz
+cyBuYW1lPSJsYWJlbC10aXRsZSIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsVGV4dCI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5BIHBhcnRpY2lwYW50IGlzIHdhaXRpbmcgaW4gdGhlIGxvYmJ5PC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJsYWJl
+bC10ZXh0Ii8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8
+c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9IndpbmRvdy1jb250ZW50Ii8+CiAgICAgICAg
+ICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuRGVueSI+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+
+RGVueTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9j
+dXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9j
+dXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fZGVueSIgc3dhcHBlZD0ibm8i
+Lz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3Mg
+bmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNr
+aW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQWRtaXQiPgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkFkbWl0
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19v
+bl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iaGFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9hZG1pdCIgc3dhcHBlZD0ibm8iLz4K
+ICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFt
+ZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLXByaW1hcnkiLz4K
+ICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8
+Y2xhc3MgbmFtZT0iYWN0aW9ucyIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAg
+ICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJmaWxsIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cGFja190eXBlIj5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBv
+c2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwv
+Y2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2luZG93
+LWFjdGlvbnMiLz4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYm9yZGVyZWQiLz4KICAgICAgICAg
+ICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hp
+bGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
 `,
 	},
 
@@ -5050,7 +6492,7 @@ dD4KPC9pbnRlcmZhY2U+Cg==
 
 	"/definitions/MainWindow.xml": {
 		local:   "definitions/MainWindow.xml",
-		size:    24955,
+		size:    27576,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
@@ -5174,301 +6616,345 @@ aW5nPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAg
 PC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8
 c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0ibWFpbi13aW5kb3ctYnRuLWhl
 bHAiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVj
-dD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
-ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAg
-ICAgICA8Y2xhc3MgbmFtZT0iYWN0aW9ucyIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAg
-ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwYWRkaW5nIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
-PC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJtYWlu
-LXdpbmRvdy10b3AtYmFyIi8+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4K
-ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
-ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAg
-ICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5U
-cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8
-L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3Bl
-cnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3RvcCI+MTAwPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjEwMDwvcHJvcGVydHk+CiAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MjA8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MjA8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAg
-ICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0
-dG9uIiBpZD0iYnRuSm9pbk1lZXRpbmciPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJuYW1lIj5qb2luTWVldGluZ0J1dHRvbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFi
-bGU9InllcyI+Sm9pbiBhIG1lZXRpbmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
-b3BlcnR5IG5hbWU9ImFsd2F5c19zaG93X2ltYWdlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
-ICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2pvaW5fbWVldGluZyIgc3dh
-cHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAg
-ICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
-c3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
-aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PG9iamVjdCBjbGFzcz0iR3RrSW1hZ2UiIGlkPSJpbWdKb2luTWVldGluZyI+CiAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
-bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
-ZT0ibWFyZ2luX3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPHByb3BlcnR5IG5hbWU9InJlc291cmNlIj5qb2luTWVldGluZ0ljb248L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJpY29uX3NpemUiPjM8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibEpv
-aW5NZWV0aW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-bmFtZSI+bGJsSm9pbk1lZXRpbmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
-CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
-c2xhdGFibGU9InllcyI+Sm9pbiBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
-ICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2lu
-Zz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
-Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5n
-PgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9v
-YmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8c3R5
-bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLWpvaW4tbWVldGluZyIvPgog
-ICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
-ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBh
-ZGRpbmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFj
-a190eXBlIj5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
-b3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
-ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxv
-YmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkhvc3RNZWV0aW5nIj4KICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0ibmFtZSI+aG9zdE1lZXRpbmdCdXR0b248L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25fY2xpY2siPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVs
-dCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0
-aXBfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkhvc3QgYSBuZXcgbWVldGluZzwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4K
-ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYWx3YXlzX3Nob3dfaW1hZ2UiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxl
-cj0ib25faG9zdF9tZWV0aW5nIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxjaGls
-ZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAg
-PG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJoYWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3Bl
-cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtJbWFnZSIgaWQ9ImltZ0hvc3RNZWV0aW5nIj4KICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
-bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
-cm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVzb3VyY2UiPmhvc3RNZWV0aW5nSWNvbjwvcHJv
-cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25f
-c2l6ZSI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0Pgog
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+dD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAg
+ICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5TY2hlZHVsZSI+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0
+Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvb2x0aXBf
+dGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlNjaGVkdWxlZCBtZWV0aW5nczwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9vcGVuX3NjaGVk
+dWxlZF9tZWV0aW5ncyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0ltYWdlIiBpZD0iaW1nU2NoZWR1bGUiPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlc291cmNlIj5qb2luTWVl
+dGluZ0ljb248L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Imljb25fc2l6ZSI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsU2NoZWR1bGUiPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFu
+c2xhdGFibGU9InllcyI+U2NoZWR1bGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
 ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
-MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAg
-ICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
-Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVs
-IiBpZD0ibGJsSG9zdE1lZXRpbmciPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJuYW1lIj5sYmxIb3N0TWVldGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNl
+PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAg
+ICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAg
+ICAgICAgICAgIDxjbGFzcyBuYW1lPSJtYWluLXdpbmRvdy1idG4taGVscCIvPgogICAgICAgICAgICAg
+ICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+Mjwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJh
+Y3Rpb25zIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0Pgog
+ICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFu
+ZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhZGRpbmciPjIwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICA8c3R5bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Im1haW4td2luZG93LXRvcC1iYXIiLz4K
+ICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+
+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94
+Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4xMDA8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MTAwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ibWFyZ2luX3RvcCI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5Kb2luTWVl
+dGluZyI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im5hbWUiPmpvaW5NZWV0aW5n
+QnV0dG9uPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9m
+b2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZv
+Y3VzX29uX2NsaWNrIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5Kb2luIGEgbWVl
+dGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+
+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYWx3YXlz
+X3Nob3dfaW1hZ2UiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFt
+ZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fam9pbl9tZWV0aW5nIiBzd2FwcGVkPSJubyIvPgogICAgICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+aGFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hp
+bGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJo
+YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtJ
+bWFnZSIgaWQ9ImltZ0pvaW5NZWV0aW5nIj4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjEw
 PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Ib3N0IG1lZXRpbmc8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
-PHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
-cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
-ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxk
-PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAg
-ICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
-cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
-cGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAg
-IDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
-ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAg
-ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0bi1ob3N0LW1l
-ZXRpbmciLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29i
-amVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
-eSBuYW1lPSJwYWRkaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
-ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9w
-cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
-eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAg
-ICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5
-bGU+CiAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9Im1haW4td2luZG93LWFjdGlvbnMiLz4KICAgICAg
-ICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAg
-ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
-cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJwYWRkaW5nIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3Np
-dGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAg
-ICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giIGlkPSJib3hBcHBsaWNh
-dGlvblN0YXR1cyI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
-ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4K
-ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAg
-ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVs
-IiBpZD0ibGJsQXBwbGljYXRpb25TdGF0dXMiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
-YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
-bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9InZhbGlnbiI+c3RhcnQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
-ZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPldhaGF5IGlzIHJlYWR5IHRvIHVzZTwv
-cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNlbGVjdGFibGUiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8
-L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ5YWxpZ24iPjA8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxj
-bGFzcyBuYW1lPSJzdGF0dXMtbGFiZWwiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAg
-ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+cmVzb3VyY2UiPmpvaW5NZWV0aW5nSWNvbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imljb25fc2l6ZSI+MzwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhw
+YW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0ibGJsSm9pbk1lZXRpbmciPgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJuYW1lIj5sYmxKb2luTWVldGlu
+ZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Kb2lu
+IG1lZXRpbmc8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAg
 ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
-ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
-ICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
-bWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
-aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hp
-bGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3gi
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5Pgog
-ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24i
+PjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAg
+ICAgICAgIDxjbGFzcyBuYW1lPSJidG4tam9pbi1tZWV0aW5nIi8+CiAgICAgICAgICAgICAgICAgICAg
+PC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNr
+aW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFkZGluZyI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAg
 ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0
-dG9uIiBpZD0iYnRuU3RhdHVzU2hvd0Vycm9ycyI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
-IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+U2hvdzwvcHJvcGVydHk+CiAgICAgICAgICAg
+dG9uIiBpZD0iYnRuSG9zdE1lZXRpbmciPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJuYW1lIj5ob3N0TWVldGluZ0J1dHRvbjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9vbHRpcF90ZXh0IiB0cmFuc2xhdGFi
+bGU9InllcyI+SG9zdCBhIG5ldyBtZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJhbHdheXNfc2hvd19pbWFnZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9ob3N0X21lZXRpbmci
+IHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtC
+b3giPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2YWxpZ24iPmNlbnRlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0ltYWdlIiBpZD0iaW1nSG9zdE1lZXRpbmciPgogICAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
+bl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJyZXNvdXJjZSI+aG9zdE1lZXRpbmdJY29uPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaWNvbl9zaXplIj4zPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxIb3N0TWVldGlu
+ZyI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im5hbWUiPmxi
+bEhvc3RNZWV0aW5nPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
 ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
-ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmb2N1c19vbl9jbGljayI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVl
-PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRs
-ZXI9Im9uX3Nob3dfZXJyb3JzIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxzdHls
-ZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJzdGF0dXMtc2hvdy1lcnJvcnMiLz4K
-ICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
-ICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
-ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
-PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
-ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNr
-aW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
-ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAg
-ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
-ICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAg
-ICAgICAgICAgPGNsYXNzIG5hbWU9Im1haW4td2luZG93LXN0YXR1cy1iYXIiLz4KICAgICAgICAgICAg
-ICA8Y2xhc3MgbmFtZT0id2l0aC1lcnJvcnMiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxl
+PSJ5ZXMiPkhvc3QgbWVldGluZzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAg
+IDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
+dWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAg
+ICAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAg
+ICAgICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
+ICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLWhvc3QtbWVldGluZyIvPgogICAgICAgICAg
+ICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhZGRpbmciPjEw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2No
+aWxkPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tp
+bmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8
+Y2xhc3MgbmFtZT0ibWFpbi13aW5kb3ctYWN0aW9ucyIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhZGRpbmciPjIwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4K
+ICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCIgaWQ9ImJveEFwcGxpY2F0aW9uU3RhdHVzIj4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InZhbGlnbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxBcHBsaWNhdGlv
+blN0YXR1cyI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmFsaWduIj5z
+dGFydDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0
+cmFuc2xhdGFibGU9InllcyI+V2FoYXkgaXMgcmVhZHkgdG8gdXNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9InN0YXR1cy1s
+YWJlbCIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4K
+ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJv
+cGVydHkgbmFtZT0idmFsaWduIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5TdGF0dXNT
+aG93RXJyb3JzIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5z
+bGF0YWJsZT0ieWVzIj5TaG93PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImZvY3VzX29uX2NsaWNrIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fc2hvd19lcnJvcnMi
+IHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAg
+ICAgICAgPGNsYXNzIG5hbWU9InN0YXR1cy1zaG93LWVycm9ycyIvPgogICAgICAgICAgICAgICAgICAg
+IDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icGFja190eXBlIj5lbmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFt
+ZT0ibWFpbi13aW5kb3ctc3RhdHVzLWJhciIvPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ3aXRo
+LWVycm9ycyIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MzwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogICAgPHN0eWxlPgog
+ICAgICA8Y2xhc3MgbmFtZT0ibWFpbi13aW5kb3ciLz4KICAgIDwvc3R5bGU+CiAgPC9vYmplY3Q+CiAg
+PG9iamVjdCBjbGFzcz0iR3RrRGlhbG9nIiBpZD0ibWFpbldpbmRvd0Vycm9ycyI+CiAgICA8cHJvcGVy
+dHkgbmFtZT0id2lkdGhfcmVxdWVzdCI+NTAwPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJo
+ZWlnaHRfcmVxdWVzdCI+MjAwPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RhbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVy
+dHkgbmFtZT0id2luZG93X3Bvc2l0aW9uIj5jZW50ZXItb24tcGFyZW50PC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxvZzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFt
+ZT0iZGVsZXRhYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0iZ3Jhdml0eSI+
+Y2VudGVyPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0cmFuc2llbnRfZm9yIj5tYWluV2lu
+ZG93PC9wcm9wZXJ0eT4KICAgIDxzaWduYWwgbmFtZT0iY2xvc2UiIGhhbmRsZXI9Im9uX2Nsb3NlX3dp
+bmRvd19lcnJvcnMiIHN3YXBwZWQ9Im5vIi8+CiAgICA8Y2hpbGQgdHlwZT0idGl0bGViYXIiPgogICAg
+ICA8cGxhY2Vob2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZCBpbnRlcm5hbC1jaGlsZD0idmJv
+eCI+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlv
+biI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4yPC9w
+cm9wZXJ0eT4KICAgICAgICA8Y2hpbGQgaW50ZXJuYWwtY2hpbGQ9ImFjdGlvbl9hcmVhIj4KICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbkJveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
+eW91dF9zdHlsZSI+ZXhwYW5kPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0bkVycm9yc0FjY2VwdCI+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5BY2NlcHQ8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJv
+bl9jbG9zZV93aW5kb3dfZXJyb3JzIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgPHN0eWxl
+PgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICA8L3N0
+eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxj
+bGFzcyBuYW1lPSJkaWFsb2ctYWN0aW9ucyIvPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJib3Jk
+ZXJlZCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8
+cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICA8L3BhY2tp
+bmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGls
+ZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUZXh0VmlldyIgaWQ9InRleHRDb250ZW50
+Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImVkaXRhYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0id3JhcF9tb2RlIj53b3JkPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJidWZmZXIiPmhlbHBUZXh0QnVmZmVyPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJhY2NlcHRzX3RhYiI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2lu
+Zz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxj
+bGFzcyBuYW1lPSJkaWFsb2ctY29udGVudCAiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAg
 IDwvb2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
-eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRy
-dWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGFja190eXBlIj5lbmQ8L3By
-b3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5Pgog
-ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgIDwvb2JqZWN0PgogICAgPC9j
-aGlsZD4KICAgIDxzdHlsZT4KICAgICAgPGNsYXNzIG5hbWU9Im1haW4td2luZG93Ii8+CiAgICA8L3N0
-eWxlPgogIDwvb2JqZWN0PgogIDxvYmplY3QgY2xhc3M9Ikd0a0RpYWxvZyIgaWQ9Im1haW5XaW5kb3dF
-cnJvcnMiPgogICAgPHByb3BlcnR5IG5hbWU9IndpZHRoX3JlcXVlc3QiPjUwMDwvcHJvcGVydHk+CiAg
-ICA8cHJvcGVydHkgbmFtZT0iaGVpZ2h0X3JlcXVlc3QiPjIwMDwvcHJvcGVydHk+CiAgICA8cHJvcGVy
-dHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0icmVz
-aXphYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ibW9kYWwiPlRydWU8L3By
-b3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRvd19wb3NpdGlvbiI+Y2VudGVyLW9uLXBhcmVu
-dDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHlwZV9oaW50Ij5kaWFsb2c8L3Byb3BlcnR5
-PgogICAgPHByb3BlcnR5IG5hbWU9ImRlbGV0YWJsZSI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3Bl
-cnR5IG5hbWU9ImdyYXZpdHkiPmNlbnRlcjwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHJh
-bnNpZW50X2ZvciI+bWFpbldpbmRvdzwvcHJvcGVydHk+CiAgICA8c2lnbmFsIG5hbWU9ImNsb3NlIiBo
-YW5kbGVyPSJvbl9jbG9zZV93aW5kb3dfZXJyb3JzIiBzd2FwcGVkPSJubyIvPgogICAgPGNoaWxkIHR5
-cGU9InRpdGxlYmFyIj4KICAgICAgPHBsYWNlaG9sZGVyLz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQg
-aW50ZXJuYWwtY2hpbGQ9InZib3giPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVy
-dHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkg
-bmFtZT0ic3BhY2luZyI+MjwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJh
-Y3Rpb25fYXJlYSI+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b25Cb3giPgogICAgICAg
-ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAg
-IDxwcm9wZXJ0eSBuYW1lPSJsYXlvdXRfc3R5bGUiPmV4cGFuZDwvcHJvcGVydHk+CiAgICAgICAgICAg
-IDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCdXR0b24iIGlkPSJidG5FcnJv
-cnNBY2NlcHQiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFi
-bGU9InllcyI+QWNjZXB0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
-aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
-Zm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNl
-aXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0i
-Y2xpY2tlZCIgaGFuZGxlcj0ib25fY2xvc2Vfd2luZG93X2Vycm9ycyIgc3dhcHBlZD0ibm8iLz4KICAg
-ICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0biIvPgog
-ICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHls
-ZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZGlhbG9nLWFjdGlvbnMiLz4KICAgICAgICAgICAg
-ICA8Y2xhc3MgbmFtZT0iYm9yZGVyZWQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwv
-b2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
-bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
-L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
-PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+CiAgICAgICAgPGNoaWxkPgogICAg
-ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
-c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+
-MjA8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4yMDwv
-cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fdG9wIj4yMDwvcHJvcGVy
-dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fYm90dG9tIj4yMDwvcHJvcGVydHk+
-CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5
-PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVGV4dFZp
-ZXciIGlkPSJ0ZXh0Q29udGVudCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
-ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3Vz
-Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJlZGl0YWJsZSI+
-RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXBfbW9kZSI+
-d29yZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYnVmZmVyIj5oZWxw
-VGV4dEJ1ZmZlcjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iYWNjZXB0
-c190YWIiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
-ICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3By
-b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5
-PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAg
-ICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHls
-ZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZGlhbG9nLWNvbnRlbnQgIi8+CiAgICAgICAgICAg
-IDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
-ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3Bl
-cnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
-cG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgPC9wYWNraW5nPgogICAgICAgIDwvY2hpbGQ+
-CiAgICAgIDwvb2JqZWN0PgogICAgPC9jaGlsZD4KICA8L29iamVjdD4KPC9pbnRlcmZhY2U+Cg==
+eHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVy
+dHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAg
+ICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -5896,7 +7382,7 @@ ICAgPC9vYmplY3Q+CiAgICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 
 	"/definitions/MasterPasswordWindow.xml": {
 		local:   "definitions/MasterPasswordWindow.xml",
-		size:    20189,
+		size:    30816,
 		modtime: 1489449600,
 		compressed: `
 PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
@@ -6235,7 +7721,184 @@ dD4KICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5G
 YWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
 ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAg
 ICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hp
-bGQ+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+bGQ+CiAgPC9vYmplY3Q+CiAgPG9iamVjdCBjbGFzcz0iR3RrV2luZG93IiBpZD0iY2hhbmdlTWFzdGVy
+UGFzc3dvcmRXaW5kb3ciPgogICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+Q2hhbmdlIG1h
+c3RlciBwYXNzd29yZDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0icmVzaXphYmxlIj5GYWxz
+ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0ibW9kYWwiPlRydWU8L3Byb3BlcnR5PgogICAg
+PHByb3BlcnR5IG5hbWU9IndpbmRvd19wb3NpdGlvbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ0eXBlX2hpbnQiPmRpYWxvZzwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0i
+ZGVsZXRhYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8Y2hpbGQgdHlwZT0idGl0bGViYXIiPgogICAg
+ICA8cGxhY2Vob2xkZXIvPgogICAgPC9jaGlsZD4KICAgIDxjaGlsZD4KICAgICAgPG9iamVjdCBjbGFz
+cz0iR3RrQm94Ij4KICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAgIDxj
+aGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3Jp
+ZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAg
+ICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2Fu
+X2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3Jp
+ZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAg
+ICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxDaGFuZ2VNYXN0ZXJQYXNz
+d29yZEludHJvIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5f
+Ym90dG9tIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Imxh
+YmVsIiB0cmFuc2xhdGFibGU9InllcyI+UGxlYXNlIGVudGVyIHRoZSBjdXJyZW50IG1hc3RlciBwYXNz
+d29yZCwgYW5kIHRoZSBuZXcgb25lIHR3aWNlLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgog
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxDaGFuZ2VNYXN0ZXJQYXNzd29yZFZh
+bGlkYXRpb24iPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2Jv
+dHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFw
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0
+YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Inhh
+bGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGln
+biI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAg
+ICAgICAgPGNsYXNzIG5hbWU9InRleHQtZGFuZ2VyIi8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHls
+ZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAg
+ICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlk
+PSJ0eHRDdXJyZW50TWFzdGVyUGFzc3dvcmQiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJ2aXNpYmlsaXR5Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImNhcHNfbG9ja193YXJuaW5nIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9sZGVyX3RleHQiIHRyYW5zbGF0YWJs
+ZT0ieWVzIj5DdXJyZW50IHBhc3N3b3JkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c3R5
+bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZm9ybS1jb250cm9sLWZvbnQiLz4K
+ICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+cG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8
+b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InR4dE5ld01hc3RlclBhc3N3b3JkIj4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJpbGl0eSI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYXBzX2xvY2tfd2FybmluZyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhv
+bGRlcl90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+TmV3IHBhc3N3b3JkPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iZm9y
+bS1jb250cm9sLWZvbnQiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAg
+ICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNoaWxkPgog
+ICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9InR4dE5ld01hc3RlclBh
+c3N3b3JkUmVwZWF0Ij4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1
+cyI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
+bl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+dmlzaWJpbGl0eSI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJjYXBzX2xvY2tfd2FybmluZyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlcl90ZXh0IiB0cmFuc2xhdGFibGU9InllcyI+UmVwZWF0
+IHRoZSBuZXcgcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAg
+ICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJmb3JtLWNvbnRyb2wtZm9udCIvPgogICAgICAg
+ICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5k
+Ij5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlv
+biI+NDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0NoZWNrQnV0dG9uIiBpZD0iY2hrQXJnb24yaWQiPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlVzZSBBcmdvbjJpZCB0byBk
+ZXJpdmUgdGhlIGtleXMgZnJvbSB0aGUgcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZm9jdXNfb25fY2xpY2siPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icmVjZWl2ZXNfZGVmYXVsdCI+RmFsc2U8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b29sdGlwX3RleHQiIHRy
+YW5zbGF0YWJsZT0ieWVzIj5BcmdvbjJpZCBpcyBtb3JlIHJlc2lzdGFudCB0aGFuIHNjcnlwdCBhZ2Fp
+bnN0IGF0dGFja3Mgd2l0aCBzcGVjaWFsIGhhcmR3YXJlLCBidXQgb2xkZXIgdmVyc2lvbnMgb2YgV2Fo
+YXkgY2FuJ3QgcmVhZCB0aGUgZmlsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InlhbGlnbiI+MC41PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZHJhd19pbmRpY2F0b3IiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxz
+dHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJsYWJlbC1jaGVja2JveCIvPgog
+ICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJw
+b3NpdGlvbiI+NTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAg
+ICAgICAgICAgIDxjbGFzcyBuYW1lPSJ3aW5kb3ctY29udGVudCIvPgogICAgICAgICAgICA8L3N0eWxl
+PgogICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAg
+IDxjaGlsZD4KICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAg
+ICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ2hhbmdlTWFzdGVyUGFzc3dv
+cmRDYW5jZWwiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNs
+YXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2NoYW5nZV9wYXNzd29yZF9j
+YW5jZWwiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAg
+ICAgICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0biIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+
+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1dHRvbiIgaWQ9
+ImJ0bkNoYW5nZU1hc3RlclBhc3N3b3JkQ29udGludWUiPgogICAgICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNoYW5nZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEw
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRs
+ZXI9Im9uX2NoYW5nZV9wYXNzd29yZCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8
+c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAg
+ICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLXByaW1hcnkiLz4KICAgICAgICAgICAgICAgICAgICA8
+L3N0eWxlPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tp
+bmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAg
+ICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYWN0aW9ucyIv
+PgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+ICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwYWNrX3R5cGUiPmVuZDwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0
+eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJ3aW5kb3ctYWN0aW9ucyIvPgogICAgICAgICAg
+ICAgIDxjbGFzcyBuYW1lPSJib3JkZXJlZCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4
+cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVy
+dHk+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgPC9vYmplY3Q+CiAg
+ICA8L2NoaWxkPgogIDwvb2JqZWN0Pgo8L2ludGVyZmFjZT4K
 `,
 	},
 
@@ -6579,6 +8242,643 @@ Cg==
 `,
 	},
 
+	"/definitions/PersonalInvitationsWindow.xml": {
+		local:   "definitions/PersonalInvitationsWindow.xml",
+		size:    11057,
+		modtime: 1489449600,
+		compressed: `
+PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
+bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
+My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a0xpc3RTdG9yZSIgaWQ9Imludml0YXRpb25zU3RvcmUi
+PgogICAgPGNvbHVtbnM+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgbmFtZSAtLT4KICAgICAgPGNvbHVt
+biB0eXBlPSJnY2hhcmFycmF5Ii8+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgdG9rZW4gLS0+CiAgICAg
+IDxjb2x1bW4gdHlwZT0iZ2NoYXJhcnJheSIvPgogICAgPC9jb2x1bW5zPgogIDwvb2JqZWN0PgogIDxv
+YmplY3QgY2xhc3M9Ikd0a1dpbmRvdyIgaWQ9Imludml0YXRpb25zV2luZG93Ij4KICAgIDxwcm9wZXJ0
+eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRs
+ZSIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlBlcnNvbmFsIGludml0YXRpb25zPC9wcm9wZXJ0eT4KICAgIDxw
+cm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1l
+PSJtb2RhbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0id2luZG93X3Bvc2l0aW9u
+Ij5jZW50ZXI8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9ImRlZmF1bHRfd2lkdGgiPjUwMDwv
+cHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHlwZV9oaW50Ij5kaWFsb2c8L3Byb3BlcnR5Pgog
+ICAgPHNpZ25hbCBuYW1lPSJkZXN0cm95IiBoYW5kbGVyPSJvbl9jbG9zZV93aW5kb3dfc2lnbmFsIiBz
+d2FwcGVkPSJubyIvPgogICAgPGNoaWxkIHR5cGU9InRpdGxlYmFyIj4KICAgICAgPHBsYWNlaG9sZGVy
+Lz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAg
+ICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJs
+ZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl90b3AiPjIwPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNhbDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4xMDwvcHJvcGVydHk+CiAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9ImxibERlc2Ny
+aXB0aW9uIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5
+ZXMiPkV2ZXJ5IHBlcnNvbiBnZXRzIHRoZWlyIG93biBwYXNzd29yZCwgd2hpY2ggeW91IGNhbiByZXZv
+a2UgYXQgYW55IHRpbWUuIFdoZW4gdGhlIG1lZXRpbmcgaGFzIG5vIHBhc3N3b3JkLCBvbmx5IHRoZSBp
+bnZpdGVkIHBlb3BsZSB3aWxsIGJlIGFibGUgdG8gam9pbi48L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9Im1heF93aWR0aF9jaGFycyI+NjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c3R5bGU+
+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJsYWJlbC10ZXh0Ii8+CiAgICAgICAgICAgICAg
+ICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InNw
+YWNpbmciPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrRW50cnkiIGlkPSJpbnBJbnZpdGF0aW9uTmFtZSI+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlcl90ZXh0IiB0cmFuc2xhdGFi
+bGU9InllcyI+TmFtZSBvZiB0aGUgaW52aXRlZCBwZXJzb248L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxzaWduYWwgbmFtZT0iYWN0aXZhdGUiIGhhbmRsZXI9Im9uX2NyZWF0ZV9pbnZpdGF0aW9u
+IiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAg
+ICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwv
+cHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0J1dHRvbiIgaWQ9ImJ0bkNyZWF0ZUludml0YXRpb24iPgogICAgICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkludml0ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRs
+ZXI9Im9uX2NyZWF0ZV9pbnZpdGF0aW9uIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAg
+IDxzdHlsZT4KICAgICAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAg
+ICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4tcHJpbWFyeSIvPgogICAgICAgICAgICAgICAgICAg
+IDwvc3R5bGU+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFj
+a2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a1Njcm9sbGVkV2luZG93Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5f
+Zm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1pbl9j
+b250ZW50X2hlaWdodCI+MTUwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJzaGFkb3dfdHlwZSI+aW48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUcmVlVmlldyIgaWQ9InRyZWVJbnZpdGF0aW9ucyI+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+aW52aXRhdGlvbnNT
+dG9yZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJz
+ZWxlY3Rpb24iPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVNlbGVj
+dGlvbiIvPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4i
+IGlkPSJjb2x1bW5OYW1lIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRp
+dGxlIiB0cmFuc2xhdGFibGU9InllcyI+TmFtZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3Rr
+Q2VsbFJlbmRlcmVyVGV4dCIvPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgog
+ICAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ0ZXh0Ij4wPC9hdHRyaWJ1
+dGU+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAg
+ICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAg
+ICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtUcmVlVmlld0NvbHVtbiIgaWQ9ImNvbHVtblRva2VuIj4K
+ICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9
+InllcyI+UGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAg
+ICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2VsbFJlbmRlcmVyVGV4dCIv
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAg
+ICAgICAgICAgPGF0dHJpYnV0ZSBuYW1lPSJ0ZXh0Ij4xPC9hdHRyaWJ1dGU+CiAgICAgICAgICAgICAg
+ICAgICAgICAgICAgPC9hdHRyaWJ1dGVzPgogICAgICAgICAgICAgICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgPC9jaGlsZD4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImV4cGFuZCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9
+Ikd0a0xhYmVsIiBpZD0ibGJsTWVzc2FnZSI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+bGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5UaGUgaW52aXRhdGlvbiBoYXMgYmVlbiBjb3BpZWQgdG8g
+dGhlIGNsaXBib2FyZDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2Vs
+ZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAg
+ICAgICAgIDxjbGFzcyBuYW1lPSJsYWJlbC1zdWNjZXNzIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxl
+PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xh
+c3MgbmFtZT0id2luZG93LWNvbnRlbnQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwv
+b2JqZWN0PgogICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+
+CiAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAg
+ICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlz
+aWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMi
+PkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImhhbGlnbiI+Y2VudGVy
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9ib3R0b20iPjIwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0J1dHRvbiIgaWQ9ImJ0bkNvcHlQZXJzb25hbEludml0YXRpb24iPgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+Q29weSBJbnZpdGF0aW9uPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9j
+b3B5X2ludml0YXRpb24iIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
+ICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3Qg
+Y2xhc3M9Ikd0a0J1dHRvbiIgaWQ9ImJ0blJldm9rZUludml0YXRpb24iPgogICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UmV2b2tlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9yZXZva2Vf
+aW52aXRhdGlvbiIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAg
+ICAgICAgICAgPGNsYXNzIG5hbWU9ImJ0biIvPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0i
+YnRuLWRhbmdlciIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVj
+dD4KICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJl
+eHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxs
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+
+MTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgog
+ICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBp
+ZD0iYnRuQ2xvc2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xh
+dGFibGU9InllcyI+Q2xvc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNh
+bl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJl
+Y2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibWFyZ2luX3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9
+ImNsaWNrZWQiIGhhbmRsZXI9Im9uX2Nsb3NlIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAg
+PHN0eWxlPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAg
+ICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAg
+IDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVy
+dHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFj
+a2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmpl
+Y3Q+CjwvaW50ZXJmYWNlPgo=
+`,
+	},
+
+	"/definitions/ProfileWindow.xml": {
+		local:   "definitions/ProfileWindow.xml",
+		size:    8723,
+		modtime: 1489449600,
+		compressed: `
+PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
+bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
+My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a1dpbmRvdyIgaWQ9InByb2ZpbGVXaW5kb3ciPgogICAg
+PHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
+bWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+Q2hvb3NlIGEgcHJvZmlsZTwvcHJvcGVydHk+CiAg
+ICA8cHJvcGVydHkgbmFtZT0icmVzaXphYmxlIj5GYWxzZTwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkg
+bmFtZT0ibW9kYWwiPlRydWU8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9IndpbmRvd19wb3Np
+dGlvbiI+Y2VudGVyPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWZhdWx0X3dpZHRoIj41
+MDA8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5hbWU9InR5cGVfaGludCI+ZGlhbG9nPC9wcm9wZXJ0
+eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJkZWxldGFibGUiPkZhbHNlPC9wcm9wZXJ0eT4KICAgIDxjaGls
+ZCB0eXBlPSJ0aXRsZWJhciI+CiAgICAgIDxwbGFjZWhvbGRlci8+CiAgICA8L2NoaWxkPgogICAgPGNo
+aWxkPgogICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5G
+YWxzZTwvcHJvcGVydHk+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9uIj52ZXJ0aWNh
+bDwvcHJvcGVydHk+CiAgICAgICAgPGNoaWxkPgogICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94
+Ij4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQm94Ij4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJvcmllbnRhdGlvbiI+dmVydGljYWw8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIg
+aWQ9ImxibFByb2ZpbGVJbnRybyI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkV2ZXJ5IHByb2ZpbGUgaGFzIGl0cyBvd24g
+c2V0dGluZ3MsIGlkZW50aXR5IGFuZCBzY2hlZHVsZWQgbWVldGluZ3MuIFBsZWFzZSBjaG9vc2UgdGhl
+IHByb2ZpbGUgdG8gdXNlLjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9IndyYXAiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJzZWxlY3RhYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ieWFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAg
+ICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBh
+bmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmls
+bCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0
+aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAg
+ICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVj
+dCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxQcm9maWxlRXJyb3IiPgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAg
+ICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ3cmFwIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ic2VsZWN0YWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InlhbGlnbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgPHN0eWxlPgogICAgICAgICAgICAgICAgICAgICAgPGNsYXNzIG5hbWU9InRleHQtZGFuZ2Vy
+Ii8+CiAgICAgICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+
+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAg
+ICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAg
+ICAgPG9iamVjdCBjbGFzcz0iR3RrQ29tYm9Cb3hUZXh0IiBpZD0iY21iUHJvZmlsZSI+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjI8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIgaWQ9
+ImxibE5ld1Byb2ZpbGUiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
+cmdpbl9ib3R0b20iPjEwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5PciBjcmVhdGUgYSBuZXcgcHJvZmlsZTo8L3Byb3Bl
+cnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5
+PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9
+ImVudE5ld1Byb2ZpbGUiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2Zv
+Y3VzIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxh
+Y2Vob2xkZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPk5hbWUgb2YgdGhlIG5ldyBwcm9maWxlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgICAgICA8
+Y2xhc3MgbmFtZT0iZm9ybS1jb250cm9sLWZvbnQiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxl
+PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRpb24iPjQ8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0id2lu
+ZG93LWNvbnRlbnQiLz4KICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICA8
+L3BhY2tpbmc+CiAgICAgICAgPC9jaGlsZD4KICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0
+IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Jv
+eCI+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0
+a0J1dHRvbiIgaWQ9ImJ0blByb2ZpbGVDYW5jZWwiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkNhbmNlbDwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9
+Im9uX2NhbmNlbCIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAg
+ICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgPC9z
+dHlsZT4KICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5n
+PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+
+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAg
+ICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9u
+IiBpZD0iYnRuUHJvZmlsZUNvbnRpbnVlIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5Db250aW51ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0
+eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2xlZnQiPjEwPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9u
+X2NvbnRpbnVlIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAg
+ICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgICAgICAgIDxj
+bGFzcyBuYW1lPSJidG4tcHJpbWFyeSIvPgogICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAg
+ICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8
+c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1lPSJhY3Rpb25zIi8+CiAgICAgICAgICAg
+ICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5n
+PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBhY2tfdHlwZSI+ZW5kPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+PC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8c3R5bGU+CiAgICAgICAg
+ICAgICAgPGNsYXNzIG5hbWU9IndpbmRvdy1hY3Rpb25zIi8+CiAgICAgICAgICAgICAgPGNsYXNzIG5h
+bWU9ImJvcmRlcmVkIi8+CiAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICA8L29iamVjdD4KICAg
+ICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwv
+cHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAg
+IDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAg
+PC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
+`,
+	},
+
+	"/definitions/ScheduledMeetingsWindow.xml": {
+		local:   "definitions/ScheduledMeetingsWindow.xml",
+		size:    16868,
+		modtime: 1489449600,
+		compressed: `
+PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPCEtLSBHZW5lcmF0ZWQgd2l0aCBn
+bGFkZSAzLjIyLjIgLS0+CjxpbnRlcmZhY2U+CiAgPHJlcXVpcmVzIGxpYj0iZ3RrKyIgdmVyc2lvbj0i
+My4xOCIvPgogIDxvYmplY3QgY2xhc3M9Ikd0a0xpc3RTdG9yZSIgaWQ9InNjaGVkdWxlZE1lZXRpbmdz
+U3RvcmUiPgogICAgPGNvbHVtbnM+CiAgICAgIDwhLS0gY29sdW1uLW5hbWUgdGl0bGUgLS0+CiAgICAg
+IDxjb2x1bW4gdHlwZT0iZ2NoYXJhcnJheSIvPgogICAgICA8IS0tIGNvbHVtbi1uYW1lIHN0YXJ0IC0t
+PgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPCEtLSBjb2x1bW4tbmFtZSBt
+ZWV0aW5nIC0tPgogICAgICA8Y29sdW1uIHR5cGU9ImdjaGFyYXJyYXkiLz4KICAgICAgPCEtLSBjb2x1
+bW4tbmFtZSBpZCAtLT4KICAgICAgPGNvbHVtbiB0eXBlPSJnY2hhcmFycmF5Ii8+CiAgICA8L2NvbHVt
+bnM+CiAgPC9vYmplY3Q+CiAgPG9iamVjdCBjbGFzcz0iR3RrV2luZG93IiBpZD0ic2NoZWR1bGVkTWVl
+dGluZ3NXaW5kb3ciPgogICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5
+PgogICAgPHByb3BlcnR5IG5hbWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+U2NoZWR1bGVkIG1l
+ZXRpbmdzPC9wcm9wZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJyZXNpemFibGUiPkZhbHNlPC9wcm9w
+ZXJ0eT4KICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RhbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICA8cHJvcGVy
+dHkgbmFtZT0id2luZG93X3Bvc2l0aW9uIj5jZW50ZXI8L3Byb3BlcnR5PgogICAgPHByb3BlcnR5IG5h
+bWU9ImRlZmF1bHRfd2lkdGgiPjYwMDwvcHJvcGVydHk+CiAgICA8cHJvcGVydHkgbmFtZT0idHlwZV9o
+aW50Ij5kaWFsb2c8L3Byb3BlcnR5PgogICAgPHNpZ25hbCBuYW1lPSJkZXN0cm95IiBoYW5kbGVyPSJv
+bl9jbG9zZV93aW5kb3dfc2lnbmFsIiBzd2FwcGVkPSJubyIvPgogICAgPGNoaWxkIHR5cGU9InRpdGxl
+YmFyIj4KICAgICAgPHBsYWNlaG9sZGVyLz4KICAgIDwvY2hpbGQ+CiAgICA8Y2hpbGQ+CiAgICAgIDxv
+YmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0
+eT4KICAgICAgICA8cHJvcGVydHkgbmFtZT0ib3JpZW50YXRpb24iPnZlcnRpY2FsPC9wcm9wZXJ0eT4K
+ICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtCb3giPgogICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5
+IG5hbWU9Im1hcmdpbl9sZWZ0Ij4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJtYXJnaW5fcmlnaHQiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1h
+cmdpbl90b3AiPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9i
+b3R0b20iPjIwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im9yaWVudGF0aW9u
+Ij52ZXJ0aWNhbDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzcGFjaW5nIj4x
+MDwvcHJvcGVydHk+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNz
+PSJHdGtMYWJlbCIgaWQ9ImxibERlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBu
+YW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPkEgc2NoZWR1bGVkIG1lZXRpbmcga2VlcHMgaXRzIG1l
+ZXRpbmcgSUQsIHNvIHlvdSBjYW4gc2VuZCB0aGUgaW52aXRhdGlvbnMgZGF5cyBiZWZvcmUgaXQgc3Rh
+cnRzLiBXYWhheSB3aWxsIG9mZmVyIHRvIHN0YXJ0IHRoZSBtZWV0aW5nIHdoZW4gdGhlIHRpbWUgYXJy
+aXZlcy48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9IndyYXAiPlRydWU8
+L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1heF93aWR0aF9jaGFycyI+
+NzA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InhhbGlnbiI+MDwvcHJv
+cGVydHk+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAgIDxjbGFzcyBuYW1l
+PSJsYWJlbC10ZXh0Ii8+CiAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgICAgIDwvb2Jq
+ZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImZp
+bGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9u
+Ij4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgIDwvY2hpbGQ+
+CiAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtHcmlkIj4K
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyb3dfc3BhY2luZyI+NjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY29sdW1uX3NwYWNpbmciPjEwPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrTGFi
+ZWwiIGlkPSJsYmxTY2hlZHVsZVRpdGxlIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0
+eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRpdGxlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2giPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9ImlucFNjaGVk
+dWxlVGl0bGUiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGV4cGFuZCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBsYWNlaG9s
+ZGVyX3RleHQiIHRyYW5zbGF0YWJsZT0ieWVzIj5OYW1lIG9mIHRoZSBtZWV0aW5nPC9wcm9wZXJ0eT4K
+ICAgICAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MTwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2giPjA8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAg
+ICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtMYWJlbCIg
+aWQ9ImxibFNjaGVkdWxlU3RhcnQiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2
+aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+Y2FuX2ZvY3VzIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+U3RhcnRzIGF0PC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ieGFsaWduIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJsZWZ0X2F0dGFjaCI+MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InRvcF9hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICA8L3BhY2tpbmc+CiAgICAgICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICAgICAgPGNo
+aWxkPgogICAgICAgICAgICAgICAgICA8b2JqZWN0IGNsYXNzPSJHdGtFbnRyeSIgaWQ9ImlucFNjaGVk
+dWxlU3RhcnQiPgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVl
+PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iY2FuX2ZvY3VzIj5U
+cnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icGxhY2Vob2xk
+ZXJfdGV4dCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPllZWVktTU0tREQgSEg6TU08L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+MTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0xhYmVsIiBpZD0i
+bGJsU2NoZWR1bGVEdXJhdGlvbiI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5EdXJhdGlvbiAobWludXRlcyk8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4YWxpZ24iPjA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+MjwvcHJvcGVydHk+CiAgICAg
+ICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAg
+ICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0i
+aW5wU2NoZWR1bGVEdXJhdGlvbiI+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZp
+c2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJj
+YW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1l
+PSJ0ZXh0Ij42MDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9h
+dHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3Bf
+YXR0YWNoIj4yPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgIDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxTY2hlZHVsZVBhc3N3b3JkIj4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idmlzaWJsZSI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPk1lZXRp
+bmcgcGFzc3dvcmQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ4
+YWxpZ24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAg
+ICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0
+YWNoIj4wPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0
+dGFjaCI+MzwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAg
+ICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgIDxvYmpl
+Y3QgY2xhc3M9Ikd0a0VudHJ5IiBpZD0iaW5wU2NoZWR1bGVQYXNzd29yZCI+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwbGFjZWhvbGRlcl90ZXh0IiB0cmFuc2xhdGFibGU9Inll
+cyI+T3B0aW9uYWw8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iYWN0
+aXZhdGUiIGhhbmRsZXI9Im9uX3NjaGVkdWxlX21lZXRpbmciIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAg
+ICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGVmdF9hdHRhY2giPjE8L3Byb3BlcnR5PgogICAgICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0b3BfYXR0YWNoIj4zPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAg
+IDxjaGlsZD4KICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRu
+U2NoZWR1bGVNZWV0aW5nIj4KICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibGFiZWwi
+IHRyYW5zbGF0YWJsZT0ieWVzIj5TY2hlZHVsZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAg
+PHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAg
+IDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5lbmQ8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxzaWduYWwgbmFtZT0iY2xpY2tlZCIgaGFuZGxlcj0ib25fc2NoZWR1bGVfbWVl
+dGluZyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAg
+ICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICAgICAgICA8Y2xhc3Mg
+bmFtZT0iYnRuLXByaW1hcnkiLz4KICAgICAgICAgICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAg
+ICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImxlZnRfYXR0YWNoIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0idG9wX2F0dGFjaCI+NDwvcHJvcGVydHk+CiAgICAgICAgICAg
+ICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgPC9v
+YmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0i
+ZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9zaXRp
+b24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9jaGls
+ZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a1Njcm9s
+bGVkV2luZG93Ij4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3By
+b3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1pbl9jb250ZW50X2hlaWdodCI+
+MTUwPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzaGFkb3dfdHlwZSI+
+aW48L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICA8b2Jq
+ZWN0IGNsYXNzPSJHdGtUcmVlVmlldyIgaWQ9InRyZWVTY2hlZHVsZWRNZWV0aW5ncyI+CiAgICAgICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPlRydWU8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtb2RlbCI+c2NoZWR1bGVkTWVldGluZ3NTdG9y
+ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkIGludGVybmFsLWNoaWxkPSJzZWxl
+Y3Rpb24iPgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVNlbGVjdGlv
+biIvPgogICAgICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxk
+PgogICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4iIGlk
+PSJjb2x1bW5TY2hlZHVsZVRpdGxlIj4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5h
+bWU9InRpdGxlIiB0cmFuc2xhdGFibGU9InllcyI+VGl0bGU8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xh
+c3M9Ikd0a0NlbGxSZW5kZXJlclRleHQiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmli
+dXRlcz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idGV4dCI+MDwv
+YXR0cmlidXRlPgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAg
+ICAgICAgICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAg
+ICAgICAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAg
+ICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4iIGlkPSJjb2x1bW5T
+Y2hlZHVsZVN0YXJ0Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InRpdGxl
+IiB0cmFuc2xhdGFibGU9InllcyI+U3RhcnRzIGF0PC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0Nl
+bGxSZW5kZXJlclRleHQiLz4KICAgICAgICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlcz4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICAgIDxhdHRyaWJ1dGUgbmFtZT0idGV4dCI+MTwvYXR0cmlidXRl
+PgogICAgICAgICAgICAgICAgICAgICAgICAgIDwvYXR0cmlidXRlcz4KICAgICAgICAgICAgICAgICAg
+ICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAg
+ICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgICAg
+ICAgICAgPG9iamVjdCBjbGFzcz0iR3RrVHJlZVZpZXdDb2x1bW4iIGlkPSJjb2x1bW5TY2hlZHVsZU1l
+ZXRpbmdJRCI+CiAgICAgICAgICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ0aXRsZSIgdHJh
+bnNsYXRhYmxlPSJ5ZXMiPk1lZXRpbmcgSUQ8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQ2VsbFJl
+bmRlcmVyVGV4dCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZWxs
+aXBzaXplIj5taWRkbGU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9IndpZHRoX2NoYXJzIj4yMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICAgICAgICAg
+ICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgICAgICAgICAgICAgPGF0dHJpYnV0ZXM+CiAgICAgICAg
+ICAgICAgICAgICAgICAgICAgICA8YXR0cmlidXRlIG5hbWU9InRleHQiPjI8L2F0dHJpYnV0ZT4KICAg
+ICAgICAgICAgICAgICAgICAgICAgICA8L2F0dHJpYnV0ZXM+CiAgICAgICAgICAgICAgICAgICAgICAg
+IDwvY2hpbGQ+CiAgICAgICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgICAg
+ICA8L2NoaWxkPgogICAgICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgICAgICAgIDwvY2hp
+bGQ+CiAgICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAg
+ICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAg
+ICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxw
+cm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVydHk+CiAgICAgICAgICAgICAgPC9wYWNraW5n
+PgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9i
+amVjdCBjbGFzcz0iR3RrTGFiZWwiIGlkPSJsYmxNZXNzYWdlIj4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9w
+ZXJ0eSBuYW1lPSJsYWJlbCIgdHJhbnNsYXRhYmxlPSJ5ZXMiPlRoZSBjYWxlbmRhciBmaWxlIGhhcyBi
+ZWVuIHNhdmVkPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJzZWxlY3Rh
+YmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAg
+ICAgPGNsYXNzIG5hbWU9ImxhYmVsLXN1Y2Nlc3MiLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAg
+ICAgICAgICAgICAgPC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAg
+ICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8
+cHJvcGVydHkgbmFtZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0icG9zaXRpb24iPjM8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICAgICAgPC9jaGlsZD4KICAgICAgICAgICAgPHN0eWxlPgogICAgICAgICAgICAgIDxjbGFzcyBu
+YW1lPSJ3aW5kb3ctY29udGVudCIvPgogICAgICAgICAgICA8L3N0eWxlPgogICAgICAgICAgPC9vYmpl
+Y3Q+CiAgICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+
+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9w
+ZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InBvc2l0aW9uIj4wPC9wcm9wZXJ0eT4KICAg
+ICAgICAgIDwvcGFja2luZz4KICAgICAgICA8L2NoaWxkPgogICAgICAgIDxjaGlsZD4KICAgICAgICAg
+IDxvYmplY3QgY2xhc3M9Ikd0a0JveCI+CiAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxl
+Ij5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFs
+c2U8L3Byb3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iaGFsaWduIj5jZW50ZXI8L3By
+b3BlcnR5PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX2JvdHRvbSI+MjA8L3Byb3Bl
+cnR5PgogICAgICAgICAgICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0
+dG9uIiBpZD0iYnRuRXhwb3J0Q2FsZW5kYXIiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9
+ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+QWRkIHRvIENhbGVuZGFyPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9leHBvcnRfY2FsZW5k
+YXIiIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9z
+aXRpb24iPjA8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1
+dHRvbiIgaWQ9ImJ0blN0YXJ0U2NoZWR1bGVkTWVldGluZyI+CiAgICAgICAgICAgICAgICA8cHJvcGVy
+dHkgbmFtZT0ibGFiZWwiIHRyYW5zbGF0YWJsZT0ieWVzIj5TdGFydCBOb3c8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUiPlRydWU8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAg
+ICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2RlZmF1bHQiPlRydWU8L3Byb3BlcnR5Pgog
+ICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2luX3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAg
+ICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQiIGhhbmRsZXI9Im9uX3N0YXJ0X21lZXRp
+bmciIHN3YXBwZWQ9Im5vIi8+CiAgICAgICAgICAgICAgICA8c3R5bGU+CiAgICAgICAgICAgICAgICAg
+IDxjbGFzcyBuYW1lPSJidG4iLz4KICAgICAgICAgICAgICAgIDwvc3R5bGU+CiAgICAgICAgICAgICAg
+PC9vYmplY3Q+CiAgICAgICAgICAgICAgPHBhY2tpbmc+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkg
+bmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFt
+ZT0iZmlsbCI+VHJ1ZTwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0icG9z
+aXRpb24iPjE8L3Byb3BlcnR5PgogICAgICAgICAgICAgIDwvcGFja2luZz4KICAgICAgICAgICAgPC9j
+aGlsZD4KICAgICAgICAgICAgPGNoaWxkPgogICAgICAgICAgICAgIDxvYmplY3QgY2xhc3M9Ikd0a0J1
+dHRvbiIgaWQ9ImJ0blJlbW92ZVNjaGVkdWxlZE1lZXRpbmciPgogICAgICAgICAgICAgICAgPHByb3Bl
+cnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9InllcyI+UmVtb3ZlPC9wcm9wZXJ0eT4KICAgICAg
+ICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJ2aXNpYmxlIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJjYW5fZm9jdXMiPkZhbHNlPC9wcm9wZXJ0eT4KICAgICAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJyZWNlaXZlc19kZWZhdWx0Ij5UcnVlPC9wcm9wZXJ0eT4KICAg
+ICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJtYXJnaW5fbGVmdCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdpbl9yaWdodCI+MTA8L3Byb3BlcnR5PgogICAg
+ICAgICAgICAgICAgPHNpZ25hbCBuYW1lPSJjbGlja2VkIiBoYW5kbGVyPSJvbl9yZW1vdmVfbWVldGlu
+ZyIgc3dhcHBlZD0ibm8iLz4KICAgICAgICAgICAgICAgIDxzdHlsZT4KICAgICAgICAgICAgICAgICAg
+PGNsYXNzIG5hbWU9ImJ0biIvPgogICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuLWRhbmdl
+ciIvPgogICAgICAgICAgICAgICAgPC9zdHlsZT4KICAgICAgICAgICAgICA8L29iamVjdD4KICAgICAg
+ICAgICAgICA8cGFja2luZz4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJleHBhbmQiPkZh
+bHNlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9w
+cm9wZXJ0eT4KICAgICAgICAgICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJwb3NpdGlvbiI+MjwvcHJvcGVy
+dHk+CiAgICAgICAgICAgICAgPC9wYWNraW5nPgogICAgICAgICAgICA8L2NoaWxkPgogICAgICAgICAg
+ICA8Y2hpbGQ+CiAgICAgICAgICAgICAgPG9iamVjdCBjbGFzcz0iR3RrQnV0dG9uIiBpZD0iYnRuQ2xv
+c2UiPgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImxhYmVsIiB0cmFuc2xhdGFibGU9Inll
+cyI+Q2xvc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InZpc2libGUi
+PlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9ImNhbl9mb2N1cyI+
+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9InJlY2VpdmVzX2Rl
+ZmF1bHQiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHByb3BlcnR5IG5hbWU9Im1hcmdp
+bl9sZWZ0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0ibWFyZ2lu
+X3JpZ2h0Ij4xMDwvcHJvcGVydHk+CiAgICAgICAgICAgICAgICA8c2lnbmFsIG5hbWU9ImNsaWNrZWQi
+IGhhbmRsZXI9Im9uX2Nsb3NlIiBzd2FwcGVkPSJubyIvPgogICAgICAgICAgICAgICAgPHN0eWxlPgog
+ICAgICAgICAgICAgICAgICA8Y2xhc3MgbmFtZT0iYnRuIi8+CiAgICAgICAgICAgICAgICA8L3N0eWxl
+PgogICAgICAgICAgICAgIDwvb2JqZWN0PgogICAgICAgICAgICAgIDxwYWNraW5nPgogICAgICAgICAg
+ICAgICAgPHByb3BlcnR5IG5hbWU9ImV4cGFuZCI+RmFsc2U8L3Byb3BlcnR5PgogICAgICAgICAgICAg
+ICAgPHByb3BlcnR5IG5hbWU9ImZpbGwiPlRydWU8L3Byb3BlcnR5PgogICAgICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4zPC9wcm9wZXJ0eT4KICAgICAgICAgICAgICA8L3BhY2tpbmc+
+CiAgICAgICAgICAgIDwvY2hpbGQ+CiAgICAgICAgICA8L29iamVjdD4KICAgICAgICAgIDxwYWNraW5n
+PgogICAgICAgICAgICA8cHJvcGVydHkgbmFtZT0iZXhwYW5kIj5GYWxzZTwvcHJvcGVydHk+CiAgICAg
+ICAgICAgIDxwcm9wZXJ0eSBuYW1lPSJmaWxsIj5UcnVlPC9wcm9wZXJ0eT4KICAgICAgICAgICAgPHBy
+b3BlcnR5IG5hbWU9InBvc2l0aW9uIj4xPC9wcm9wZXJ0eT4KICAgICAgICAgIDwvcGFja2luZz4KICAg
+ICAgICA8L2NoaWxkPgogICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmplY3Q+CjwvaW50
+ZXJmYWNlPgo=
+`,
+	},
+
 	"/definitions/StartHostingWindow.xml": {
 		local:   "definitions/StartHostingWindow.xml",
 		size:    21345,
@@ -7298,6 +9598,24 @@ ICAgICA8L29iamVjdD4KICAgIDwvY2hpbGQ+CiAgPC9vYmplY3Q+CjwvaW50ZXJmYWNlPgo=
 `,
 	},
 
+	"/images/calendar.svg": {
+		local:   "images/calendar.svg",
+		size:    553,
+		modtime: 1489449600,
+		compressed: `
+PHN2ZyB3aWR0aD0iMTIwIiBoZWlnaHQ9IjEyMCIgdmlld0JveD0iMCAwIDEyMCAxMjAiIGZpbGw9Im5v
+bmUiIHhtbG5zPSJodHRwOi8vd3d3LnczLm9yZy8yMDAwL3N2ZyI+CjxwYXRoIGZpbGwtcnVsZT0iZXZl
+bm9kZCIgY2xpcC1ydWxlPSJldmVub2RkIiBkPSJNMzMgMEMzNy4xNDIxIDAgNDAuNSAzLjM1Nzg2IDQw
+LjUgNy41VjE1SDc5LjVWNy41Qzc5LjUgMy4zNTc4NiA4Mi44NTc5IDAgODcgMEM5MS4xNDIxIDAgOTQu
+NSAzLjM1Nzg2IDk0LjUgNy41VjE1SDEwNUMxMTMuMjg0IDE1IDEyMCAyMS43MTU3IDEyMCAzMFYxMDVD
+MTIwIDExMy4yODQgMTEzLjI4NCAxMjAgMTA1IDEyMEgxNUM2LjcxNTczIDEyMCAwIDExMy4yODQgMCAx
+MDVWMzBDMCAyMS43MTU3IDYuNzE1NzMgMTUgMTUgMTVIMjUuNVY3LjVDMjUuNSAzLjM1Nzg2IDI4Ljg1
+NzkgMCAzMyAwWk0xNSA0NVYxMDVIMTA1VjQ1SDE1Wk0zMCA2MEg1MFY3OEgzMFY2MFpNNzAgNjBIOTBW
+NzhINzBWNjBaTTMwIDg3SDUwVjk3SDMwVjg3Wk03MCA4N0g5MFY5N0g3MFY4N1oiIGZpbGw9IiMxQTIw
+MkMiLz4KPC9zdmc+Cg==
+`,
+	},
+
 	"/images/email.png": {
 		local:   "images/email.png",
 		size:    15696,
@@ -17003,7 +19321,7 @@ FoEOFGTqYz9ohX00lvzNGxMbbTdi9eNRu7BW72vot8I6aYtL1FgMbLENuXhxXjZtahDaQP4IdMAhaZV/
 
 	"/styles/gui.css": {
 		local:   "styles/gui.css",
-		size:    17198,
+		size:    17649,
 		modtime: 1489449600,
 		compressed: `
 LmJveC1zaGFkb3cgewogIGJveC1zaGFkb3c6IDAgMXB4IDFweCByZ2JhKDAsIDAsIDAsIDAuMSk7IH0K
@@ -17233,66 +19551,74 @@ CiAgYm9yZGVyLXdpZHRoOiAwOwogIHBhZGRpbmc6IDEwcHggMjBweDsKICBiYWNrZ3JvdW5kOiAjMWEy
 MDJjOwogIGJhY2tncm91bmQtaW1hZ2U6IGxpbmVhci1ncmFkaWVudCgtMTgwZGVnLCAjMTQxOTIyIDAl
 LCBibGFjayA5MCUpOyB9CiAgLm1haW4td2luZG93LXN0YXR1cy1iYXIuZXJyb3IgewogICAgYmFja2dy
 b3VuZDogIzc0MmEyYTsKICAgIGJhY2tncm91bmQtaW1hZ2U6IGxpbmVhci1ncmFkaWVudCgtMTgwZGVn
-LCAjZjQ1NzU3IDAlLCAjNjkyNjI2IDkwJSk7IH0KICAubWFpbi13aW5kb3ctc3RhdHVzLWJhciAuc3Rh
-dHVzLWxhYmVsIHsKICAgIGZvbnQtc2l6ZTogMThweDsgfQogIC5tYWluLXdpbmRvdy1zdGF0dXMtYmFy
-IC5zdGF0dXMtc2hvdy1lcnJvcnMgewogICAgY29sb3I6ICNmZmY7CiAgICBmb250LXdlaWdodDogNDAw
-OwogICAgZm9udC1zaXplOiAxOHB4OwogICAgcGFkZGluZzogNXB4IDIwcHg7CiAgICBib3JkZXItcmFk
-aXVzOiAxMGVtOwogICAgYm9yZGVyOiAycHggc29saWQgcmdiYSgyNTUsIDI1NSwgMjU1LCAwLjEpOyB9
-CiAgICAubWFpbi13aW5kb3ctc3RhdHVzLWJhciAuc3RhdHVzLXNob3ctZXJyb3JzOmhvdmVyIHsKICAg
-ICAgY29sb3I6ICNmZmY7CiAgICAgIGJhY2tncm91bmQ6ICM5YjJjMmM7CiAgICAgIGJvcmRlci1jb2xv
-cjogIzliMmMyYzsgfQoKLmhlbHAtY29udGVudCB7CiAgcGFkZGluZzogMjBweDsgfQoKLmhlbHAtdGl0
-bGUsIC5oZWxwLXByaW1hcnkgewogIGZvbnQtc2l6ZTogMjBweDsgfQoKLmhlbHAtcHJpbWFyeSB7CiAg
-Zm9udC13ZWlnaHQ6IDYwMDsKICBmb250LXNpemU6IDIwcHg7IH0KCi5oZWxwLXRleHQgewogIGZvbnQt
-c2l6ZTogMThweDsgfQoKLmxvYWRpbmctd2luZG93IHsKICBjb2xvcjogIzAwMDsKICBiYWNrZ3JvdW5k
-OiAjZmZmOwogIGZvbnQtc2l6ZTogMThweDsgfQoKLmludml0ZS1lbWFpbC1saW5rIHsKICBib3JkZXIt
-cmFkaXVzOiAxMDAlOwogIHBhZGRpbmc6IDA7IH0KCi5pbnZpdGUtd2luZG93LWJvdHRvbSB7CiAgcGFk
-ZGluZzogMjBweDsKICBib3JkZXItdG9wOiAxcHggc29saWQgI2VkZjJmNzsgfQoKLmludml0ZS13aW5k
-b3ctYnRuIHsKICBwYWRkaW5nLWxlZnQ6IDIwcHg7CiAgcGFkZGluZy1yaWdodDogMjBweDsgfQoKLmhv
-c3QtbWVldGluZy10b29sYmFyIHsKICBiYWNrZ3JvdW5kOiAjYzZmNmQ1OwogIGJveC1zaGFkb3c6IDAg
-MnB4IDRweCByZ2JhKDAsIDAsIDAsIDAuMTIpOwogIHBhZGRpbmc6IDIwcHg7CiAgZm9udC1zaXplOiAy
-MHB4OwogIGNvbG9yOiAjMjI1NDNkOwogIGJvcmRlci1ib3R0b206IDJweCBzb2xpZCAjZmZmOyB9CiAg
-Lmhvc3QtbWVldGluZy10b29sYmFyIC5tZXNzYWdlIHsKICAgIGZvbnQtd2VpZ2h0OiA1MDA7IH0KCndp
-bmRvdy5tZWV0aW5nLWNvbnRyb2xzIHsKICBib3JkZXItcmFkaXVzOiAycHg7IH0KICB3aW5kb3cubWVl
-dGluZy1jb250cm9scyAudG9wIHsKICAgIGJhY2tncm91bmQ6ICNmMGZmZjQ7CiAgICBjb2xvcjogIzI3
-Njc0OTsKICAgIGZvbnQtd2VpZ2h0OiA1MDA7CiAgICBmb250LXNpemU6IDEwcHg7CiAgICBib3gtc2hh
-ZG93OiAwIDFweCAycHggcmdiYSgwLCAwLCAwLCAwLjEyKTsKICAgIHBhZGRpbmc6IDIwcHg7IH0KICAg
-IHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xzIC50b3AgLnRleHQgewogICAgICBmb250LXdlaWdodDogNTAw
-OwogICAgICBmb250LXNpemU6IDIxcHg7IH0KICB3aW5kb3cubWVldGluZy1jb250cm9scyAuY29udGVu
-dCB7CiAgICBwYWRkaW5nOiAyMHB4OyB9CiAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLmJ1dHRvbnMg
-ewogICAgYmFja2dyb3VuZDogI2VkZjJmNzsKICAgIHBhZGRpbmc6IDIwcHg7IH0KICAgIHdpbmRvdy5t
-ZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5jb250cm9sLWxlYXZlLWNhbGwsIHdpbmRvdy5tZWV0aW5n
-LWNvbnRyb2xzIC5idXR0b25zIC5jb250cm9sLWZpbmlzaC1jYWxsIHsKICAgICAgcGFkZGluZzogMTMu
-MzMzMzMzMzMzM3B4IDIwcHg7CiAgICAgIGZvbnQtc2l6ZTogMjBweDsKICAgICAgZm9udC13ZWlnaHQ6
-IDUwMDsKICAgICAgYm9yZGVyLXJhZGl1czogNHB4OwogICAgICB0ZXh0LXNoYWRvdzogbm9uZTsKICAg
-ICAgYm9yZGVyOiAycHggc29saWQgdHJhbnNwYXJlbnQ7IH0KICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRy
-b2xzIC5idXR0b25zIC5jb250cm9sLWxlYXZlLWNhbGwgewogICAgICBjb2xvcjogI2ZmZjsKICAgICAg
-YmFja2dyb3VuZDogI2RkNmIyMDsKICAgICAgYm9yZGVyLWNvbG9yOiAjZGQ2YjIwOyB9CiAgICAgIHdp
-bmRvdy5tZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5jb250cm9sLWxlYXZlLWNhbGw6Zm9jdXMgewog
-ICAgICAgIGJveC1zaGFkb3c6IDAgMCAwIDAuMmVtIHJnYmEoMjM3LCAxMzcsIDU0LCAwLjQpOyB9CiAg
-ICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5jb250cm9sLWxlYXZlLWNhbGw6aG92
-ZXIgewogICAgICAgIGJhY2tncm91bmQ6ICNkNDY3MWY7CiAgICAgICAgYm9yZGVyLWNvbG9yOiAjZDQ2
-NzFmOyB9CiAgICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5jb250cm9sLWxlYXZl
-LWNhbGw6YWN0aXZlIHsKICAgICAgICBiYWNrZ3JvdW5kOiAjZDI2ODFhOwogICAgICAgIGJvcmRlci1j
-b2xvcjogI2Q0NjcxZjsKICAgICAgICBib3gtc2hhZG93OiBpbnNldCAwIDAuMTVlbSAwLjNlbSByZ2Jh
-KDAsIDAsIDAsIDAuMTUpOyB9CiAgICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5j
-b250cm9sLWxlYXZlLWNhbGw6ZGlzYWJsZWQgewogICAgICAgIGNvbG9yOiByZ2JhKDI1NSwgMjU1LCAy
-NTUsIDAuNjUpOwogICAgICAgIGJhY2tncm91bmQ6ICNlZWI1OTA7CiAgICAgICAgYm9yZGVyLWNvbG9y
-OiB0cmFuc3BhcmVudDsKICAgICAgICBib3gtc2hhZG93OiBub25lOyB9CiAgICB3aW5kb3cubWVldGlu
-Zy1jb250cm9scyAuYnV0dG9ucyAuY29udHJvbC1maW5pc2gtY2FsbCB7CiAgICAgIGNvbG9yOiAjZmZm
-OwogICAgICBiYWNrZ3JvdW5kOiAjYzUzMDMwOwogICAgICBib3JkZXItY29sb3I6ICNjNTMwMzA7IH0K
+LCAjZjQ1NzU3IDAlLCAjNjkyNjI2IDkwJSk7IH0KICAubWFpbi13aW5kb3ctc3RhdHVzLWJhci53YXJu
+aW5nIHsKICAgIGJhY2tncm91bmQ6ICM3YjM0MWU7CiAgICBiYWNrZ3JvdW5kLWltYWdlOiBsaW5lYXIt
+Z3JhZGllbnQoLTE4MGRlZywgI2VjODEyOCAwJSwgIzZmMmYxYiA5MCUpOyB9CiAgLm1haW4td2luZG93
+LXN0YXR1cy1iYXIgLnN0YXR1cy1sYWJlbCB7CiAgICBmb250LXNpemU6IDE4cHg7IH0KICAubWFpbi13
+aW5kb3ctc3RhdHVzLWJhciAuc3RhdHVzLXNob3ctZXJyb3JzIHsKICAgIGNvbG9yOiAjZmZmOwogICAg
+Zm9udC13ZWlnaHQ6IDQwMDsKICAgIGZvbnQtc2l6ZTogMThweDsKICAgIHBhZGRpbmc6IDVweCAyMHB4
+OwogICAgYm9yZGVyLXJhZGl1czogMTBlbTsKICAgIGJvcmRlcjogMnB4IHNvbGlkIHJnYmEoMjU1LCAy
+NTUsIDI1NSwgMC4xKTsgfQogICAgLm1haW4td2luZG93LXN0YXR1cy1iYXIgLnN0YXR1cy1zaG93LWVy
+cm9yczpob3ZlciB7CiAgICAgIGNvbG9yOiAjZmZmOwogICAgICBiYWNrZ3JvdW5kOiAjOWIyYzJjOwog
+ICAgICBib3JkZXItY29sb3I6ICM5YjJjMmM7IH0KCi5oZWxwLWNvbnRlbnQgewogIHBhZGRpbmc6IDIw
+cHg7IH0KCi5oZWxwLXRpdGxlLCAuaGVscC1wcmltYXJ5IHsKICBmb250LXNpemU6IDIwcHg7IH0KCi5o
+ZWxwLXByaW1hcnkgewogIGZvbnQtd2VpZ2h0OiA2MDA7CiAgZm9udC1zaXplOiAyMHB4OyB9CgouaGVs
+cC10ZXh0IHsKICBmb250LXNpemU6IDE4cHg7IH0KCi5sb2FkaW5nLXdpbmRvdyB7CiAgY29sb3I6ICMw
+MDA7CiAgYmFja2dyb3VuZDogI2ZmZjsKICBmb250LXNpemU6IDE4cHg7IH0KCi5pbnZpdGUtZW1haWwt
+bGluayB7CiAgYm9yZGVyLXJhZGl1czogMTAwJTsKICBwYWRkaW5nOiAwOyB9CgouaW52aXRlLXdpbmRv
+dy1ib3R0b20gewogIHBhZGRpbmc6IDIwcHg7CiAgYm9yZGVyLXRvcDogMXB4IHNvbGlkICNlZGYyZjc7
+IH0KCi5pbnZpdGUtd2luZG93LWJ0biB7CiAgcGFkZGluZy1sZWZ0OiAyMHB4OwogIHBhZGRpbmctcmln
+aHQ6IDIwcHg7IH0KCi5ob3N0LW1lZXRpbmctdG9vbGJhciB7CiAgYmFja2dyb3VuZDogI2M2ZjZkNTsK
+ICBib3gtc2hhZG93OiAwIDJweCA0cHggcmdiYSgwLCAwLCAwLCAwLjEyKTsKICBwYWRkaW5nOiAyMHB4
+OwogIGZvbnQtc2l6ZTogMjBweDsKICBjb2xvcjogIzIyNTQzZDsKICBib3JkZXItYm90dG9tOiAycHgg
+c29saWQgI2ZmZjsgfQogIC5ob3N0LW1lZXRpbmctdG9vbGJhciAubWVzc2FnZSB7CiAgICBmb250LXdl
+aWdodDogNTAwOyB9Cgp3aW5kb3cubWVldGluZy1jb250cm9scyB7CiAgYm9yZGVyLXJhZGl1czogMnB4
+OyB9CiAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLnRvcCB7CiAgICBiYWNrZ3JvdW5kOiAjZjBmZmY0
+OwogICAgY29sb3I6ICMyNzY3NDk7CiAgICBmb250LXdlaWdodDogNTAwOwogICAgZm9udC1zaXplOiAx
+MHB4OwogICAgYm94LXNoYWRvdzogMCAxcHggMnB4IHJnYmEoMCwgMCwgMCwgMC4xMik7CiAgICBwYWRk
+aW5nOiAyMHB4OyB9CiAgICB3aW5kb3cubWVldGluZy1jb250cm9scyAudG9wIC50ZXh0IHsKICAgICAg
+Zm9udC13ZWlnaHQ6IDUwMDsKICAgICAgZm9udC1zaXplOiAyMXB4OyB9CiAgICB3aW5kb3cubWVldGlu
+Zy1jb250cm9scyAudG9wIC5zdGF0dXMgewogICAgICBjb2xvcjogIzJmODU1YTsKICAgICAgZm9udC1z
+aXplOiAxMnB4OwogICAgICBwYWRkaW5nLXRvcDogNXB4OyB9CiAgd2luZG93Lm1lZXRpbmctY29udHJv
+bHMgLmNvbnRlbnQgewogICAgcGFkZGluZzogMjBweDsgfQogIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xz
+IC5jaGF0IHsKICAgIHBhZGRpbmc6IDEwcHggMjBweDsgfQogICAgd2luZG93Lm1lZXRpbmctY29udHJv
+bHMgLmNoYXQgLnN0YXR1cyB7CiAgICAgIGNvbG9yOiAjNGE1NTY4OwogICAgICBmb250LXNpemU6IDEy
+cHg7IH0KICB3aW5kb3cubWVldGluZy1jb250cm9scyAuYnV0dG9ucyB7CiAgICBiYWNrZ3JvdW5kOiAj
+ZWRmMmY3OwogICAgcGFkZGluZzogMjBweDsgfQogICAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLmJ1
+dHRvbnMgLmNvbnRyb2wtdG9nZ2xlLCB3aW5kb3cubWVldGluZy1jb250cm9scyAuYnV0dG9ucyAuY29u
+dHJvbC1sZWF2ZS1jYWxsLCB3aW5kb3cubWVldGluZy1jb250cm9scyAuYnV0dG9ucyAuY29udHJvbC1m
+aW5pc2gtY2FsbCB7CiAgICAgIHBhZGRpbmc6IDEzLjMzMzMzMzMzMzNweCAyMHB4OwogICAgICBmb250
+LXNpemU6IDIwcHg7CiAgICAgIGZvbnQtd2VpZ2h0OiA1MDA7CiAgICAgIGJvcmRlci1yYWRpdXM6IDRw
+eDsKICAgICAgdGV4dC1zaGFkb3c6IG5vbmU7CiAgICAgIGJvcmRlcjogMnB4IHNvbGlkIHRyYW5zcGFy
+ZW50OyB9CiAgICB3aW5kb3cubWVldGluZy1jb250cm9scyAuYnV0dG9ucyAuY29udHJvbC1sZWF2ZS1j
+YWxsIHsKICAgICAgY29sb3I6ICNmZmY7CiAgICAgIGJhY2tncm91bmQ6ICNkZDZiMjA7CiAgICAgIGJv
+cmRlci1jb2xvcjogI2RkNmIyMDsgfQogICAgICB3aW5kb3cubWVldGluZy1jb250cm9scyAuYnV0dG9u
+cyAuY29udHJvbC1sZWF2ZS1jYWxsOmZvY3VzIHsKICAgICAgICBib3gtc2hhZG93OiAwIDAgMCAwLjJl
+bSByZ2JhKDIzNywgMTM3LCA1NCwgMC40KTsgfQogICAgICB3aW5kb3cubWVldGluZy1jb250cm9scyAu
+YnV0dG9ucyAuY29udHJvbC1sZWF2ZS1jYWxsOmhvdmVyIHsKICAgICAgICBiYWNrZ3JvdW5kOiAjZDQ2
+NzFmOwogICAgICAgIGJvcmRlci1jb2xvcjogI2Q0NjcxZjsgfQogICAgICB3aW5kb3cubWVldGluZy1j
+b250cm9scyAuYnV0dG9ucyAuY29udHJvbC1sZWF2ZS1jYWxsOmFjdGl2ZSB7CiAgICAgICAgYmFja2dy
+b3VuZDogI2QyNjgxYTsKICAgICAgICBib3JkZXItY29sb3I6ICNkNDY3MWY7CiAgICAgICAgYm94LXNo
+YWRvdzogaW5zZXQgMCAwLjE1ZW0gMC4zZW0gcmdiYSgwLCAwLCAwLCAwLjE1KTsgfQogICAgICB3aW5k
+b3cubWVldGluZy1jb250cm9scyAuYnV0dG9ucyAuY29udHJvbC1sZWF2ZS1jYWxsOmRpc2FibGVkIHsK
+ICAgICAgICBjb2xvcjogcmdiYSgyNTUsIDI1NSwgMjU1LCAwLjY1KTsKICAgICAgICBiYWNrZ3JvdW5k
+OiAjZWViNTkwOwogICAgICAgIGJvcmRlci1jb2xvcjogdHJhbnNwYXJlbnQ7CiAgICAgICAgYm94LXNo
+YWRvdzogbm9uZTsgfQogICAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLmJ1dHRvbnMgLmNvbnRyb2wt
+ZmluaXNoLWNhbGwgewogICAgICBjb2xvcjogI2ZmZjsKICAgICAgYmFja2dyb3VuZDogI2M1MzAzMDsK
+ICAgICAgYm9yZGVyLWNvbG9yOiAjYzUzMDMwOyB9CiAgICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xz
+IC5idXR0b25zIC5jb250cm9sLWZpbmlzaC1jYWxsOmZvY3VzIHsKICAgICAgICBib3gtc2hhZG93OiAw
+IDAgMCAwLjJlbSByZ2JhKDIyOSwgNjIsIDYyLCAwLjQpOyB9CiAgICAgIHdpbmRvdy5tZWV0aW5nLWNv
+bnRyb2xzIC5idXR0b25zIC5jb250cm9sLWZpbmlzaC1jYWxsOmhvdmVyIHsKICAgICAgICBiYWNrZ3Jv
+dW5kOiAjYmQyZTJlOwogICAgICAgIGJvcmRlci1jb2xvcjogI2JkMmUyZTsgfQogICAgICB3aW5kb3cu
+bWVldGluZy1jb250cm9scyAuYnV0dG9ucyAuY29udHJvbC1maW5pc2gtY2FsbDphY3RpdmUgewogICAg
+ICAgIGJhY2tncm91bmQ6ICNjMDI4Mjg7CiAgICAgICAgYm9yZGVyLWNvbG9yOiAjYmQyZTJlOwogICAg
+ICAgIGJveC1zaGFkb3c6IGluc2V0IDAgMC4xNWVtIDAuM2VtIHJnYmEoMCwgMCwgMCwgMC4xNSk7IH0K
 ICAgICAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLmJ1dHRvbnMgLmNvbnRyb2wtZmluaXNoLWNhbGw6
-Zm9jdXMgewogICAgICAgIGJveC1zaGFkb3c6IDAgMCAwIDAuMmVtIHJnYmEoMjI5LCA2MiwgNjIsIDAu
-NCk7IH0KICAgICAgd2luZG93Lm1lZXRpbmctY29udHJvbHMgLmJ1dHRvbnMgLmNvbnRyb2wtZmluaXNo
-LWNhbGw6aG92ZXIgewogICAgICAgIGJhY2tncm91bmQ6ICNiZDJlMmU7CiAgICAgICAgYm9yZGVyLWNv
-bG9yOiAjYmQyZTJlOyB9CiAgICAgIHdpbmRvdy5tZWV0aW5nLWNvbnRyb2xzIC5idXR0b25zIC5jb250
-cm9sLWZpbmlzaC1jYWxsOmFjdGl2ZSB7CiAgICAgICAgYmFja2dyb3VuZDogI2MwMjgyODsKICAgICAg
-ICBib3JkZXItY29sb3I6ICNiZDJlMmU7CiAgICAgICAgYm94LXNoYWRvdzogaW5zZXQgMCAwLjE1ZW0g
-MC4zZW0gcmdiYSgwLCAwLCAwLCAwLjE1KTsgfQogICAgICB3aW5kb3cubWVldGluZy1jb250cm9scyAu
-YnV0dG9ucyAuY29udHJvbC1maW5pc2gtY2FsbDpkaXNhYmxlZCB7CiAgICAgICAgY29sb3I6IHJnYmEo
-MjU1LCAyNTUsIDI1NSwgMC42NSk7CiAgICAgICAgYmFja2dyb3VuZDogI2UyOTg5ODsKICAgICAgICBi
-b3JkZXItY29sb3I6IHRyYW5zcGFyZW50OwogICAgICAgIGJveC1zaGFkb3c6IG5vbmU7IH0KCi5tZWV0
-aW5nLWluZm8tbGluZSB7CiAgcGFkZGluZy10b3A6IDRweDsKICBwYWRkaW5nLWJvdHRvbTogNHB4OyB9
-CgovKiMgc291cmNlTWFwcGluZ1VSTD1ndWkuY3NzLm1hcCAqLwo=
+ZGlzYWJsZWQgewogICAgICAgIGNvbG9yOiByZ2JhKDI1NSwgMjU1LCAyNTUsIDAuNjUpOwogICAgICAg
+IGJhY2tncm91bmQ6ICNlMjk4OTg7CiAgICAgICAgYm9yZGVyLWNvbG9yOiB0cmFuc3BhcmVudDsKICAg
+ICAgICBib3gtc2hhZG93OiBub25lOyB9CgoubWVldGluZy1pbmZvLWxpbmUgewogIHBhZGRpbmctdG9w
+OiA0cHg7CiAgcGFkZGluZy1ib3R0b206IDRweDsgfQoKLyojIHNvdXJjZU1hcHBpbmdVUkw9Z3VpLmNz
+cy5tYXAgKi8K
 `,
 	},
 
@@ -79,8 +79,10 @@ type errGroupType string
 
 type errGroupTranslator func(err error) string
 type errGroupData struct {
-	errorList  []string
-	translator errGroupTranslator
+	errorList         []string
+	translator        errGroupTranslator
+	warningList       []string
+	warningTranslator errGroupTranslator
 }
 
 var initStartupErrorsGroups = map[errGroupType]*errGroupData{}
@@ -96,9 +98,18 @@ func initStartupErrorGroup(group errGroupType, t errGroupTranslator) {
 	}
 }
 
+// initStartupWarningTranslator sets how the warnings of the group are shown.
+// Unlike the errors, the warnings don't stop the user from using Wahay
+func initStartupWarningTranslator(group errGroupType, t errGroupTranslator) {
+	if g, ok := initStartupErrorsGroups[group]; ok {
+		g.warningTranslator = t
+	}
+}
+
 type errorHandler struct {
 	sync.Mutex
 	hasErrors     bool
+	hasWarnings   bool
 	startupErrors map[errGroupType]*errGroupData
 }
 
@@ -113,8 +124,12 @@ func (h *errorHandler) isThereAnyStartupError() bool {
 	return h.hasErrors
 }
 
+func (h *errorHandler) isThereAnyStartupWarning() bool {
+	return h.hasWarnings
+}
+
 func (h *errorHandler) getStatusErrorsText() string {
-	if !h.hasErrors {
+	if !h.hasErrors && !h.hasWarnings {
 		return "" // nothing to show
 	}
 
@@ -123,6 +138,10 @@ func (h *errorHandler) getStatusErrorsText() string {
 		txt = append(txt, v.errorList...)
 	}
 
+	for _, v := range h.startupErrors {
+		txt = append(txt, v.warningList...)
+	}
+
 	return strings.Join(txt, "\n")
 }
 
@@ -137,3 +156,18 @@ func (h *errorHandler) addNewStartupError(err error, group errGroupType) {
 		h.startupErrors[group].translator(err),
 	)
 }
+
+func (h *errorHandler) addNewStartupWarning(err error, group errGroupType) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.hasWarnings = true
+
+	g := h.startupErrors[group]
+	message := err.Error()
+	if g.warningTranslator != nil {
+		message = g.warningTranslator(err)
+	}
+
+	g.warningList = append(g.warningList, message)
+}
@@ -9,6 +9,8 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
+	"github.com/digitalautonomy/wahay/config"
 	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
 )
@@ -20,17 +22,23 @@ type hostData struct {
 	asSuperUser       bool
 	superUserPassword string
 	autoJoin          bool
+	waitingRoom       bool
+	webSocket         bool
 	meetingUsername   string
 	meetingPassword   string
 	currentWindow     gtki.Window
+	knockWindows      map[uint32]gtki.Window
+	statsDashboard    *statsDashboard
+	scheduled         *config.ScheduledMeeting
+	rejoiner          *client.Rejoiner
 	next              func()
 }
 
 func (u *gtkUI) hostMeetingHandler() {
-	go u.realHostMeetingHandler()
+	go u.realHostMeetingHandler(nil)
 }
 
-func (u *gtkUI) realHostMeetingHandler() {
+func (u *gtkUI) realHostMeetingHandler(scheduled *config.ScheduledMeeting) {
 	u.hideMainWindow()
 	u.displayLoadingWindow()
 
@@ -49,6 +57,10 @@ func (u *gtkUI) realHostMeetingHandler() {
 		u:           u,
 		asSuperUser: u.config.GetAsSuperUser(),
 		autoJoin:    u.config.GetAutoJoin(),
+		waitingRoom: u.config.GetWaitingRoom(),
+		webSocket:   u.config.GetWebSocket(),
+		scheduled:   scheduled,
+		rejoiner:    &client.Rejoiner{},
 		next:        nil,
 	}
 
@@ -128,21 +140,26 @@ func (h *hostData) showMeetingControls() {
 func (h *hostData) joinMeetingHost() {
 	h.u.displayLoadingWindow()
 
-	validOpChannel := make(chan bool)
+	result := make(chan error)
 
-	go h.joinMeetingHostHelper(validOpChannel)
+	go h.joinMeetingHostHelper(result)
 
-	if <-validOpChannel {
+	err := <-result
+	if err == nil {
 		h.openHostJoinMeetingWindow()
 		return
 	}
 
+	if h.u.retryAfterCertificateProblem(err, h.joinMeetingHost, h.u.switchToMainWindow) {
+		return
+	}
+
 	// TODO: we should give more information to the user
 	h.u.reportError(i18n.Sprintf("we couldn't start the meeting"))
 	h.u.switchToMainWindow()
 }
 
-func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
+func (h *hostData) joinMeetingHostHelper(result chan error) {
 	data := hosting.MeetingData{
 		MeetingID: h.service.ID(),
 		Port:      h.service.ServicePort(),
@@ -150,7 +167,7 @@ func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
 			if h.asSuperUser {
 				return h.superUserPassword
 			}
-			return h.meetingPassword
+			return h.service.CurrentPassword()
 		}(),
 		Username: h.meetingUsername,
 	}
@@ -164,8 +181,19 @@ func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
 		mumble, err = h.u.launchMumbleClient(
 			data,
 			// Callback to be executed when the client is closed
-			func() {
+			func(status tor.ExitStatus) {
 				if h.next == nil {
+					// If Mumble crashed, the meeting is still
+					// running, so the host can join it again
+					if h.u.rejoinAfterCrash(h.rejoiner, status, h.joinMeetingHost) {
+						return
+					}
+					if status.Crashed() {
+						h.u.reportMumbleCrash(status)
+					}
+					if client.HandedOff(status) {
+						h.u.reportMumbleHandoff()
+					}
 					h.next = h.uiActionFinishMeeting
 				}
 				h.switchToHostOnFinishMeeting()
@@ -180,11 +208,11 @@ func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
 
 	if err != nil {
 		log.Errorf("joinMeetingHost() error: %s", err)
-		validOpChannel <- false
 	} else {
 		h.mumble = mumble
-		validOpChannel <- true
 	}
+
+	result <- err
 }
 
 func (h *hostData) switchToHostOnFinishMeeting() {
@@ -206,8 +234,20 @@ func (u *gtkUI) getCurrentHostMeetingWindow() *uiBuilder {
 		"tooltip", "btnLeaveMeeting",
 		"button", "btnInviteOthers",
 		"label", "lblTipPush",
+		"label", "lblStatsParticipants",
+		"label", "lblStatsUptime",
+		"label", "lblStatsTraffic",
+		"title", "columnStatsName",
+		"title", "columnStatsPing",
+		"title", "columnStatsLoss",
+		"label", "lblChat",
+		"label", "lblChatStatus",
+		"placeholder", "entChatMessage",
+		"button", "btnChatSend",
 	)
 
+	u.showPushToTalkTip(builder)
+
 	return builder
 }
 
@@ -229,8 +269,14 @@ func (h *hostData) openHostJoinMeetingWindow() {
 		h.currentWindow = nil
 	}
 
+	// The chat joins as a regular participant, even when the host joined as
+	// the super user. It needs the password the meeting accepts now, since
+	// the meeting is locked when there are personal invitations
+	chat := h.u.startMeetingChat(builder, h.mumble, h.service.CurrentPassword())
+
 	builder.ConnectSignals(map[string]interface{}{
 		"on_close_window_signal": func() {
+			h.stopStatsDashboard()
 			h.leaveHostMeeting()
 			h.u.quit()
 		},
@@ -239,14 +285,18 @@ func (h *hostData) openHostJoinMeetingWindow() {
 		"on_invite_others": func() {
 			h.onInviteParticipants(onInviteOpen, onInviteClose)
 		},
+		"on_chat_send": chat.onSend,
 	})
 
 	h.u.connectShortcutCurrentHostMeetingWindow(win, h)
 
+	h.startStatsDashboard(builder)
+
 	h.u.switchToWindow(win)
 }
 
 func (h *hostData) uiActionLeaveMeeting() {
+	h.stopStatsDashboard()
 	h.u.currentWindow.Hide()
 	h.showMeetingControls()
 }
@@ -258,13 +308,22 @@ func (h *hostData) uiActionFinishMeeting() {
 func (h *hostData) createNewService(err chan error) {
 	var port string
 
+	var key tor.OnionKey
+
 	configuredPort := h.u.config.GetPortMumble()
 	if configuredPort != "" {
 		port = configuredPort
 	}
 
+	// A scheduled meeting must be found at the
+	// address and port sent in the invitations
+	if h.scheduled != nil {
+		port = h.scheduled.Port
+		key = tor.OnionKey(h.scheduled.OnionKey)
+	}
+
 	h.u.waitForTorInstance(func(t tor.Instance) {
-		s, e := h.u.servers.NewService(port, t)
+		s, e := h.u.servers.NewServiceWithKey(port, key, t)
 		if e != nil {
 			log.Errorf("createNewService(): %s", e)
 			err <- e
@@ -282,12 +341,19 @@ func (h *hostData) createNewService(err chan error) {
 func (h *hostData) createNewConferenceRoom(complete chan bool) {
 	var su hosting.SuperUserData
 	if h.asSuperUser {
+		if len(h.superUserPassword) == 0 {
+			h.superUserPassword = generateRandomPassword()
+		}
 		su = hosting.SuperUserData{
 			Username: h.meetingUsername,
 			Password: h.superUserPassword,
 		}
 	}
 
+	h.service.SetWaitingRoom(h.waitingRoom)
+	h.service.SetEventFeed(h.u.config.GetEventFeed())
+	h.service.SetWebSocket(h.webSocket)
+
 	err := h.service.NewConferenceRoom(h.meetingPassword, su)
 	if err != nil {
 		h.u.hideLoadingWindow()
@@ -296,6 +362,14 @@ func (h *hostData) createNewConferenceRoom(complete chan bool) {
 		return
 	}
 
+	if h.waitingRoom {
+		h.watchWaitingRoom()
+	}
+
+	if h.u.config.IsLogsEnabled() {
+		go h.logMeetingStats()
+	}
+
 	complete <- true
 }
 
@@ -314,6 +388,9 @@ func (h *hostData) finishMeetingReal() {
 		h.currentWindow = nil
 	}
 
+	h.closeAllKnockWindows()
+	h.stopStatsDashboard()
+
 	h.u.servers = nil
 
 	h.u.switchToMainWindow()
@@ -418,11 +495,38 @@ func (h *hostData) getInvitationSubject() string {
 }
 
 func (h *hostData) getInvitationText() string {
-	it := i18n.Sprintf("Please join the Wahay meeting with the following details:") + "%0D%0A%0D%0A"
+	it := i18n.Sprintf("Please join the Wahay meeting with the following details:") + "\n\n"
+	it += h.getInvitationDetails(false)
+	if link := h.getInvitationLink(); link != "" {
+		it += "\n\n" + i18n.Sprintf("Join with one click: %s", link)
+	}
+	return strings.Replace(it, "\n", "%0D%0A", -1)
+}
+
+// getInvitationDetails returns only what participants need to join the
+// meeting, one item per line. The password is included when asked for
+func (h *hostData) getInvitationDetails(withPassword bool) string {
+	var lines []string
 	if h.service.URL() != "" {
-		it = i18n.Sprintf("%sMeeting ID: %s", it, h.service.URL())
+		lines = append(lines, i18n.Sprintf("Meeting ID: %s", h.service.URL()))
+	}
+	if withPassword && len(h.meetingPassword) != 0 {
+		lines = append(lines, i18n.Sprintf("Password: %s", h.meetingPassword))
 	}
-	return it
+	if h.webSocket {
+		lines = append(lines, i18n.Sprintf("Browser address: %s", h.service.WebSocketURL()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getInvitationLink returns the link that opens Wahay ready to join
+// the meeting. The password is never part of it
+func (h *hostData) getInvitationLink() string {
+	data, err := hosting.ParseMeetingAddress(h.service.URL())
+	if err != nil {
+		return ""
+	}
+	return data.InvitationURI()
 }
 
 func (h *hostData) wouldYouConfirmFinishMeeting(k func(bool)) {
@@ -456,8 +560,12 @@ func (u *gtkUI) getConfigureMeetingWindow() *uiBuilder {
 		"placeholder", "inpMeetingPassword",
 		"checkbox", "chkAutoJoin",
 		"checkbox", "chkAutoJoinSuperUser",
+		"checkbox", "chkWaitingRoom",
+		"checkbox", "chkWebSocket",
 		"tooltip", "chkAutoJoin",
 		"tooltip", "chkAutoJoinSuperUser",
+		"tooltip", "chkWaitingRoom",
+		"tooltip", "chkWebSocket",
 		"button", "btnCopyMeetingID",
 		"button", "btnInviteOthers",
 		"button", "btnCancel",
@@ -471,6 +579,8 @@ func (h *hostData) showMeetingConfiguration() {
 	win := builder.get("configureMeetingWindow").(gtki.ApplicationWindow)
 	chkAutoJoin := builder.get("chkAutoJoin").(gtki.CheckButton)
 	chkAutoJoinSuperUser := builder.get("chkAutoJoinSuperUser").(gtki.CheckButton)
+	chkWaitingRoom := builder.get("chkWaitingRoom").(gtki.CheckButton)
+	chkWebSocket := builder.get("chkWebSocket").(gtki.CheckButton)
 	btnStart := builder.get("btnStartMeeting").(gtki.Button)
 
 	onInviteOpen := func(d gtki.ApplicationWindow) {
@@ -487,8 +597,16 @@ func (h *hostData) showMeetingConfiguration() {
 
 	chkAutoJoin.SetActive(h.autoJoin)
 	chkAutoJoinSuperUser.SetActive(h.asSuperUser)
+	chkWaitingRoom.SetActive(h.waitingRoom)
+	chkWebSocket.SetActive(h.webSocket)
+	chkAutoJoinSuperUser.SetSensitive(!h.waitingRoom)
 	h.changeStartButtonText(btnStart)
 
+	if h.scheduled != nil {
+		inpPassword := builder.get("inpMeetingPassword").(gtki.Entry)
+		inpPassword.SetText(h.scheduled.Password)
+	}
+
 	btnCopyMeetingID := builder.get("btnCopyMeetingID").(gtki.Button)
 	btnCopyMeetingID.SetVisible(h.u.isCopyToClipboardSupported())
 
@@ -510,6 +628,12 @@ func (h *hostData) showMeetingConfiguration() {
 		"on_chkAutoJoinSuperUser_toggled": func() {
 			h.handlerOnAutoJoinSuperUserToggled(chkAutoJoinSuperUser)
 		},
+		"on_chkWaitingRoom_toggled": func() {
+			h.handlerOnWaitingRoomToggled(chkWaitingRoom, chkAutoJoinSuperUser)
+		},
+		"on_chkWebSocket_toggled": func() {
+			h.handlerOnWebSocketToggled(chkWebSocket)
+		},
 	})
 
 	h.u.connectShortcutsHostingMeetingConfigurationWindow(win, builder, h)
@@ -558,6 +682,23 @@ func (h *hostData) handlerOnAutoJoinSuperUserToggled(ch gtki.CheckButton) {
 	h.u.config.SetAutoJoinSuperUser(h.asSuperUser)
 }
 
+func (h *hostData) handlerOnWaitingRoomToggled(ch, chkSuperUser gtki.CheckButton) {
+	h.waitingRoom = ch.GetActive()
+	h.u.config.SetWaitingRoom(h.waitingRoom)
+
+	// Only the super user skips the lobby, so the host
+	// must join like that when the waiting room is enabled
+	if h.waitingRoom {
+		chkSuperUser.SetActive(true)
+	}
+	chkSuperUser.SetSensitive(!h.waitingRoom)
+}
+
+func (h *hostData) handlerOnWebSocketToggled(ch gtki.CheckButton) {
+	h.webSocket = ch.GetActive()
+	h.u.config.SetWebSocket(h.webSocket)
+}
+
 func (h *hostData) handlerOnAutoJoinToggled(ch gtki.CheckButton, b gtki.Button) {
 	h.autoJoin = ch.GetActive()
 	h.u.config.SetAutoJoin(h.autoJoin)
@@ -627,7 +768,13 @@ func (h *hostData) getInvitePeopleBuilder() *uiBuilder {
 		"label", "lblYahoo",
 		"label", "lblOutlook",
 		"button", "btnCopyMeetingID",
-		"button", "btnCopyInvitation")
+		"button", "btnCopyInvitation",
+		"button", "btnPersonalInvitations",
+		"button", "btnExportCalendar",
+		"tooltip", "btnExportCalendar",
+		"label", "lblQRCode",
+		"checkbox", "chkQRPassword",
+		"button", "btnSaveQRCode")
 
 	btnEmail := builder.get("btnEmail").(gtki.LinkButton)
 	btnGmail := builder.get("btnGmail").(gtki.LinkButton)
@@ -684,8 +831,22 @@ func (h *hostData) onInviteParticipants(onOpen func(d gtki.ApplicationWindow), o
 		"on_copy_invitation": func() {
 			h.copyInvitationToClipboard(builder)
 		},
+		"on_personal_invitations": func() {
+			h.onPersonalInvitations(dialog)
+		},
+		"on_export_calendar": func() {
+			h.exportMeetingToCalendar(builder.get("lblMessage").(gtki.Label))
+		},
+		"on_qr_password_toggled": func() {
+			h.showInvitationQRCode(builder)
+		},
+		"on_save_qr_code": func() {
+			h.saveInvitationQRCode(builder)
+		},
 	})
 
+	h.showInvitationQRCode(builder)
+
 	if onOpen == nil {
 		onOpen = func(gtki.ApplicationWindow) {
 			h.u.disableCurrentWindow()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -88,11 +89,35 @@ func (i *installation) ensureApplicationDesktop() {
 	fileName := filepath.Join(i.dataHome, "applications", "wahay.desktop")
 	content := i.generateDesktopFile()
 
+	old, _ := ioutil.ReadFile(filepath.Clean(fileName))
+	if string(old) == content {
+		return
+	}
+
 	err = ioutil.WriteFile(fileName, []byte(content), 0600)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"desktopFileName": fileName,
 		}).Errorf("ensureApplicationDesktop(): %s", err.Error())
+		return
+	}
+
+	updateDesktopDatabase(dir)
+}
+
+// updateDesktopDatabase tells the desktop that Wahay opens the
+// invitation links. Not every system has the tool, and the
+// desktop will eventually notice the new file anyway
+func updateDesktopDatabase(dir string) {
+	path, err := exec.LookPath("update-desktop-database")
+	if err != nil {
+		return
+	}
+
+	// #nosec
+	err = exec.Command(path, dir).Run()
+	if err != nil {
+		log.Debugf("updateDesktopDatabase(): %s", err)
 	}
 }
 
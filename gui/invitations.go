@@ -0,0 +1,178 @@
+package gui
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+const (
+	invitationNameColumn  = 0
+	invitationTokenColumn = 1
+)
+
+type personalInvitationsWindow struct {
+	h          *hostData
+	win        gtki.Window
+	store      gtki.ListStore
+	tree       gtki.TreeView
+	inpName    gtki.Entry
+	lblMessage gtki.Label
+}
+
+func (h *hostData) getPersonalInvitationsBuilder() *uiBuilder {
+	builder := h.u.g.uiBuilderFor("PersonalInvitationsWindow")
+
+	builder.i18nProperties(
+		"title", "invitationsWindow",
+		"label", "lblDescription",
+		"placeholder", "inpInvitationName",
+		"button", "btnCreateInvitation",
+		"title", "columnName",
+		"title", "columnToken",
+		"button", "btnCopyPersonalInvitation",
+		"button", "btnRevokeInvitation",
+		"button", "btnClose")
+
+	return builder
+}
+
+func (h *hostData) onPersonalInvitations(parent gtki.Window) {
+	builder := h.getPersonalInvitationsBuilder()
+
+	pi := &personalInvitationsWindow{
+		h:          h,
+		win:        builder.get("invitationsWindow").(gtki.Window),
+		store:      builder.get("invitationsStore").(gtki.ListStore),
+		tree:       builder.get("treeInvitations").(gtki.TreeView),
+		inpName:    builder.get("inpInvitationName").(gtki.Entry),
+		lblMessage: builder.get("lblMessage").(gtki.Label),
+	}
+
+	btnCopy := builder.get("btnCopyPersonalInvitation").(gtki.Button)
+	btnCopy.SetVisible(h.u.isCopyToClipboardSupported())
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_create_invitation": pi.createInvitation,
+		"on_copy_invitation":   pi.copySelectedInvitation,
+		"on_revoke_invitation": pi.revokeSelectedInvitation,
+		"on_close":             pi.win.Destroy,
+		"on_close_window_signal": func() {
+			if parent != nil {
+				parent.SetSensitive(true)
+			}
+		},
+	})
+
+	pi.refresh()
+
+	if parent != nil {
+		pi.win.SetTransientFor(parent)
+		parent.SetSensitive(false)
+	}
+
+	pi.win.Present()
+	pi.win.Show()
+}
+
+func (pi *personalInvitationsWindow) refresh() {
+	pi.store.Clear()
+
+	for _, inv := range pi.h.service.Invitations() {
+		iter := pi.store.Append()
+		err := pi.store.Set2(iter,
+			[]int{invitationNameColumn, invitationTokenColumn},
+			[]interface{}{inv.Name, inv.Token})
+		if err != nil {
+			log.Errorf("personal invitations refresh(): %s", err)
+		}
+	}
+}
+
+func (pi *personalInvitationsWindow) selected() (hosting.Invitation, bool) {
+	selection, err := pi.tree.GetSelection()
+	if err != nil {
+		return hosting.Invitation{}, false
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		return hosting.Invitation{}, false
+	}
+
+	v, err := pi.store.GetValue(iter, invitationTokenColumn)
+	if err != nil {
+		return hosting.Invitation{}, false
+	}
+
+	token, _ := v.GetString()
+	for _, inv := range pi.h.service.Invitations() {
+		if inv.Token == token {
+			return inv, true
+		}
+	}
+
+	return hosting.Invitation{}, false
+}
+
+func (pi *personalInvitationsWindow) createInvitation() {
+	name, _ := pi.inpName.GetText()
+
+	inv, err := pi.h.service.NewInvitation(name)
+	if err != nil {
+		pi.h.u.reportError(i18n.Sprintf("The invitation can't be created: %s", err))
+		return
+	}
+
+	pi.inpName.SetText("")
+	pi.refresh()
+	pi.copyInvitation(inv)
+}
+
+func (pi *personalInvitationsWindow) copySelectedInvitation() {
+	inv, ok := pi.selected()
+	if !ok {
+		return
+	}
+
+	pi.copyInvitation(inv)
+}
+
+func (pi *personalInvitationsWindow) copyInvitation(inv hosting.Invitation) {
+	if !pi.h.u.isCopyToClipboardSupported() {
+		return
+	}
+
+	err := pi.h.u.copyToClipboard(pi.h.getPersonalInvitationText(inv))
+	if err != nil {
+		pi.h.u.reportError(err.Error())
+		return
+	}
+
+	go pi.h.u.messageToLabel(pi.lblMessage, i18n.Sprintf("The invitation for %s has been copied to the clipboard", inv.Name), 5)
+}
+
+func (pi *personalInvitationsWindow) revokeSelectedInvitation() {
+	inv, ok := pi.selected()
+	if !ok {
+		return
+	}
+
+	err := pi.h.service.RevokeInvitation(inv.Token)
+	if err != nil {
+		pi.h.u.reportError(i18n.Sprintf("The invitation can't be revoked: %s", err))
+		return
+	}
+
+	pi.refresh()
+}
+
+func (h *hostData) getPersonalInvitationText(inv hosting.Invitation) string {
+	return fmt.Sprintf("%s\n\n%s\n%s\n",
+		i18n.Sprintf("%s, please join the Wahay meeting with the following details:", inv.Name),
+		i18n.Sprintf("Meeting ID: %s", h.service.URL()),
+		i18n.Sprintf("Password: %s", inv.Token))
+}
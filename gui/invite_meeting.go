@@ -1,13 +1,8 @@
 package gui
 
 import (
-	"errors"
-	"net"
-	"net/url"
-	"strconv"
-	"strings"
-
 	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
 	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
 
@@ -19,6 +14,40 @@ func (u *gtkUI) joinMeeting() {
 	u.openJoinWindow()
 }
 
+// OpenInvitation makes Wahay open the join window for the given
+// invitation link as soon as the main window is shown.
+// Since the application is started without GApplication command
+// line handling, a link opened while Wahay is already running
+// only brings the existing window to the front
+func (u *gtkUI) OpenInvitation(uri string) {
+	u.invitation = uri
+}
+
+func (u *gtkUI) openPendingInvitation() {
+	uri := u.invitation
+	if len(uri) == 0 {
+		return
+	}
+
+	u.invitation = ""
+
+	if u.errorHandler.isThereAnyStartupError() {
+		return
+	}
+
+	data, err := hosting.ParseMeetingAddress(uri)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"invitation": uri,
+		}).Error("Invalid invitation provided")
+		u.reportError(i18n.Sprintf("The invitation is invalid: \n\n%s", uri))
+		return
+	}
+
+	u.hideMainWindow()
+	u.openJoinWindowWith(&data)
+}
+
 func (u *gtkUI) getInviteCodeEntities() (gtki.ApplicationWindow, *uiBuilder) {
 	builder := u.g.uiBuilderFor("InviteCodeWindow")
 
@@ -48,8 +77,18 @@ func (u *gtkUI) getCurrentMeetingWindow() *uiBuilder {
 		"button", "btnLeaveMeeting",
 		"tooltip", "btnLeaveMeeting",
 		"label", "lblTipPush",
+		"button", "btnMute",
+		"tooltip", "btnMute",
+		"button", "btnDeafen",
+		"tooltip", "btnDeafen",
+		"label", "lblChat",
+		"label", "lblChatStatus",
+		"placeholder", "entChatMessage",
+		"button", "btnChatSend",
 	)
 
+	u.showPushToTalkTip(builder)
+
 	return builder
 }
 
@@ -62,6 +101,8 @@ func (u *gtkUI) openCurrentMeetingWindow(m tor.Service) {
 
 	builder := u.getCurrentMeetingWindow()
 	win := builder.get("currentMeetingWindow").(gtki.ApplicationWindow)
+	controls := u.startMeetingControls(builder, m)
+	chat := u.startMeetingChat(builder, m, "")
 
 	builder.ConnectSignals(map[string]interface{}{
 		"on_close_window_signal": func() {
@@ -71,6 +112,9 @@ func (u *gtkUI) openCurrentMeetingWindow(m tor.Service) {
 		"on_leave_meeting": func() {
 			u.leaveMeeting(m)
 		},
+		"on_mute_toggled":   controls.onMuteToggled,
+		"on_deafen_toggled": controls.onDeafenToggled,
+		"on_chat_send":      chat.onSend,
 	})
 
 	u.connectShortcutCurrentMeetingWindow(win, m)
@@ -84,11 +128,15 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 		return
 	}
 
-	if !isAValidMeetingID(data.MeetingID) {
+	if !hosting.IsAValidMeetingID(data.MeetingID) {
 		u.reportError(i18n.Sprintf("The provided meeting ID is invalid: \n\n%s", data.MeetingID))
 		return
 	}
 
+	u.joinMeetingWith(data, &client.Rejoiner{})
+}
+
+func (u *gtkUI) joinMeetingWith(data hosting.MeetingData, r *client.Rejoiner) {
 	u.hideCurrentWindow()
 	u.displayLoadingWindow()
 
@@ -97,11 +145,25 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 
 	finish := make(chan bool)
 
+	onClose := func(status tor.ExitStatus) {
+		rejoined := u.rejoinAfterCrash(r, status, func() {
+			u.joinMeetingWith(data, r)
+		})
+		if rejoined {
+			return
+		}
+
+		u.switchContextWhenMumbleFinish()
+		if status.Crashed() {
+			u.reportMumbleCrash(status)
+		}
+		if client.HandedOff(status) {
+			u.reportMumbleHandoff()
+		}
+	}
+
 	go func() {
-		mumble, err = u.launchMumbleClient(
-			data,
-			u.switchContextWhenMumbleFinish,
-		)
+		mumble, err = u.launchMumbleClient(data, onClose)
 
 		finish <- true
 	}()
@@ -111,6 +173,13 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 	u.hideLoadingWindow()
 
 	if err != nil {
+		retry := func() {
+			u.joinMeetingWith(data, r)
+		}
+		if u.retryAfterCertificateProblem(err, retry, u.showMainWindow) {
+			return
+		}
+
 		u.openErrorDialog(i18n.Sprintf("An error occurred\n\n%s", err.Error()))
 		u.showMainWindow()
 		return
@@ -122,12 +191,25 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 // Test Onion that can be used:
 // qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion
 func (u *gtkUI) openJoinWindow() {
+	u.openJoinWindowWith(nil)
+}
+
+// openJoinWindowWith shows the join window filled with the given
+// invitation, if any. Since nobody has typed anything, joining
+// an invitation has to be confirmed first
+func (u *gtkUI) openJoinWindowWith(invitation *hosting.MeetingData) {
 	win, builder := u.getInviteCodeEntities()
 
 	entMeetingID, _ := builder.get("entMeetingID").(gtki.Entry)
 	entScreenName, _ := builder.get("entScreenName").(gtki.Entry)
 	entMeetingPassword, _ := builder.get("entMeetingPassword").(gtki.Entry)
 
+	if invitation != nil {
+		entMeetingID.SetText(invitation.Address())
+		entScreenName.SetText(invitation.Username)
+		entMeetingPassword.SetText(invitation.Password)
+	}
+
 	cleanup := func() {
 		win.Destroy()
 		u.switchToMainWindow()
@@ -141,7 +223,7 @@ func (u *gtkUI) openJoinWindow() {
 
 			// TODO: remove this if we show a custom input field to enter
 			// the SERVICE URL and the PORT
-			meetingID, port, err := extractMeetingIDandPort(url)
+			meetingID, port, err := hosting.ExtractMeetingIDandPort(url)
 			if err != nil {
 				log.WithFields(log.Fields{
 					"url":  url,
@@ -159,7 +241,16 @@ func (u *gtkUI) openJoinWindow() {
 				Password:  password,
 			}
 
-			go u.joinMeetingHandler(data)
+			if invitation == nil {
+				go u.joinMeetingHandler(data)
+				return
+			}
+
+			u.showConfirmation(func(join bool) {
+				if join {
+					go u.joinMeetingHandler(data)
+				}
+			}, i18n.Sprintf("Do you want to join the meeting %s?", url))
 		},
 		"on_cancel": cleanup,
 		"on_close":  cleanup,
@@ -169,99 +260,6 @@ func (u *gtkUI) openJoinWindow() {
 	u.setCurrentWindow(win)
 }
 
-var errInvalidMeetingAddr = errors.New("invalid meeting address")
-
-func extractMeetingIDandPort(meetingURL string) (meetingID string, port int, err error) {
-	if !isAValidMeetingID(meetingURL) {
-		err = errInvalidMeetingAddr
-		return
-	}
-
-	if !strings.HasPrefix(meetingURL, "mumble://") {
-		v := url.URL{
-			Scheme: "mumble",
-			Host:   meetingURL,
-		}
-		meetingURL = v.String()
-	}
-
-	h, p, e := extractURLAndPort(meetingURL)
-	if e != nil {
-		err = errInvalidMeetingAddr
-		return
-	}
-
-	meetingID = h
-	port = p
-
-	return
-}
-
-func extractURLAndPort(urlToParse string) (host string, port int, err error) {
-	var h string
-	var p string
-	var e error
-
-	u, e := url.Parse(urlToParse)
-	if e != nil {
-		err = e
-		return
-	}
-
-	if u.Port() != "" {
-		h, p, e = net.SplitHostPort(u.Host)
-		if e != nil {
-			err = e
-			return
-		}
-
-		host = h
-
-		port, e = strconv.Atoi(p)
-		if e != nil {
-			err = e
-		}
-
-		return
-	}
-
-	host = u.Host
-	port = hosting.DefaultPort
-
-	return host, port, err
-}
-
-const onionServiceLength = 62
-
-// TODO: This function needs to be improved in order to make a real validation of
-// the Meeting ID or Onion Address.
-// At the moment, this function helps to test the error code window render.
-func isAValidMeetingID(meetingID string) bool {
-	if len(meetingID) < onionServiceLength {
-		return false
-	}
-
-	if len(meetingID) == onionServiceLength &&
-		!strings.HasSuffix(meetingID, ".onion") {
-		return false
-	}
-
-	// TODO[OB]: this one is very confusing to me. It can NEVER be true
-	// since this code is dead
-	if len(meetingID) > onionServiceLength &&
-		len(meetingID[:onionServiceLength]) < onionServiceLength {
-		return false
-	}
-
-	if len(meetingID) > onionServiceLength &&
-		strings.Index(meetingID, ":") != onionServiceLength &&
-		strings.Index(meetingID, ".onion") != onionServiceLength-4 {
-		return false
-	}
-
-	return true
-}
-
 func (u *gtkUI) leaveMeeting(m tor.Service) {
 	u.wouldYouConfirmLeaveMeeting(func(res bool) {
 		if res {
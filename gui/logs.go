@@ -29,10 +29,10 @@ func ensureLogFile(conf *config.ApplicationConfig) io.Writer {
 	configuredLogFile := conf.GetRawLogFile()
 
 	if len(configuredLogFile) == 0 {
-		configuredLogFile = config.GetDefaultLogFile()
+		configuredLogFile = conf.GetDefaultLogFile()
 	}
 
-	rawLogFile, err := ensureLogFileDirectory(configuredLogFile)
+	rawLogFile, err := ensureLogFileDirectory(configuredLogFile, conf.GetDefaultLogFile())
 	if len(rawLogFile) == 0 || err != nil {
 		return nil
 	}
@@ -45,8 +45,8 @@ func ensureLogFile(conf *config.ApplicationConfig) io.Writer {
 	return file
 }
 
-func ensureLogFileDirectory(rawLogFile string) (string, error) {
-	fileInfo, err := getLogFileInfo(rawLogFile, config.GetDefaultLogFile())
+func ensureLogFileDirectory(rawLogFile, defaultFile string) (string, error) {
+	fileInfo, err := getLogFileInfo(rawLogFile, defaultFile)
 	if err != nil {
 		return "", err
 	}
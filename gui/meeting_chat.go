@@ -0,0 +1,167 @@
+package gui
+
+import (
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+const meetingChatWatchInterval = 2 * time.Second
+
+// meetingChat is the chat panel of a meeting window. The chat connects
+// to the meeting by itself, so it works even when the Mumble window
+// is not in front, and it leaves the meeting when Mumble finishes
+type meetingChat struct {
+	u         *gtkUI
+	lblStatus gtki.Label
+	scroll    gtki.ScrolledWindow
+	view      gtki.TextView
+	entry     gtki.Entry
+	btnSend   gtki.Button
+
+	// chat and stopped are only used from the UI thread
+	chat    client.Chat
+	stopped bool
+}
+
+// startMeetingChat connects the chat of the meeting that the Mumble running in
+// the service is in. The password is only given when it's not the one Mumble used
+func (u *gtkUI) startMeetingChat(builder *uiBuilder, m tor.Service, password string) *meetingChat {
+	mc := &meetingChat{u: u}
+
+	builder.getItems(
+		"lblChatStatus", &mc.lblStatus,
+		"scrChat", &mc.scroll,
+		"txtChat", &mc.view,
+		"entChatMessage", &mc.entry,
+		"btnChatSend", &mc.btnSend,
+	)
+
+	if u.tor == nil {
+		mc.lblStatus.SetText(i18n.Sprintf("The chat is not available because Tor is not running"))
+		return mc
+	}
+
+	go mc.connect(m, password)
+	go mc.watch(m)
+
+	return mc
+}
+
+func (mc *meetingChat) connect(m tor.Service, password string) {
+	onMessage := func(m client.ChatMessage) {
+		mc.u.doInUIThread(func() {
+			mc.add(m.From, m.Text, m.Time)
+		})
+	}
+
+	onClose := func(err error) {
+		if err == nil {
+			return
+		}
+
+		mc.u.doInUIThread(func() {
+			mc.disconnected(err)
+		})
+	}
+
+	c, err := mc.u.client.ConnectChat(m, password, onMessage, onClose)
+
+	mc.u.doInUIThread(func() {
+		if err != nil {
+			log.Errorf("meeting chat: %s", err)
+			mc.disconnected(err)
+			return
+		}
+
+		if mc.stopped {
+			c.Close()
+			return
+		}
+
+		mc.chat = c
+		mc.lblStatus.Hide()
+		mc.entry.SetSensitive(true)
+		mc.btnSend.SetSensitive(true)
+	})
+}
+
+// watch leaves the chat when Mumble finishes
+func (mc *meetingChat) watch(m tor.Service) {
+	t := time.NewTicker(meetingChatWatchInterval)
+	defer t.Stop()
+
+	for !m.IsClosed() {
+		<-t.C
+	}
+
+	mc.u.doInUIThread(mc.stop)
+}
+
+func (mc *meetingChat) stop() {
+	mc.stopped = true
+
+	if mc.chat != nil {
+		mc.chat.Close()
+		mc.chat = nil
+	}
+}
+
+func (mc *meetingChat) disconnected(err error) {
+	if mc.stopped {
+		return
+	}
+
+	mc.chat = nil
+	mc.entry.SetSensitive(false)
+	mc.btnSend.SetSensitive(false)
+	mc.lblStatus.SetText(i18n.Sprintf("The chat is not available:\n%s", err.Error()))
+	mc.lblStatus.Show()
+}
+
+func (mc *meetingChat) onSend() {
+	if mc.chat == nil {
+		return
+	}
+
+	text, _ := mc.entry.GetText()
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+
+	err := mc.chat.Send(text)
+	if err != nil {
+		log.Errorf("meeting chat: %s", err)
+		mc.u.reportError(i18n.Sprintf("The message couldn't be sent:\n\n%s", err.Error()))
+		return
+	}
+
+	mc.entry.SetText("")
+	mc.add(i18n.Sprintf("Me"), text, time.Now())
+}
+
+func (mc *meetingChat) add(from, text string, t time.Time) {
+	if from == "" {
+		from = i18n.Sprintf("Someone")
+	}
+
+	buf, err := mc.view.GetBuffer()
+	if err != nil {
+		log.Errorf("meeting chat: %s", err)
+		return
+	}
+
+	buf.Insert(buf.GetEndIter(), i18n.Sprintf("[%s] %s: %s\n", t.Format("15:04"), from, text))
+
+	// The size of the text is only known after it's shown
+	mc.u.doInUIThread(func() {
+		adj := mc.scroll.GetVAdjustment()
+		adj.SetValue(adj.GetUpper() - adj.GetPageSize())
+	})
+}
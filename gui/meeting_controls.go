@@ -0,0 +1,126 @@
+package gui
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+const meetingStateRefreshInterval = 2 * time.Second
+
+// meetingControls shows the state of the running Mumble client in the
+// meeting window and lets the participant mute and deafen it. The
+// controls are hidden while the client can't tell us its state
+type meetingControls struct {
+	u         *gtkUI
+	c         client.Instance
+	m         tor.Service
+	box       gtki.Box
+	lblStatus gtki.Label
+	btnMute   gtki.ToggleButton
+	btnDeafen gtki.ToggleButton
+
+	// updating is true while the state reported by the client is being
+	// shown, so the toggled handlers don't send it back to the client.
+	// It's only used from the UI thread
+	updating bool
+}
+
+func (u *gtkUI) startMeetingControls(builder *uiBuilder, m tor.Service) *meetingControls {
+	mc := &meetingControls{u: u, c: u.client, m: m}
+
+	builder.getItems(
+		"boxMeetingControls", &mc.box,
+		"lblMeetingStatus", &mc.lblStatus,
+		"btnMute", &mc.btnMute,
+		"btnDeafen", &mc.btnDeafen,
+	)
+
+	if mc.c != nil && m != nil {
+		go mc.refresh()
+	}
+
+	return mc
+}
+
+func (mc *meetingControls) refresh() {
+	t := time.NewTicker(meetingStateRefreshInterval)
+	defer t.Stop()
+
+	for !mc.m.IsClosed() {
+		state, err := mc.c.QueryState(mc.m)
+
+		mc.u.doInUIThread(func() {
+			if err != nil {
+				mc.hide()
+				return
+			}
+			mc.show(state)
+		})
+
+		<-t.C
+	}
+}
+
+func (mc *meetingControls) hide() {
+	mc.box.Hide()
+	mc.lblStatus.Hide()
+}
+
+func (mc *meetingControls) show(state client.MeetingState) {
+	mc.updating = true
+	defer func() { mc.updating = false }()
+
+	if state.Connected {
+		mc.lblStatus.SetText(i18n.Sprintf("Connected"))
+	} else {
+		mc.lblStatus.SetText(i18n.Sprintf("Disconnected"))
+	}
+
+	mc.btnMute.SetActive(state.Muted)
+	mc.btnDeafen.SetActive(state.Deafened)
+
+	mc.lblStatus.Show()
+	mc.box.Show()
+}
+
+func (mc *meetingControls) onMuteToggled() {
+	mc.send(mc.btnMute, func(muted bool) error {
+		return mc.c.Mute(mc.m, muted)
+	})
+}
+
+func (mc *meetingControls) onDeafenToggled() {
+	mc.send(mc.btnDeafen, func(deafened bool) error {
+		return mc.c.Deafen(mc.m, deafened)
+	})
+}
+
+// send tells the client about the new state of the toggle,
+// and puts the toggle back if the client doesn't take it
+func (mc *meetingControls) send(toggle gtki.ToggleButton, action func(bool) error) {
+	if mc.updating || mc.c == nil || mc.m == nil {
+		return
+	}
+
+	active := toggle.GetActive()
+
+	go func() {
+		err := action(active)
+		if err == nil {
+			return
+		}
+
+		log.Errorf("meeting controls: %s", err)
+
+		mc.u.doInUIThread(func() {
+			mc.updating = true
+			toggle.SetActive(!active)
+			mc.updating = false
+		})
+	}()
+}
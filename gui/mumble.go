@@ -3,6 +3,9 @@ package gui
 import (
 	"errors"
 	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 
 	"github.com/digitalautonomy/wahay/client"
 	"github.com/digitalautonomy/wahay/hosting"
@@ -22,6 +25,10 @@ func (u *gtkUI) ensureMumble(wg *sync.WaitGroup) {
 				return
 			}
 
+			for _, w := range c.Warnings() {
+				u.errorHandler.addNewStartupWarning(w, errGroupMumble)
+			}
+
 			u.onExit(c.Destroy)
 
 			u.client = c
@@ -29,7 +36,7 @@ func (u *gtkUI) ensureMumble(wg *sync.WaitGroup) {
 	})
 }
 
-func (u *gtkUI) launchMumbleClient(data hosting.MeetingData, onClose func()) (tor.Service, error) {
+func (u *gtkUI) launchMumbleClient(data hosting.MeetingData, onClose func(tor.ExitStatus)) (tor.Service, error) {
 	c := u.client
 
 	if !c.IsValid() {
@@ -44,12 +51,85 @@ func (u *gtkUI) switchContextWhenMumbleFinish() {
 	u.switchToMainWindow()
 }
 
+// rejoinAfterCrash joins the meeting again if Mumble crashed, after
+// waiting as long as the rejoiner says. It returns false if it won't
+func (u *gtkUI) rejoinAfterCrash(r *client.Rejoiner, status tor.ExitStatus, join func()) bool {
+	delay, ok := r.Next(status)
+	if !ok {
+		return false
+	}
+
+	log.WithFields(log.Fields{
+		"attempt": r.Attempts(),
+		"delay":   delay,
+	}).Warn("Mumble finished unexpectedly, joining the meeting again")
+
+	u.doInUIThread(func() {
+		u.hideCurrentWindow()
+		u.displayLoadingWindow()
+	})
+
+	time.AfterFunc(delay, join)
+
+	return true
+}
+
+func (u *gtkUI) reportMumbleHandoff() {
+	u.doInUIThread(func() {
+		u.reportError(i18n.Sprintf("Mumble closed right after starting. This happens when another Mumble " +
+			"is already running and this one can't run at the same time: the meeting is passed to the " +
+			"other Mumble, that doesn't use the settings Wahay made for it. Please close the other Mumble and try again."))
+	})
+}
+
+func (u *gtkUI) reportMumbleCrash(status tor.ExitStatus) {
+	u.doInUIThread(func() {
+		u.reportError(i18n.Sprintf("Mumble finished unexpectedly and the meeting couldn't be joined again:\n\n%s", status.Err))
+	})
+}
+
+// retryAfterCertificateProblem explains why the meeting can't be joined
+// when its certificate couldn't be checked, and lets the participant
+// try again. It returns false if the error has nothing to do with it
+func (u *gtkUI) retryAfterCertificateProblem(err error, retry, cancel func()) bool {
+	var certErr *client.CertificateError
+	if !errors.As(err, &certErr) {
+		return false
+	}
+
+	text := i18n.Sprintf("Wahay couldn't get the certificate of the meeting, so it can't make sure "+
+		"that you are joining the meeting you were invited to. This can happen when the meeting "+
+		"has just started or the connection to Tor is slow, so you can try again in a moment.\n\n%s", certErr.Err)
+	if certErr.Mismatch {
+		text = i18n.Sprintf("The server of the meeting presented a different certificate than the one " +
+			"the meeting published. Somebody could be pretending to be the meeting, so Wahay didn't join it. " +
+			"If you try again and this keeps happening, ask the host for a new invitation.")
+	}
+
+	u.doInUIThread(func() {
+		u.showConfirmationWith(func(op bool) {
+			if op {
+				go retry()
+				return
+			}
+			cancel()
+		}, i18n.Sprintf("The certificate of the meeting couldn't be checked"), text, i18n.Sprintf("Retry"))
+	})
+
+	return true
+}
+
 const errGroupMumble errGroupType = "mumble"
 
 func init() {
 	initStartupErrorGroup(errGroupMumble, mumbleErrorTranslator)
+	initStartupWarningTranslator(errGroupMumble, mumbleWarningTranslator)
 }
 
 func mumbleErrorTranslator(err error) string {
 	return i18n.Sprintf("Mumble client can't be used because:\n\n%s", err.Error())
 }
+
+func mumbleWarningTranslator(err error) string {
+	return i18n.Sprintf("Mumble client might not work well because:\n\n%s", err.Error())
+}
@@ -62,7 +62,17 @@ func (u *gtkUI) getMasterPasswordBuilder() *uiBuilder {
 		"tooltip", "btnTogglePassword",
 		"label", "lblMasterPasswordText",
 		"button", "btnMasterPasswordCancel",
-		"button", "btnMasterPasswordContinue")
+		"button", "btnMasterPasswordContinue",
+
+		"title", "changeMasterPasswordWindow",
+		"label", "lblChangeMasterPasswordIntro",
+		"placeholder", "txtCurrentMasterPassword",
+		"placeholder", "txtNewMasterPassword",
+		"placeholder", "txtNewMasterPasswordRepeat",
+		"checkbox", "chkArgon2id",
+		"tooltip", "chkArgon2id",
+		"button", "btnChangeMasterPasswordCancel",
+		"button", "btnChangeMasterPasswordContinue")
 
 	return builder
 }
@@ -0,0 +1,162 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// chooseProfile sets the profile to use before loading the configuration.
+// It's the one given in the command line or, if there are named profiles,
+// the one chosen in the profile window. It returns false if no profile
+// was chosen
+func (u *gtkUI) chooseProfile() bool {
+	name := *config.Profile
+
+	if len(name) == 0 && len(config.ListProfiles()) > 0 {
+		var ok bool
+		name, ok = u.askForProfile()
+		if !ok {
+			return false
+		}
+	}
+
+	err := u.config.SetProfile(name)
+	if err != nil {
+		u.reportError(profileErrorMessage(err))
+		return false
+	}
+
+	return true
+}
+
+func (u *gtkUI) getProfileBuilder() *uiBuilder {
+	builder := u.g.uiBuilderFor("ProfileWindow")
+
+	builder.i18nProperties(
+		"title", "profileWindow",
+		"label", "lblProfileIntro",
+		"label", "lblNewProfile",
+		"placeholder", "entNewProfile",
+		"button", "btnProfileCancel",
+		"button", "btnProfileContinue")
+
+	return builder
+}
+
+// askForProfile shows the profile window and waits until a profile is
+// chosen or created. It must not be called from the UI thread
+func (u *gtkUI) askForProfile() (string, bool) {
+	u.hideLoadingWindow()
+
+	profiles := append([]string{""}, config.ListProfiles()...)
+	chosen := make(chan string, 1)
+	cancelled := make(chan bool)
+
+	u.doInUIThread(func() {
+		builder := u.getProfileBuilder()
+
+		var win gtki.Window
+		var cmbProfile gtki.ComboBoxText
+		var entNewProfile gtki.Entry
+		var lblError gtki.Label
+
+		builder.getItems(
+			"profileWindow", &win,
+			"cmbProfile", &cmbProfile,
+			"entNewProfile", &entNewProfile,
+			"lblProfileError", &lblError,
+		)
+
+		win.SetApplication(u.app)
+
+		for _, p := range profiles {
+			cmbProfile.AppendText(profileLabel(p))
+		}
+		cmbProfile.SetActive(0)
+
+		builder.ConnectSignals(map[string]interface{}{
+			"on_cancel": func() {
+				win.Destroy()
+				close(cancelled)
+			},
+			"on_continue": func() {
+				text, _ := entNewProfile.GetText()
+				name := strings.TrimSpace(text)
+
+				if len(name) == 0 {
+					name = profiles[0]
+					if i := cmbProfile.GetActive(); i > 0 && i < len(profiles) {
+						name = profiles[i]
+					}
+				} else if err := config.CreateProfile(name); err != nil && err != config.ErrProfileExists {
+					lblError.SetText(profileErrorMessage(err))
+					lblError.Show()
+					entNewProfile.GrabFocus()
+					return
+				}
+
+				win.Destroy()
+				chosen <- name
+			},
+		})
+
+		win.Show()
+	})
+
+	select {
+	case name := <-chosen:
+		u.displayLoadingWindow()
+		return name, true
+	case <-cancelled:
+		return "", false
+	}
+}
+
+// profileLabel returns the name of the profile as it's shown
+func profileLabel(name string) string {
+	if len(name) == 0 {
+		return i18n.Sprintf("Default profile")
+	}
+	return name
+}
+
+func profileErrorMessage(err error) string {
+	switch err {
+	case config.ErrInvalidProfileName:
+		return i18n.Sprintf("The name of a profile can only have letters, numbers, spaces, dots, " +
+			"dashes and underscores, and it can't start with a dot or a space.")
+	case config.ErrProfileExists:
+		return i18n.Sprintf("There is already a profile with that name.")
+	}
+
+	return i18n.Sprintf("The profile can't be created: %s", err)
+}
+
+func (s *settings) initProfileControls() {
+	profile := s.u.config.GetProfile()
+	if len(profile) == 0 {
+		s.lblCurrentProfile.SetText(i18n.Sprintf("You are using the default profile. Every profile has " +
+			"its own settings, identity and scheduled meetings."))
+		return
+	}
+
+	s.lblCurrentProfile.SetText(i18n.Sprintf("You are using the profile %s. Every profile has "+
+		"its own settings, identity and scheduled meetings.", profile))
+}
+
+func (s *settings) createProfile() {
+	text, _ := s.entNewProfileName.GetText()
+	name := strings.TrimSpace(text)
+
+	err := config.CreateProfile(name)
+	if err != nil {
+		go s.u.messageToLabel(s.lblProfileMessage, profileErrorMessage(err), 5)
+		return
+	}
+
+	s.entNewProfileName.SetText("")
+	go s.u.messageToLabel(s.lblProfileMessage,
+		i18n.Sprintf("The profile %s has been created. You can choose it the next time Wahay starts.", name), 10)
+}
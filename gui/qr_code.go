@@ -0,0 +1,91 @@
+package gui
+
+import (
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/qr"
+)
+
+const (
+	// The approximate size of the code shown in the window, in pixels
+	qrCodeDisplaySize = 200
+
+	// Every module is this many pixels wide in the saved image,
+	// which is enough to print it or to show it in another screen
+	qrCodeSaveScale = 10
+)
+
+func (h *hostData) getInvitationQRCode(builder *uiBuilder) (*qr.Code, error) {
+	chk := builder.get("chkQRPassword").(gtki.CheckButton)
+	return qr.Encode(h.getInvitationDetails(chk.GetActive()))
+}
+
+func (h *hostData) showInvitationQRCode(builder *uiBuilder) {
+	chk := builder.get("chkQRPassword").(gtki.CheckButton)
+	chk.SetVisible(len(h.meetingPassword) != 0)
+
+	box := builder.get("boxQRCode").(gtki.Box)
+	img := builder.get("imgQRCode").(gtki.Image)
+
+	code, err := h.getInvitationQRCode(builder)
+	if err != nil {
+		log.Errorf("showInvitationQRCode(): %s", err)
+		box.SetVisible(false)
+		return
+	}
+
+	// Only whole pixels per module, so the code is never blurred
+	scale := qrCodeDisplaySize / code.Size
+	if scale < 1 {
+		scale = 1
+	}
+
+	data, err := code.PNG(scale)
+	if err != nil {
+		log.Errorf("showInvitationQRCode(): %s", err)
+		box.SetVisible(false)
+		return
+	}
+
+	pb, err := h.u.g.getPixbufFromBytes(data, code.Width(scale))
+	if err != nil {
+		log.Errorf("showInvitationQRCode(): %s", err)
+		box.SetVisible(false)
+		return
+	}
+
+	img.SetFromPixbuf(pb)
+	box.SetVisible(true)
+}
+
+func (h *hostData) saveInvitationQRCode(builder *uiBuilder) {
+	code, err := h.getInvitationQRCode(builder)
+	if err != nil {
+		h.u.reportError(i18n.Sprintf("The QR code can't be created: %s", err))
+		return
+	}
+
+	lblMessage := builder.get("lblMessage").(gtki.Label)
+
+	go func() {
+		ok, filename := h.u.getSaveFilePath("wahay-meeting.png")
+		if !ok {
+			return
+		}
+
+		data, err := code.PNG(qrCodeSaveScale)
+		if err == nil {
+			err = ioutil.WriteFile(filename, data, 0600)
+		}
+
+		if err != nil {
+			h.u.reportError(i18n.Sprintf("The QR code can't be saved: %s", err))
+			return
+		}
+
+		h.u.messageToLabel(lblMessage, i18n.Sprintf("The QR code has been saved"), 5)
+	}()
+}
@@ -0,0 +1,419 @@
+package gui
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+const (
+	scheduledTitleColumn     = 0
+	scheduledStartColumn     = 1
+	scheduledMeetingIDColumn = 2
+	scheduledIDColumn        = 3
+
+	scheduleTimeFormat     = "2006-01-02 15:04"
+	scheduleCheckInterval  = 30 * time.Second
+	unscheduledMeetingTime = time.Hour
+)
+
+var (
+	errScheduleNoTitle         = errors.New("the title is required")
+	errScheduleInvalidStart    = errors.New("the start time must look like 2020-05-04 10:30")
+	errSchedulePastStart       = errors.New("the start time has already passed")
+	errScheduleInvalidDuration = errors.New("the duration must be a number of minutes")
+)
+
+type scheduledMeetingsWindow struct {
+	u           *gtkUI
+	win         gtki.Window
+	store       gtki.ListStore
+	tree        gtki.TreeView
+	inpTitle    gtki.Entry
+	inpStart    gtki.Entry
+	inpDuration gtki.Entry
+	inpPassword gtki.Entry
+	lblMessage  gtki.Label
+}
+
+func (u *gtkUI) getScheduledMeetingsBuilder() *uiBuilder {
+	builder := u.g.uiBuilderFor("ScheduledMeetingsWindow")
+
+	builder.i18nProperties(
+		"title", "scheduledMeetingsWindow",
+		"label", "lblDescription",
+		"label", "lblScheduleTitle",
+		"label", "lblScheduleStart",
+		"label", "lblScheduleDuration",
+		"label", "lblSchedulePassword",
+		"placeholder", "inpScheduleTitle",
+		"placeholder", "inpScheduleStart",
+		"placeholder", "inpSchedulePassword",
+		"button", "btnScheduleMeeting",
+		"title", "columnScheduleTitle",
+		"title", "columnScheduleStart",
+		"title", "columnScheduleMeetingID",
+		"button", "btnExportCalendar",
+		"button", "btnStartScheduledMeeting",
+		"button", "btnRemoveScheduledMeeting",
+		"button", "btnClose")
+
+	return builder
+}
+
+func (u *gtkUI) openScheduledMeetingsWindow() {
+	builder := u.getScheduledMeetingsBuilder()
+
+	sw := &scheduledMeetingsWindow{
+		u:           u,
+		win:         builder.get("scheduledMeetingsWindow").(gtki.Window),
+		store:       builder.get("scheduledMeetingsStore").(gtki.ListStore),
+		tree:        builder.get("treeScheduledMeetings").(gtki.TreeView),
+		inpTitle:    builder.get("inpScheduleTitle").(gtki.Entry),
+		inpStart:    builder.get("inpScheduleStart").(gtki.Entry),
+		inpDuration: builder.get("inpScheduleDuration").(gtki.Entry),
+		inpPassword: builder.get("inpSchedulePassword").(gtki.Entry),
+		lblMessage:  builder.get("lblMessage").(gtki.Label),
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_schedule_meeting": sw.scheduleMeeting,
+		"on_export_calendar":  sw.exportSelectedMeeting,
+		"on_start_meeting":    sw.startSelectedMeeting,
+		"on_remove_meeting":   sw.removeSelectedMeeting,
+		"on_close":            sw.win.Destroy,
+		"on_close_window_signal": func() {
+			u.enableMainWindow()
+		},
+	})
+
+	sw.refresh()
+
+	if u.mainWindow != nil {
+		sw.win.SetTransientFor(u.mainWindow)
+		u.disableMainWindow()
+	}
+
+	sw.win.Present()
+	sw.win.Show()
+}
+
+func (sw *scheduledMeetingsWindow) refresh() {
+	sw.store.Clear()
+
+	for _, m := range sw.u.config.GetScheduledMeetings() {
+		url, err := scheduledMeetingURL(m)
+		if err != nil {
+			log.Errorf("scheduled meetings refresh(): %s", err)
+			continue
+		}
+
+		iter := sw.store.Append()
+		err = sw.store.Set2(iter,
+			[]int{scheduledTitleColumn, scheduledStartColumn, scheduledMeetingIDColumn, scheduledIDColumn},
+			[]interface{}{m.Title, m.Start.Format(scheduleTimeFormat), url, m.ID})
+		if err != nil {
+			log.Errorf("scheduled meetings refresh(): %s", err)
+		}
+	}
+}
+
+func (sw *scheduledMeetingsWindow) selected() (*config.ScheduledMeeting, bool) {
+	selection, err := sw.tree.GetSelection()
+	if err != nil {
+		return nil, false
+	}
+
+	_, iter, ok := selection.GetSelected()
+	if !ok {
+		return nil, false
+	}
+
+	v, err := sw.store.GetValue(iter, scheduledIDColumn)
+	if err != nil {
+		return nil, false
+	}
+
+	id, _ := v.GetString()
+	m := sw.u.config.GetScheduledMeeting(id)
+
+	return m, m != nil
+}
+
+// scheduleMeeting keeps the private key of the meeting address in the
+// configuration file, so it needs one that is kept when Wahay closes.
+// If it's not encrypted, the host has to agree to save the key in plain text
+func (sw *scheduledMeetingsWindow) scheduleMeeting() {
+	if !sw.u.config.IsPersistentConfiguration() {
+		sw.u.reportError(i18n.Sprintf("Scheduled meetings are kept in the configuration file, " +
+			"but Wahay is not keeping it when it closes. Please enable it in the settings first."))
+		return
+	}
+
+	if sw.u.config.ShouldEncrypt() {
+		sw.addScheduledMeeting()
+		return
+	}
+
+	sw.u.showConfirmationWith(func(op bool) {
+		if op {
+			sw.addScheduledMeeting()
+		}
+	}, i18n.Sprintf("Schedule meeting"), i18n.Sprintf("The configuration file is not encrypted with a master password, "+
+		"so the private key of the meeting address and its password will be saved in plain text. "+
+		"Anybody who can read the file could host the meeting in your place. "+
+		"Do you want to schedule the meeting anyway?"), i18n.Sprintf("Schedule"))
+}
+
+func (sw *scheduledMeetingsWindow) addScheduledMeeting() {
+	title, _ := sw.inpTitle.GetText()
+	start, _ := sw.inpStart.GetText()
+	duration, _ := sw.inpDuration.GetText()
+	password, _ := sw.inpPassword.GetText()
+
+	m, err := parseScheduledMeeting(title, start, duration, time.Now())
+	if err != nil {
+		sw.u.reportError(i18n.Sprintf("The meeting can't be scheduled: %s", err))
+		return
+	}
+
+	key, err := tor.NewOnionKey()
+	if err != nil {
+		sw.u.reportError(i18n.Sprintf("The meeting can't be scheduled: %s", err))
+		return
+	}
+
+	m.OnionKey = string(key)
+	m.Port = sw.u.config.GetPortMumble()
+	m.Password = password
+
+	sw.u.config.AddScheduledMeeting(m)
+	sw.u.saveConfigOnly()
+
+	sw.inpTitle.SetText("")
+	sw.inpStart.SetText("")
+	sw.inpPassword.SetText("")
+	sw.refresh()
+}
+
+func (sw *scheduledMeetingsWindow) exportSelectedMeeting() {
+	m, ok := sw.selected()
+	if !ok {
+		return
+	}
+
+	url, err := scheduledMeetingURL(m)
+	if err != nil {
+		sw.u.reportError(i18n.Sprintf("The calendar file can't be created: %s", err))
+		return
+	}
+
+	e := hosting.CalendarEvent{
+		UID:         m.ID + "@wahay",
+		Title:       m.Title,
+		Description: calendarDescription(url, m.Password, ""),
+		Location:    url,
+		Start:       m.Start,
+		Duration:    m.Duration,
+	}
+
+	sw.u.exportCalendarEvent(e, sw.lblMessage)
+}
+
+func (sw *scheduledMeetingsWindow) startSelectedMeeting() {
+	m, ok := sw.selected()
+	if !ok {
+		return
+	}
+
+	sw.win.Destroy()
+	sw.u.hostScheduledMeeting(m)
+}
+
+func (sw *scheduledMeetingsWindow) removeSelectedMeeting() {
+	m, ok := sw.selected()
+	if !ok {
+		return
+	}
+
+	sw.u.config.RemoveScheduledMeeting(m.ID)
+	sw.u.saveConfigOnly()
+	sw.refresh()
+}
+
+func parseScheduledMeeting(title, start, duration string, now time.Time) (*config.ScheduledMeeting, error) {
+	title = strings.TrimSpace(title)
+	if len(title) == 0 {
+		return nil, errScheduleNoTitle
+	}
+
+	s, err := time.ParseInLocation(scheduleTimeFormat, strings.TrimSpace(start), now.Location())
+	if err != nil {
+		return nil, errScheduleInvalidStart
+	}
+
+	if !s.After(now) {
+		return nil, errSchedulePastStart
+	}
+
+	minutes, err := strconv.Atoi(strings.TrimSpace(duration))
+	if err != nil || minutes <= 0 {
+		return nil, errScheduleInvalidDuration
+	}
+
+	return &config.ScheduledMeeting{
+		Title:    title,
+		Start:    s,
+		Duration: time.Duration(minutes) * time.Minute,
+	}, nil
+}
+
+// scheduledMeetingURL returns the meeting ID that participants will use,
+// in the same way hosting.Service does once the meeting has started
+func scheduledMeetingURL(m *config.ScheduledMeeting) (string, error) {
+	id, err := tor.OnionKey(m.OnionKey).ServiceID()
+	if err != nil {
+		return "", err
+	}
+
+	if m.Port != "" && m.Port != strconv.Itoa(hosting.DefaultPort) {
+		return net.JoinHostPort(id, m.Port), nil
+	}
+
+	return id, nil
+}
+
+func calendarDescription(url, password, browserURL string) string {
+	d := i18n.Sprintf("Please join the Wahay meeting with the following details:") + "\n\n"
+	d += i18n.Sprintf("Meeting ID: %s", url) + "\n"
+
+	if len(password) != 0 {
+		d += i18n.Sprintf("Password: %s", password) + "\n"
+	}
+
+	if len(browserURL) != 0 {
+		d += i18n.Sprintf("Browser address: %s", browserURL) + "\n"
+	}
+
+	d += "\n" + i18n.Sprintf("To join, open Wahay, click on \"Join a meeting\" and enter the meeting ID.")
+
+	return d
+}
+
+func calendarFileName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, title)
+
+	name = strings.Trim(name, "-")
+	if len(name) == 0 {
+		name = "wahay-meeting"
+	}
+
+	return name + ".ics"
+}
+
+func (u *gtkUI) exportCalendarEvent(e hosting.CalendarEvent, lblMessage gtki.Label) {
+	go func() {
+		ok, filename := u.getSaveFilePath(calendarFileName(e.Title))
+		if !ok {
+			return
+		}
+
+		err := ioutil.WriteFile(filename, e.ICS(), 0600)
+		if err != nil {
+			u.reportError(i18n.Sprintf("The calendar file can't be created: %s", err))
+			return
+		}
+
+		u.messageToLabel(lblMessage, i18n.Sprintf("The calendar file has been saved"), 5)
+	}()
+}
+
+// exportMeetingToCalendar saves the current meeting as a calendar
+// event. Meetings that were not scheduled are supposed to start now
+func (h *hostData) exportMeetingToCalendar(lblMessage gtki.Label) {
+	e := hosting.CalendarEvent{
+		UID:         h.service.ID() + "@wahay",
+		Title:       h.getInvitationSubject(),
+		Description: calendarDescription(h.service.URL(), h.meetingPassword, h.browserURL()),
+		Location:    h.service.URL(),
+		Start:       time.Now(),
+		Duration:    unscheduledMeetingTime,
+	}
+
+	if h.scheduled != nil {
+		e.UID = h.scheduled.ID + "@wahay"
+		e.Title = h.scheduled.Title
+		e.Start = h.scheduled.Start
+		e.Duration = h.scheduled.Duration
+	}
+
+	h.u.exportCalendarEvent(e, lblMessage)
+}
+
+func (h *hostData) browserURL() string {
+	if h.webSocket {
+		return h.service.WebSocketURL()
+	}
+	return ""
+}
+
+func (u *gtkUI) hostScheduledMeeting(m *config.ScheduledMeeting) {
+	go u.realHostMeetingHandler(m)
+}
+
+// watchScheduledMeetings offers to start each scheduled
+// meeting once, as soon as its time arrives
+func (u *gtkUI) watchScheduledMeetings() {
+	offered := make(map[string]bool)
+
+	t := time.NewTicker(scheduleCheckInterval)
+	defer t.Stop()
+
+	for range t.C {
+		u.doInUIThread(func() {
+			u.offerScheduledMeeting(offered)
+		})
+	}
+}
+
+func (u *gtkUI) offerScheduledMeeting(offered map[string]bool) {
+	// Nobody is looking at the main window while a meeting or
+	// another window is open, so we will ask again later
+	if u.mainWindow == nil || u.currentWindow != u.mainWindow || !u.mainWindow.IsVisible() {
+		return
+	}
+
+	now := time.Now()
+	for _, m := range u.config.GetScheduledMeetings() {
+		if offered[m.ID] || now.Before(m.Start) || now.After(m.End()) {
+			continue
+		}
+
+		offered[m.ID] = true
+
+		meeting := m
+		u.showConfirmation(func(start bool) {
+			if start {
+				u.hostScheduledMeeting(meeting)
+			}
+		}, i18n.Sprintf("It's time for the scheduled meeting \"%s\". Do you want to start it now?", m.Title))
+
+		return
+	}
+}
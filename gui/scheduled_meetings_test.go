@@ -0,0 +1,59 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type WahayScheduledMeetingsSuite struct{}
+
+var _ = Suite(&WahayScheduledMeetingsSuite{})
+
+func (s *WahayScheduledMeetingsSuite) Test_parseScheduledMeeting_readsTheForm(c *C) {
+	now := time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	m, err := parseScheduledMeeting(" Weekly ", "2020-05-04 10:30", "45", now)
+
+	c.Assert(err, IsNil)
+	c.Assert(m.Title, Equals, "Weekly")
+	c.Assert(m.Start, Equals, time.Date(2020, 5, 4, 10, 30, 0, 0, time.UTC))
+	c.Assert(m.Duration, Equals, 45*time.Minute)
+}
+
+func (s *WahayScheduledMeetingsSuite) Test_parseScheduledMeeting_rejectsInvalidValues(c *C) {
+	now := time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	_, err := parseScheduledMeeting("", "2020-05-04 10:30", "45", now)
+	c.Assert(err, Equals, errScheduleNoTitle)
+
+	_, err = parseScheduledMeeting("Weekly", "tomorrow", "45", now)
+	c.Assert(err, Equals, errScheduleInvalidStart)
+
+	_, err = parseScheduledMeeting("Weekly", "2020-04-30 10:30", "45", now)
+	c.Assert(err, Equals, errSchedulePastStart)
+
+	_, err = parseScheduledMeeting("Weekly", "2020-05-04 10:30", "0", now)
+	c.Assert(err, Equals, errScheduleInvalidDuration)
+}
+
+func (s *WahayScheduledMeetingsSuite) Test_scheduledMeetingURL_includesOnlyANonDefaultPort(c *C) {
+	key, _ := tor.NewOnionKey()
+	id, _ := key.ServiceID()
+
+	u1, _ := scheduledMeetingURL(&config.ScheduledMeeting{OnionKey: string(key)})
+	u2, _ := scheduledMeetingURL(&config.ScheduledMeeting{OnionKey: string(key), Port: "64738"})
+	u3, _ := scheduledMeetingURL(&config.ScheduledMeeting{OnionKey: string(key), Port: "8080"})
+
+	c.Assert(u1, Equals, id)
+	c.Assert(u2, Equals, id)
+	c.Assert(u3, Equals, id+":8080")
+}
+
+func (s *WahayScheduledMeetingsSuite) Test_calendarFileName_onlyUsesSafeCharacters(c *C) {
+	c.Assert(calendarFileName("Team sync: Q2/Q3"), Equals, "team-sync--q2-q3.ics")
+	c.Assert(calendarFileName("///"), Equals, "wahay-meeting.ics")
+}
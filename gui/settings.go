@@ -16,8 +16,14 @@ type settings struct {
 	dialog gtki.Window
 
 	chkAutojoin                gtki.CheckButton
+	chkEventFeed               gtki.CheckButton
+	lblCurrentProfile          gtki.Label
+	entNewProfileName          gtki.Entry
+	lblProfileMessage          gtki.Label
 	chkPersistentConfiguration gtki.CheckButton
 	chkEncryptFile             gtki.CheckButton
+	btnChangeMasterPassword    gtki.Button
+	lblMasterPasswordMessage   gtki.Label
 	lblMessage                 gtki.Label
 	chkEnableLogging           gtki.CheckButton
 	rawLogFile                 gtki.Entry
@@ -25,14 +31,32 @@ type settings struct {
 	mumbleBinaryLocation       gtki.Entry
 	mumblePort                 gtki.Entry
 	lblPortMumbleMessage       gtki.Label
+	chkPersistentIdentity      gtki.CheckButton
+	chkStrictCertificates      gtki.CheckButton
+	lblIdentityFingerprint     gtki.Label
+	lblIdentityMessage         gtki.Label
+	btnExportIdentity          gtki.Button
+	btnImportIdentity          gtki.Button
+	btnRotateIdentity          gtki.Button
+	lblSettingsTransferMessage gtki.Label
+	cmbAudioBackend            gtki.ComboBoxText
+	entAudioInputDevice        gtki.Entry
+	entAudioOutputDevice       gtki.Entry
+	cmbTransmitMode            gtki.ComboBoxText
+	spnVoiceThreshold          gtki.SpinButton
+	cmbPushToTalkKey           gtki.ComboBoxText
+	cmbAudioQuality            gtki.ComboBoxText
 
 	autoJoinOriginalValue          bool
+	eventFeedOriginalValue         bool
 	persistConfigFileOriginalValue bool
 	encryptFileOriginalValue       bool
 	logOriginalValue               bool
 	rawLogFileOriginalValue        string
 	mumbleBinaryOriginalValue      string
 	mumblePortOriginalValue        string
+	identityOriginalValue          bool
+	strictCertificatesOriginal     bool
 }
 
 func createSettings(u *gtkUI) *settings {
@@ -47,8 +71,14 @@ func createSettings(u *gtkUI) *settings {
 
 	s.b.getItems(
 		"chkAutojoin", &s.chkAutojoin,
+		"chkEventFeed", &s.chkEventFeed,
+		"lblCurrentProfile", &s.lblCurrentProfile,
+		"entNewProfileName", &s.entNewProfileName,
+		"lblProfileMessage", &s.lblProfileMessage,
 		"chkPersistentConfiguration", &s.chkPersistentConfiguration,
 		"chkEncryptFile", &s.chkEncryptFile,
+		"btnChangeMasterPassword", &s.btnChangeMasterPassword,
+		"lblMasterPasswordMessage", &s.lblMasterPasswordMessage,
 		"lblMessage", &s.lblMessage,
 		"chkEnableLogging", &s.chkEnableLogging,
 		"rawLogFile", &s.rawLogFile,
@@ -56,6 +86,21 @@ func createSettings(u *gtkUI) *settings {
 		"mumbleBinaryLocation", &s.mumbleBinaryLocation,
 		"mumblePort", &s.mumblePort,
 		"lblPortMumbleMessage", &s.lblPortMumbleMessage,
+		"chkPersistentIdentity", &s.chkPersistentIdentity,
+		"chkStrictCertificates", &s.chkStrictCertificates,
+		"lblIdentityFingerprint", &s.lblIdentityFingerprint,
+		"lblIdentityMessage", &s.lblIdentityMessage,
+		"btnExportIdentity", &s.btnExportIdentity,
+		"btnImportIdentity", &s.btnImportIdentity,
+		"btnRotateIdentity", &s.btnRotateIdentity,
+		"lblSettingsTransferMessage", &s.lblSettingsTransferMessage,
+		"cmbAudioBackend", &s.cmbAudioBackend,
+		"entAudioInputDevice", &s.entAudioInputDevice,
+		"entAudioOutputDevice", &s.entAudioOutputDevice,
+		"cmbTransmitMode", &s.cmbTransmitMode,
+		"spnVoiceThreshold", &s.spnVoiceThreshold,
+		"cmbPushToTalkKey", &s.cmbPushToTalkKey,
+		"cmbAudioQuality", &s.cmbAudioQuality,
 	)
 
 	s.init()
@@ -69,6 +114,11 @@ func (s *settings) init() {
 	s.autoJoinOriginalValue = conf.GetAutoJoin()
 	s.chkAutojoin.SetActive(s.autoJoinOriginalValue)
 
+	s.eventFeedOriginalValue = conf.GetEventFeed()
+	s.chkEventFeed.SetActive(s.eventFeedOriginalValue)
+
+	s.initProfileControls()
+
 	s.persistConfigFileOriginalValue = conf.IsPersistentConfiguration()
 	s.chkPersistentConfiguration.SetActive(s.persistConfigFileOriginalValue)
 	s.lblMessage.SetVisible(!s.persistConfigFileOriginalValue)
@@ -76,6 +126,7 @@ func (s *settings) init() {
 	s.encryptFileOriginalValue = conf.ShouldEncrypt()
 	s.chkEncryptFile.SetActive(s.encryptFileOriginalValue)
 	s.chkEncryptFile.SetSensitive(s.persistConfigFileOriginalValue)
+	s.updateMasterPasswordControls()
 
 	s.logOriginalValue = conf.IsLogsEnabled()
 	s.chkEnableLogging.SetActive(s.logOriginalValue)
@@ -88,6 +139,15 @@ func (s *settings) init() {
 	s.mumbleBinaryLocation.SetText(s.mumbleBinaryOriginalValue)
 	s.mumblePortOriginalValue = conf.GetPortMumble()
 	s.mumblePort.SetText(s.mumblePortOriginalValue)
+
+	s.identityOriginalValue = conf.GetPersistentIdentity()
+	s.chkPersistentIdentity.SetActive(s.identityOriginalValue)
+	s.updateIdentityControls()
+
+	s.strictCertificatesOriginal = conf.GetStrictCertificates()
+	s.chkStrictCertificates.SetActive(s.strictCertificatesOriginal)
+
+	s.initAudioPreferences()
 }
 
 func (u *gtkUI) getSettingsBuilder() *uiBuilder {
@@ -95,14 +155,23 @@ func (u *gtkUI) getSettingsBuilder() *uiBuilder {
 
 	builder.i18nProperties(
 		"checkbox", "chkAutojoin",
+		"checkbox", "chkEventFeed",
 		"checkbox", "chkPersistentConfiguration",
 		"checkbox", "chkEncryptFile",
 		"checkbox", "chkEnableLogging",
+		"checkbox", "chkPersistentIdentity",
+		"checkbox", "chkStrictCertificates",
 		"tooltip", "chkAutojoin",
+		"tooltip", "chkEventFeed",
 		"tooltip", "chkPersistentConfiguration",
 		"tooltip", "chkEnableLogging",
+		"tooltip", "chkPersistentIdentity",
+		"tooltip", "chkStrictCertificates",
 		"label", "lblAutojoin",
+		"label", "lblEventFeed",
 		"label", "lblHostingGroup",
+		"label", "lblProfileGroup",
+		"label", "lblCreateProfile",
 		"label", "tabGeneral",
 		"label", "tabSecurity",
 		"label", "tabDebug",
@@ -123,12 +192,34 @@ func (u *gtkUI) getSettingsBuilder() *uiBuilder {
 		"label", "lblConfigFileCorrupted",
 		"label", "lblConfigFileCorruptedHelp",
 		"label", "lblMumbleBinaryDescription",
+		"label", "lblIdentityDescription",
+		"label", "lblExportIdentity",
+		"label", "lblImportIdentity",
+		"label", "lblRotateIdentity",
+		"label", "lblStrictCertificatesDescription",
+		"label", "lblChangeMasterPassword",
+		"label", "lblSettingsTransfer",
+		"label", "lblSettingsTransferDescription",
+		"label", "lblExportSettings",
+		"label", "lblImportSettings",
+		"label", "lblAudioGroup",
+		"label", "lblAudioBackend",
+		"label", "lblAudioInputDevice",
+		"label", "lblAudioOutputDevice",
+		"label", "lblTransmitMode",
+		"label", "lblVoiceThreshold",
+		"label", "lblPushToTalkKey",
+		"label", "lblAudioQuality",
+		"label", "lblAudioHelp",
 		"button", "btnCancelSettings",
 		"button", "btnSaveSettings",
 		"button", "btnConfigFileCorruptedCancel",
 		"button", "btnConfigFileCorruptedBackup",
 		"placeholder", "mumbleBinaryLocation",
-		"placeholder", "mumblePort")
+		"placeholder", "mumblePort",
+		"placeholder", "entNewProfileName",
+		"placeholder", "entAudioInputDevice",
+		"placeholder", "entAudioOutputDevice")
 
 	return builder
 }
@@ -142,6 +233,15 @@ func (s *settings) processAutojoinOption() {
 	}
 }
 
+func (s *settings) processEventFeedOption() {
+	conf := s.u.config
+
+	if s.chkEventFeed.GetActive() != s.eventFeedOriginalValue {
+		conf.SetEventFeed(!s.eventFeedOriginalValue)
+		s.eventFeedOriginalValue = !s.eventFeedOriginalValue
+	}
+}
+
 func (s *settings) processPersistentConfigOption() {
 	conf := s.u.config
 
@@ -150,6 +250,7 @@ func (s *settings) processPersistentConfigOption() {
 		conf.SetPersistentConfiguration(!s.persistConfigFileOriginalValue)
 		s.persistConfigFileOriginalValue = !s.persistConfigFileOriginalValue
 		s.chkEncryptFile.SetSensitive(s.persistConfigFileOriginalValue)
+		s.updateMasterPasswordControls()
 	}
 }
 
@@ -163,6 +264,7 @@ func (s *settings) processEncryptFileOption() {
 					s.encryptFileOriginalValue = false
 					conf.SetShouldEncrypt(false)
 					s.chkEncryptFile.SetActive(false)
+					s.updateMasterPasswordControls()
 				} else {
 					// We keep the checkbutton checked. Nothing else change.
 					s.chkEncryptFile.SetActive(true)
@@ -192,6 +294,15 @@ func (s *settings) processLogsOption() {
 	}
 }
 
+func (s *settings) processStrictCertificatesOption() {
+	conf := s.u.config
+
+	if s.chkStrictCertificates.GetActive() != s.strictCertificatesOriginal {
+		s.strictCertificatesOriginal = !s.strictCertificatesOriginal
+		conf.SetStrictCertificates(s.strictCertificatesOriginal)
+	}
+}
+
 func (s *settings) processMumblePort() {
 	conf := s.u.config
 	v, _ := s.mumblePort.GetText()
@@ -200,9 +311,12 @@ func (s *settings) processMumblePort() {
 
 func (u *gtkUI) onSettingsToggleOption(s *settings) {
 	s.processAutojoinOption()
+	s.processEventFeedOption()
 	s.processPersistentConfigOption()
 	s.processEncryptFileOption()
 	s.processLogsOption()
+	s.processPersistentIdentityOption()
+	s.processStrictCertificatesOption()
 }
 
 func (u *gtkUI) openSettingsWindow() {
@@ -222,6 +336,7 @@ func (u *gtkUI) openSettingsWindow() {
 		},
 		"on_save": func() {
 			s.processMumblePort()
+			s.processAudioPreferences()
 			u.saveConfigOnly()
 			cleanup()
 		},
@@ -234,6 +349,15 @@ func (u *gtkUI) openSettingsWindow() {
 		"on_mumbleBinaryLocation_clicked_event": s.setCustomPathForMumble,
 		"on_portMumble_insert_text":             s.onInsertPortMumble,
 		"on_portMumble_delete_text":             s.onDeletePortMumble,
+		"on_export_identity":                    s.exportIdentity,
+		"on_import_identity":                    s.importIdentity,
+		"on_rotate_identity":                    s.rotateIdentity,
+		"on_change_master_password":             s.changeMasterPassword,
+		"on_create_profile":                     s.createProfile,
+		"on_export_settings":                    s.exportSettings,
+		"on_import_settings":                    s.importSettings,
+		"on_audio_backend_changed":              s.updateAudioControls,
+		"on_transmit_mode_changed":              s.updateAudioControls,
 	})
 
 	if u.mainWindow != nil {
@@ -319,6 +443,11 @@ func (u *gtkUI) initConfig() {
 }
 
 func (u *gtkUI) loadConfig() {
+	if !u.chooseProfile() {
+		u.quit()
+		return
+	}
+
 	u.config.WhenLoaded(func(c *config.ApplicationConfig) {
 		u.config = c
 		u.doInUIThread(u.initialSetupWindow)
@@ -355,6 +484,11 @@ func (u *gtkUI) ensureConfig(configFile string) bool {
 			continue
 		}
 
+		if err == config.ErrConfigFileTooNew {
+			u.useDefaultConfigInsteadOfNewerFile()
+			break
+		}
+
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -365,6 +499,22 @@ func (u *gtkUI) ensureConfig(configFile string) bool {
 	return false
 }
 
+// useDefaultConfigInsteadOfNewerFile leaves alone the configuration file that
+// a newer version of Wahay wrote, so its settings are not lost, and uses the
+// default settings without saving them
+func (u *gtkUI) useDefaultConfigInsteadOfNewerFile() {
+	log.Warn("The configuration file was written by a newer version of Wahay, using the default settings")
+
+	u.config.SetPersistentConfiguration(false)
+	u.config.InitDefault()
+
+	u.doInUIThread(func() {
+		u.reportError(i18n.Sprintf("The configuration file was written by a newer version of Wahay, " +
+			"so this version can't read it. The default settings will be used, " +
+			"and they won't be saved so the file is not changed."))
+	})
+}
+
 func (u *gtkUI) processCorruptedConfigFileOrExit() bool {
 	if u.regenerateSettingsIfRequiredOrCancel() ||
 		u.regenerateEncryptionKeyIfRequiredOrCancel() {
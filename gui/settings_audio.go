@@ -0,0 +1,166 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func transmitModeLabel(m string) string {
+	switch m {
+	case config.TransmitPushToTalk:
+		return i18n.Sprintf("Push to talk")
+	case config.TransmitVoiceActivity:
+		return i18n.Sprintf("When I talk")
+	case config.TransmitContinuous:
+		return i18n.Sprintf("Always")
+	}
+	return m
+}
+
+func audioQualityLabel(q int) string {
+	switch q {
+	case config.AudioQualityLow:
+		return i18n.Sprintf("Low (16 kbit/s)")
+	case config.AudioQualityBalanced:
+		return i18n.Sprintf("Balanced (40 kbit/s)")
+	case config.AudioQualityHigh:
+		return i18n.Sprintf("High (72 kbit/s)")
+	}
+	return i18n.Sprintf("%d bit/s", q)
+}
+
+func pushToTalkKeyLabel(k string) string {
+	switch k {
+	case "Control_R":
+		return i18n.Sprintf("Right Control")
+	case "Control_L":
+		return i18n.Sprintf("Left Control")
+	case "Alt_R":
+		return i18n.Sprintf("Right Alt")
+	case "Shift_R":
+		return i18n.Sprintf("Right Shift")
+	case "Super_R":
+		return i18n.Sprintf("Right Super")
+	case "Menu":
+		return i18n.Sprintf("Menu")
+	case "Caps_Lock":
+		return i18n.Sprintf("Caps Lock")
+	case "Scroll_Lock":
+		return i18n.Sprintf("Scroll Lock")
+	case "Pause":
+		return i18n.Sprintf("Pause")
+	}
+	return k
+}
+
+func indexOfString(values []string, v string) int {
+	for i, s := range values {
+		if s == v {
+			return i
+		}
+	}
+	return 0
+}
+
+func indexOfInt(values []int, v int) int {
+	for i, n := range values {
+		if n == v {
+			return i
+		}
+	}
+	return 0
+}
+
+func (s *settings) initAudioPreferences() {
+	p := s.u.config.GetAudioPreferences()
+
+	for _, b := range config.AudioBackends {
+		s.cmbAudioBackend.AppendText(b)
+	}
+	s.cmbAudioBackend.SetActive(indexOfString(config.AudioBackends, p.Backend))
+
+	for _, m := range config.TransmitModes {
+		s.cmbTransmitMode.AppendText(transmitModeLabel(m))
+	}
+	s.cmbTransmitMode.SetActive(indexOfString(config.TransmitModes, p.Transmit))
+
+	for _, k := range config.PushToTalkKeys {
+		s.cmbPushToTalkKey.AppendText(pushToTalkKeyLabel(k))
+	}
+	s.cmbPushToTalkKey.SetActive(indexOfString(config.PushToTalkKeys, p.PushToTalkKey))
+
+	for _, q := range config.AudioQualities {
+		s.cmbAudioQuality.AppendText(audioQualityLabel(q))
+	}
+	s.cmbAudioQuality.SetActive(indexOfInt(config.AudioQualities, p.Quality))
+
+	s.entAudioInputDevice.SetText(p.InputDevice)
+	s.entAudioOutputDevice.SetText(p.OutputDevice)
+	s.spnVoiceThreshold.SetValue(float64(p.VoiceThreshold))
+
+	s.updateAudioControls()
+}
+
+func selectedString(c gtki.ComboBoxText, values []string) string {
+	i := c.GetActive()
+	if i < 0 || i >= len(values) {
+		return values[0]
+	}
+	return values[i]
+}
+
+func selectedInt(c gtki.ComboBoxText, values []int) int {
+	i := c.GetActive()
+	if i < 0 || i >= len(values) {
+		return values[0]
+	}
+	return values[i]
+}
+
+func (s *settings) selectedAudioBackend() string {
+	return selectedString(s.cmbAudioBackend, config.AudioBackends)
+}
+
+func (s *settings) selectedTransmitMode() string {
+	return selectedString(s.cmbTransmitMode, config.TransmitModes)
+}
+
+// updateAudioControls makes sensitive only the settings
+// that Mumble uses with the selected options
+func (s *settings) updateAudioControls() {
+	b := s.selectedAudioBackend()
+	devices := b == config.AudioBackendPulseAudio || b == config.AudioBackendALSA
+
+	s.entAudioInputDevice.SetSensitive(devices)
+	s.entAudioOutputDevice.SetSensitive(devices)
+	s.spnVoiceThreshold.SetSensitive(s.selectedTransmitMode() == config.TransmitVoiceActivity)
+}
+
+func (s *settings) processAudioPreferences() {
+	input, _ := s.entAudioInputDevice.GetText()
+	output, _ := s.entAudioOutputDevice.GetText()
+
+	s.u.config.SetAudioPreferences(config.AudioPreferences{
+		Backend:        s.selectedAudioBackend(),
+		InputDevice:    input,
+		OutputDevice:   output,
+		Transmit:       s.selectedTransmitMode(),
+		VoiceThreshold: s.spnVoiceThreshold.GetValueAsInt(),
+		PushToTalkKey:  selectedString(s.cmbPushToTalkKey, config.PushToTalkKeys),
+		Quality:        selectedInt(s.cmbAudioQuality, config.AudioQualities),
+	})
+}
+
+// showPushToTalkTip tells in a meeting window how to
+// talk with the audio preferences that Mumble uses
+func (u *gtkUI) showPushToTalkTip(builder *uiBuilder) {
+	lbl := builder.get("lblTipPush").(gtki.Label)
+
+	p := u.config.GetAudioPreferences()
+	if p.Transmit != config.TransmitPushToTalk {
+		lbl.Hide()
+		return
+	}
+
+	lbl.SetText(i18n.Sprintf("Tip: Push %s to talk", pushToTalkKeyLabel(p.PushToTalkKey)))
+}
@@ -26,9 +26,19 @@ func (u *gtkUI) setCustomFilePathFor(
 }
 
 func (u *gtkUI) getCustomFilePath() (ok bool, path string) {
+	return u.chooseFile(gtki.FILE_CHOOSER_ACTION_OPEN, i18n.Sprintf("Open file"), i18n.Sprintf("Open"), "")
+}
+
+// getSaveFilePath asks for the place where a new file will be
+// saved, suggesting the given name for it
+func (u *gtkUI) getSaveFilePath(name string) (ok bool, path string) {
+	return u.chooseFile(gtki.FILE_CHOOSER_ACTION_SAVE, i18n.Sprintf("Save file"), i18n.Sprintf("Save"), name)
+}
+
+func (u *gtkUI) chooseFile(action gtki.FileChooserAction, title, accept, name string) (ok bool, path string) {
 	channel := make(chan string)
 	errChannel := make(chan bool)
-	go u.showCustomFilePathDialog(channel, errChannel)
+	go u.showFileChooserDialog(action, title, accept, name, channel, errChannel)
 	select {
 	case v := <-channel:
 		return true, v
@@ -37,15 +47,16 @@ func (u *gtkUI) getCustomFilePath() (ok bool, path string) {
 	}
 }
 
-func (u *gtkUI) showCustomFilePathDialog(channel chan string, errChannel chan bool) {
+func (u *gtkUI) showFileChooserDialog(action gtki.FileChooserAction, title, accept, name string,
+	channel chan string, errChannel chan bool) {
 	u.doInUIThread(func() {
 		dialog, err := u.g.gtk.FileChooserDialogNewWith2Buttons(
-			i18n.Sprintf("Open file"),
+			title,
 			u.currentWindow,
-			gtki.FILE_CHOOSER_ACTION_OPEN,
+			action,
 			i18n.Sprintf("Cancel"),
 			gtki.RESPONSE_CANCEL,
-			i18n.Sprintf("Open"),
+			accept,
 			gtki.RESPONSE_ACCEPT)
 
 		if err != nil {
@@ -55,6 +66,9 @@ func (u *gtkUI) showCustomFilePathDialog(channel chan string, errChannel chan bo
 
 		chooser := (dialog).(gtki.FileChooser)
 		chooser.SetDoOverwriteConfirmation(true)
+		if len(name) != 0 {
+			chooser.SetCurrentName(name)
+		}
 
 		if u.currentWindow != nil {
 			dialog.SetTransientFor(u.currentWindow)
@@ -0,0 +1,207 @@
+package gui
+
+import (
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/client"
+)
+
+const identityFileName = "wahay-identity.pem"
+
+// currentIdentity returns the identity kept in the configuration, if any
+func (s *settings) currentIdentity() *client.Identity {
+	data := s.u.config.GetIdentityCertificate()
+	if len(data) == 0 {
+		return nil
+	}
+
+	id, err := client.ParseIdentity([]byte(data))
+	if err != nil {
+		log.Errorf("The persistent identity is invalid: %s", err)
+		return nil
+	}
+
+	return id
+}
+
+func (s *settings) updateIdentityControls() {
+	id := s.currentIdentity()
+	enabled := s.identityOriginalValue
+
+	s.lblIdentityFingerprint.SetVisible(enabled && id != nil)
+	if id != nil {
+		s.lblIdentityFingerprint.SetText(i18n.Sprintf("Fingerprint: %s", id.Fingerprint()))
+	}
+
+	s.btnExportIdentity.SetSensitive(enabled && id != nil)
+	s.btnImportIdentity.SetSensitive(enabled)
+	s.btnRotateIdentity.SetSensitive(enabled)
+}
+
+func (s *settings) processPersistentIdentityOption() {
+	if s.chkPersistentIdentity.GetActive() == s.identityOriginalValue {
+		return
+	}
+
+	if !s.identityOriginalValue && s.currentIdentity() == nil {
+		s.confirmIdentityStorage(func(op bool) {
+			if !op {
+				s.chkPersistentIdentity.SetActive(false)
+				return
+			}
+
+			s.setPersistentIdentity(true)
+			go s.createIdentity()
+		})
+		return
+	}
+
+	s.setPersistentIdentity(!s.identityOriginalValue)
+	s.updateIdentityControls()
+}
+
+func (s *settings) setPersistentIdentity(v bool) {
+	s.identityOriginalValue = v
+	s.u.config.SetPersistentIdentity(v)
+}
+
+// confirmIdentityStorage asks the user to agree before the private key of the
+// identity is kept in a configuration file that is not encrypted. It must be
+// called from the UI thread
+func (s *settings) confirmIdentityStorage(onConfirm func(bool)) {
+	if !s.u.config.IsPersistentConfiguration() || s.u.config.ShouldEncrypt() {
+		onConfirm(true)
+		return
+	}
+
+	s.u.showConfirmationWith(onConfirm, i18n.Sprintf("Persistent identity"),
+		i18n.Sprintf("The configuration file is not encrypted with a master password, "+
+			"so the private key of your identity will be saved in plain text. "+
+			"Anybody who can read the file could pretend to be you in the meetings. "+
+			"Do you want to save it anyway?"), i18n.Sprintf("Save"))
+}
+
+func (s *settings) setIdentity(id *client.Identity) error {
+	data, err := id.Bytes()
+	if err != nil {
+		return err
+	}
+
+	s.u.config.SetIdentityCertificate(string(data))
+	s.u.doInUIThread(s.updateIdentityControls)
+
+	return nil
+}
+
+func (s *settings) createIdentity() {
+	id, err := client.NewIdentity()
+	if err == nil {
+		err = s.setIdentity(id)
+	}
+
+	if err != nil {
+		s.u.reportError(i18n.Sprintf("The identity can't be created: %s", err))
+	}
+}
+
+func (s *settings) rotateIdentity() {
+	if s.currentIdentity() == nil {
+		go s.createIdentity()
+		return
+	}
+
+	s.u.showConfirmation(func(op bool) {
+		if !op {
+			return
+		}
+
+		s.confirmIdentityStorage(func(op bool) {
+			if !op {
+				return
+			}
+
+			go func() {
+				s.createIdentity()
+				s.u.messageToLabel(s.lblIdentityMessage, i18n.Sprintf("A new identity has been created"), 5)
+			}()
+		})
+	}, i18n.Sprintf("The hosts won't recognize you with the new identity. Do you want to continue?"))
+}
+
+// exportIdentity writes the identity with its private key as it is,
+// so the user is warned before choosing where to write it
+func (s *settings) exportIdentity() {
+	id := s.currentIdentity()
+	if id == nil {
+		return
+	}
+
+	s.u.showConfirmationWith(func(op bool) {
+		if op {
+			go s.exportIdentityTo(id)
+		}
+	}, i18n.Sprintf("Export identity"), i18n.Sprintf("The exported file will contain the private key of your "+
+		"identity without any protection. Anybody who can read it could pretend to be you in the meetings, "+
+		"so keep it in a safe place and remove it once you have imported it. "+
+		"Do you want to export it?"), i18n.Sprintf("Export"))
+}
+
+func (s *settings) exportIdentityTo(id *client.Identity) {
+	ok, filename := s.u.getSaveFilePath(identityFileName)
+	if !ok {
+		return
+	}
+
+	data, err := id.Bytes()
+	if err == nil {
+		err = ioutil.WriteFile(filename, data, 0600)
+	}
+
+	if err != nil {
+		s.u.reportError(i18n.Sprintf("The identity can't be exported: %s", err))
+		return
+	}
+
+	s.u.messageToLabel(s.lblIdentityMessage, i18n.Sprintf("The identity has been exported"), 5)
+}
+
+func (s *settings) importIdentity() {
+	go func() {
+		ok, filename := s.u.getCustomFilePath()
+		if !ok {
+			return
+		}
+
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			s.u.reportError(i18n.Sprintf("The identity can't be imported: %s", err))
+			return
+		}
+
+		id, err := client.ParseIdentity(data)
+		if err != nil {
+			s.u.reportError(i18n.Sprintf("The identity can't be imported: %s", err))
+			return
+		}
+
+		s.u.doInUIThread(func() {
+			s.confirmIdentityStorage(func(op bool) {
+				if op {
+					go s.useImportedIdentity(id)
+				}
+			})
+		})
+	}()
+}
+
+func (s *settings) useImportedIdentity(id *client.Identity) {
+	err := s.setIdentity(id)
+	if err != nil {
+		s.u.reportError(i18n.Sprintf("The identity can't be imported: %s", err))
+		return
+	}
+
+	s.u.messageToLabel(s.lblIdentityMessage, i18n.Sprintf("The identity has been imported"), 5)
+}
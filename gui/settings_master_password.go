@@ -0,0 +1,95 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// updateMasterPasswordControls lets the master password be changed
+// only when the configuration file is already encrypted with one
+func (s *settings) updateMasterPasswordControls() {
+	s.btnChangeMasterPassword.SetSensitive(s.u.config.CanChangeMasterPassword())
+}
+
+func (s *settings) changeMasterPassword() {
+	builder := s.u.getMasterPasswordBuilder()
+
+	var win gtki.Window
+	var txtCurrent, txtPassword, txtPasswordRepeat gtki.Entry
+	var chkArgon2id gtki.CheckButton
+	var lblValidation gtki.Label
+
+	builder.getItems(
+		"changeMasterPasswordWindow", &win,
+		"txtCurrentMasterPassword", &txtCurrent,
+		"txtNewMasterPassword", &txtPassword,
+		"txtNewMasterPasswordRepeat", &txtPasswordRepeat,
+		"chkArgon2id", &chkArgon2id,
+		"lblChangeMasterPasswordValidation", &lblValidation,
+	)
+
+	chkArgon2id.SetActive(s.u.config.KeyDerivation() == config.KeyDerivationArgon2id)
+
+	showError := func(message string) {
+		lblValidation.SetText(message)
+		lblValidation.SetVisible(true)
+	}
+
+	closeWindow := func() {
+		win.Destroy()
+		s.u.enableWindow(s.u.currentWindow)
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_change_password": func() {
+			current, _ := txtCurrent.GetText()
+			password, _ := txtPassword.GetText()
+			repeat, _ := txtPasswordRepeat.GetText()
+
+			err := validatePasswords(password, repeat)
+			if err != nil {
+				txtPassword.GrabFocus()
+				showError(err.Error())
+				return
+			}
+
+			algorithm := config.KeyDerivationScrypt
+			if chkArgon2id.GetActive() {
+				algorithm = config.KeyDerivationArgon2id
+			}
+
+			// Deriving the keys takes a while
+			win.SetSensitive(false)
+
+			go func() {
+				err := s.u.config.ChangeMasterPassword(current, password, algorithm, s.u.keySupplier)
+
+				s.u.doInUIThread(func() {
+					if err == config.ErrWrongMasterPassword {
+						win.SetSensitive(true)
+						txtCurrent.GrabFocus()
+						showError(i18n.Sprintf("The current master password is not correct"))
+						return
+					}
+
+					if err != nil {
+						win.SetSensitive(true)
+						showError(i18n.Sprintf("The master password can't be changed: %s", err))
+						return
+					}
+
+					closeWindow()
+					go s.u.messageToLabel(s.lblMasterPasswordMessage, i18n.Sprintf("The master password has been changed"), 5)
+				})
+			}()
+		},
+		"on_change_password_cancel": closeWindow,
+	})
+
+	if s.u.currentWindow != nil {
+		s.u.disableWindow(s.u.currentWindow)
+		win.SetTransientFor(s.u.currentWindow)
+	}
+
+	s.u.doInUIThread(win.Show)
+}
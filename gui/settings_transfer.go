@@ -0,0 +1,217 @@
+package gui
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
+)
+
+const settingsExportFileName = "wahay-settings.json"
+
+// askPassphrase shows a window to write the passphrase of an exported settings
+// file. When confirm is true, the passphrase has to be written twice. The
+// window is the one used to set the master password, with other texts
+func (u *gtkUI) askPassphrase(title, intro, problem string, confirm bool, onPassphrase func(string)) {
+	builder := u.getMasterPasswordBuilder()
+
+	win := builder.get("captureMasterPassword").(gtki.Window)
+	lblIntro := builder.get("lblPasswordIntro").(gtki.Label)
+	txtPassword := builder.get("txtPassword").(gtki.Entry)
+	txtPasswordRepeat := builder.get("txtPasswordRepeat").(gtki.Entry)
+	lblValidation := builder.get("lblValidation").(gtki.Label)
+
+	win.SetTitle(title)
+	lblIntro.SetText(intro)
+	_ = txtPassword.SetProperty("placeholder-text", i18n.Sprintf("Passphrase"))
+	_ = txtPasswordRepeat.SetProperty("placeholder-text", i18n.Sprintf("Repeat the passphrase"))
+	txtPasswordRepeat.SetVisible(confirm)
+	lblValidation.SetText(problem)
+	lblValidation.SetVisible(len(problem) > 0)
+
+	closeWindow := func() {
+		win.Destroy()
+		u.enableWindow(u.currentWindow)
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_save": func() {
+			passphrase, _ := txtPassword.GetText()
+			repeat := passphrase
+			if confirm {
+				repeat, _ = txtPasswordRepeat.GetText()
+			}
+
+			err := validatePasswords(passphrase, repeat)
+			if err != nil {
+				txtPassword.GrabFocus()
+				lblValidation.SetText(err.Error())
+				lblValidation.SetVisible(true)
+				return
+			}
+
+			closeWindow()
+			onPassphrase(passphrase)
+		},
+		"on_cancel": closeWindow,
+		"on_close":  closeWindow,
+	})
+
+	if u.currentWindow != nil {
+		u.disableWindow(u.currentWindow)
+		win.SetTransientFor(u.currentWindow)
+	}
+
+	u.doInUIThread(win.Show)
+}
+
+func (s *settings) exportSettings() {
+	go func() {
+		ok, filename := s.u.getSaveFilePath(settingsExportFileName)
+		if !ok {
+			return
+		}
+
+		s.u.doInUIThread(func() {
+			s.u.askPassphrase(i18n.Sprintf("Export settings"),
+				i18n.Sprintf("Choose a passphrase for the file. You will need it to import the settings in the other computer."),
+				"", true, func(passphrase string) {
+					go s.exportSettingsTo(filename, passphrase)
+				})
+		})
+	}()
+}
+
+func (s *settings) exportSettingsTo(filename, passphrase string) {
+	data, err := s.u.config.ExportSettings(passphrase)
+	if err == nil {
+		err = ioutil.WriteFile(filename, data, 0600)
+	}
+
+	if err != nil {
+		log.Errorf("The settings can't be exported: %s", err)
+		s.u.reportError(i18n.Sprintf("The settings can't be exported: %s", err))
+		return
+	}
+
+	s.u.messageToLabel(s.lblSettingsTransferMessage, i18n.Sprintf("The settings have been exported"), 5)
+}
+
+func (s *settings) importSettings() {
+	go func() {
+		ok, filename := s.u.getCustomFilePath()
+		if !ok {
+			return
+		}
+
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			s.u.reportError(i18n.Sprintf("The settings can't be imported: %s", err))
+			return
+		}
+
+		s.u.doInUIThread(func() {
+			s.askImportPassphrase(data, "")
+		})
+	}()
+}
+
+func (s *settings) askImportPassphrase(data []byte, problem string) {
+	s.u.askPassphrase(i18n.Sprintf("Import settings"),
+		i18n.Sprintf("Please enter the passphrase that was chosen when the settings were exported."),
+		problem, false, func(passphrase string) {
+			go s.readSettingsExport(data, passphrase)
+		})
+}
+
+func (s *settings) readSettingsExport(data []byte, passphrase string) {
+	imported, err := config.ReadSettingsExport(data, passphrase)
+
+	switch err {
+	case nil:
+		s.u.doInUIThread(func() {
+			s.confirmImport(imported)
+		})
+	case config.ErrWrongPassphrase:
+		s.u.doInUIThread(func() {
+			s.askImportPassphrase(data, i18n.Sprintf("The passphrase is not correct"))
+		})
+	case config.ErrConfigFileTooNew:
+		s.u.reportError(i18n.Sprintf("The settings were exported by a newer version of Wahay. " +
+			"Please update Wahay to import them."))
+	default:
+		s.u.reportError(i18n.Sprintf("The settings can't be imported: %s", err))
+	}
+}
+
+func (s *settings) confirmImport(imported *config.ImportedSettings) {
+	unencrypted := s.u.config.IsPersistentConfiguration() && !s.u.config.ShouldEncrypt()
+
+	s.u.showConfirmationWith(func(op bool) {
+		if op {
+			s.applyImportedSettings(imported)
+		}
+	}, i18n.Sprintf("Import settings"), importPreview(imported, unencrypted), i18n.Sprintf("Import"))
+}
+
+// importPreview describes what is in the imported settings. When the
+// configuration is saved without encryption, it warns that the keys in
+// them will be written to the disk as they are
+func importPreview(imported *config.ImportedSettings, unencrypted bool) string {
+	var b strings.Builder
+
+	b.WriteString(i18n.Sprintf("These settings were exported on %s.", imported.Exported().Format(scheduleTimeFormat)))
+	b.WriteString("\n\n")
+
+	if imported.HasIdentity() {
+		b.WriteString(i18n.Sprintf("They have your preferences for the meetings, Mumble and the audio, and your identity."))
+	} else {
+		b.WriteString(i18n.Sprintf("They have your preferences for the meetings, Mumble and the audio, but no identity."))
+	}
+
+	meetings := imported.ScheduledMeetings()
+	if len(meetings) > 0 {
+		b.WriteString("\n\n")
+		b.WriteString(i18n.Sprintf("Scheduled meetings (%d with their onion address):", imported.OnionKeys()))
+		for _, m := range meetings {
+			b.WriteString(fmt.Sprintf("\n  • %s (%s)", m.Title, m.Start.Format(scheduleTimeFormat)))
+		}
+	}
+
+	missing := imported.MissingPaths()
+	if len(missing) > 0 {
+		b.WriteString("\n\n")
+		b.WriteString(i18n.Sprintf("These files don't exist in this computer, so the current ones will be kept:"))
+		for _, p := range missing {
+			b.WriteString(fmt.Sprintf("\n  • %s", p))
+		}
+	}
+
+	if unencrypted && (imported.HasIdentity() || imported.OnionKeys() > 0) {
+		b.WriteString("\n\n")
+		b.WriteString(i18n.Sprintf("Your settings are not encrypted, so the identity and the keys of the onion addresses " +
+			"will be saved in this computer without any protection. Anybody that can read your files could use " +
+			"them to pretend to be you or to host your scheduled meetings. You can enable the encryption of the " +
+			"settings before importing them."))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(i18n.Sprintf("Your current settings will be replaced. Do you want to import them?"))
+
+	return b.String()
+}
+
+// applyImportedSettings imports the settings and opens the settings
+// window again, so that it shows the imported values
+func (s *settings) applyImportedSettings(imported *config.ImportedSettings) {
+	s.u.config.ImportSettings(imported)
+	s.u.saveConfigOnly()
+
+	s.dialog.Destroy()
+	s.u.currentWindow = nil
+	s.u.openSettingsWindow()
+}
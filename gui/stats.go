@@ -0,0 +1,142 @@
+package gui
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+const (
+	statsRefreshInterval = 5 * time.Second
+	statsLogInterval     = time.Minute
+
+	statsNameColumn = 0
+	statsPingColumn = 1
+	statsLossColumn = 2
+)
+
+type statsDashboard struct {
+	lblParticipants gtki.Label
+	lblUptime       gtki.Label
+	lblTraffic      gtki.Label
+	store           gtki.ListStore
+	done            chan bool
+}
+
+func (h *hostData) startStatsDashboard(builder *uiBuilder) {
+	h.stopStatsDashboard()
+
+	d := &statsDashboard{done: make(chan bool)}
+
+	builder.getItems(
+		"lblStatsParticipantsValue", &d.lblParticipants,
+		"lblStatsUptimeValue", &d.lblUptime,
+		"lblStatsTrafficValue", &d.lblTraffic,
+		"statsStore", &d.store,
+	)
+
+	h.statsDashboard = d
+
+	go h.refreshStatsDashboard(d)
+}
+
+func (h *hostData) stopStatsDashboard() {
+	if h.statsDashboard != nil {
+		close(h.statsDashboard.done)
+		h.statsDashboard = nil
+	}
+}
+
+func (h *hostData) refreshStatsDashboard(d *statsDashboard) {
+	t := time.NewTicker(statsRefreshInterval)
+	defer t.Stop()
+
+	for {
+		stats, err := h.service.Stats()
+		if err != nil {
+			return
+		}
+
+		h.u.doInUIThread(func() {
+			if !d.stopped() {
+				d.show(stats)
+			}
+		})
+
+		select {
+		case <-d.done:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (d *statsDashboard) stopped() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *statsDashboard) show(stats hosting.Stats) {
+	d.lblParticipants.SetText(fmt.Sprintf("%d", len(stats.Participants)))
+	d.lblUptime.SetText(stats.Uptime.Round(time.Second).String())
+	d.lblTraffic.SetText(i18n.Sprintf("%s in, %s out", formatBytes(stats.BytesIn), formatBytes(stats.BytesOut)))
+
+	d.store.Clear()
+	for _, p := range stats.Participants {
+		iter := d.store.Append()
+		err := d.store.Set2(iter,
+			[]int{statsNameColumn, statsPingColumn, statsLossColumn},
+			[]interface{}{
+				p.Username,
+				fmt.Sprintf("%.0f ms", p.Ping),
+				fmt.Sprintf("%.1f%%", p.PacketLoss),
+			})
+		if err != nil {
+			log.Errorf("stats dashboard show(): %s", err)
+		}
+	}
+}
+
+// logMeetingStats leaves a summary of the meeting health in the
+// logs from time to time, until the meeting finishes
+func (h *hostData) logMeetingStats() {
+	t := time.NewTicker(statsLogInterval)
+	defer t.Stop()
+
+	for range t.C {
+		stats, err := h.service.Stats()
+		if err != nil {
+			return
+		}
+
+		log.WithFields(log.Fields{
+			"participants": len(stats.Participants),
+			"uptime":       stats.Uptime.Round(time.Second).String(),
+			"bytesIn":      stats.BytesIn,
+			"bytesOut":     stats.BytesOut,
+		}).Info("Meeting statistics")
+	}
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
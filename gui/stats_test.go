@@ -0,0 +1,16 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type WahayStatsSuite struct{}
+
+var _ = Suite(&WahayStatsSuite{})
+
+func (s *WahayStatsSuite) Test_formatBytes_usesTheBiggestUnitThatFits(c *C) {
+	c.Assert(formatBytes(0), Equals, "0 B")
+	c.Assert(formatBytes(1023), Equals, "1023 B")
+	c.Assert(formatBytes(1536), Equals, "1.5 KiB")
+	c.Assert(formatBytes(5*1024*1024), Equals, "5.0 MiB")
+}
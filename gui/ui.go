@@ -40,6 +40,7 @@ func CreateGraphics(gtkVal gtki.Gtk, glibVal glibi.Glib, gdkVal gdki.Gdk) Graphi
 // UI is the user interface functionality exposed to main
 type UI interface {
 	Loop()
+	OpenInvitation(uri string)
 }
 
 func argsWithApplicationName() *[]string {
@@ -63,6 +64,7 @@ type gtkUI struct {
 	servers        hosting.Servers
 	errorHandler   *errorHandler
 	cleanupHandler *cleanupHandler
+	invitation     string
 }
 
 // NewGTK returns a new client for a GTK ui
@@ -127,6 +129,7 @@ func (u *gtkUI) configLoaded() {
 	u.displayLoadingWindow()
 
 	go u.initLogs()
+	go u.watchScheduledMeetings()
 
 	go u.ensureDependencies(func() {
 		u.hideLoadingWindow()
@@ -145,6 +148,7 @@ func (u *gtkUI) getMainWindowBuilder() *uiBuilder {
 		"button", "btnErrorsAccept",
 		"tooltip", "btnSettings",
 		"tooltip", "btnHelp",
+		"tooltip", "btnSchedule",
 		"tooltip", "btnJoinMeeting",
 		"tooltip", "btnHostMeeting",
 		"label", "lblWelcome",
@@ -152,22 +156,26 @@ func (u *gtkUI) getMainWindowBuilder() *uiBuilder {
 		"label", "lblHostMeeting",
 		"label", "lblJoinMeeting",
 		"label", "lblSettings",
-		"label", "lblHelp")
+		"label", "lblHelp",
+		"label", "lblSchedule")
 
 	imgHostMeeting := builder.get("imgHostMeeting").(gtki.Image)
 	imgJoinMeeting := builder.get("imgJoinMeeting").(gtki.Image)
 	imgSettings := builder.get("imgSettings").(gtki.Image)
 	imgHelp := builder.get("imgHelp").(gtki.Image)
+	imgSchedule := builder.get("imgSchedule").(gtki.Image)
 
 	icon1, _ := u.g.getImagePixbufForSize("host-meeting.svg", 32)
 	icon2, _ := u.g.getImagePixbufForSize("join-meeting.svg", 32)
 	icon3, _ := u.g.getImagePixbufForSize("settings.svg", 24)
 	icon4, _ := u.g.getImagePixbufForSize("help.svg", 24)
+	icon5, _ := u.g.getImagePixbufForSize("calendar.svg", 24)
 
 	imgHostMeeting.SetFromPixbuf(icon1)
 	imgJoinMeeting.SetFromPixbuf(icon2)
 	imgSettings.SetFromPixbuf(icon3)
 	imgHelp.SetFromPixbuf(icon4)
+	imgSchedule.SetFromPixbuf(icon5)
 
 	return builder
 }
@@ -180,14 +188,20 @@ func (u *gtkUI) createMainWindow() {
 
 	win.SetApplication(u.app)
 	win.SetIcon(getApplicationIcon().getPixbuf())
+
+	// The profile in use is always in sight, so they are not mixed up
+	if profile := u.config.GetProfile(); len(profile) > 0 {
+		win.SetTitle(i18n.Sprintf("Wahay Conference Calls - %s", profile))
+	}
 	u.g.gtk.WindowSetDefaultIcon(getApplicationIcon().getPixbuf())
 
 	builder.ConnectSignals(map[string]interface{}{
-		"on_close_window_signal": u.quit,
-		"on_host_meeting":        u.hostMeetingHandler,
-		"on_join_meeting":        u.joinMeeting,
-		"on_open_settings":       u.openSettingsWindow,
-		"on_open_help":           u.openHelpWindow,
+		"on_close_window_signal":     u.quit,
+		"on_host_meeting":            u.hostMeetingHandler,
+		"on_join_meeting":            u.joinMeeting,
+		"on_open_settings":           u.openSettingsWindow,
+		"on_open_help":               u.openHelpWindow,
+		"on_open_scheduled_meetings": u.openScheduledMeetingsWindow,
 		"on_show_errors": func() {
 			u.showStatusErrorsWindow(builder)
 		},
@@ -202,10 +216,13 @@ func (u *gtkUI) createMainWindow() {
 	u.disableMainWindowControls(builder)
 
 	win.Show()
+
+	u.openPendingInvitation()
 }
 
 func (u *gtkUI) updateMainWindowStatusBar(builder *uiBuilder) {
-	if !u.errorHandler.isThereAnyStartupError() {
+	hasErrors := u.errorHandler.isThereAnyStartupError()
+	if !hasErrors && !u.errorHandler.isThereAnyStartupWarning() {
 		return // nothing to do
 	}
 
@@ -218,11 +235,17 @@ func (u *gtkUI) updateMainWindowStatusBar(builder *uiBuilder) {
 		log.WithFields(log.Fields{
 			"context": "boxApplicationStatus style context",
 		}).Debug("programmer error: updateMainWindowStatusBar()")
-	} else {
+	} else if hasErrors {
 		cntx.AddClass("error")
+	} else {
+		cntx.AddClass("warning")
 	}
 
-	lblAppStatus.SetLabel(i18n.Sprintf("We've found errors"))
+	if hasErrors {
+		lblAppStatus.SetLabel(i18n.Sprintf("We've found errors"))
+	} else {
+		lblAppStatus.SetLabel(i18n.Sprintf("We've found some warnings"))
+	}
 	btnStatusShow.SetVisible(true)
 }
 
@@ -267,6 +290,13 @@ func (u *gtkUI) getConfirmWindow() *uiBuilder {
 }
 
 func (u *gtkUI) showConfirmation(onConfirm func(bool), text string) {
+	u.showConfirmationWith(onConfirm, "", text, "")
+}
+
+// showConfirmationWith shows the confirmation dialog with the given
+// title, text and label of the confirm button. The empty ones are
+// left as they are in the dialog definition
+func (u *gtkUI) showConfirmationWith(onConfirm func(bool), title, text, confirm string) {
 	u.disableCurrentWindow()
 
 	builder := u.getConfirmWindow()
@@ -276,11 +306,21 @@ func (u *gtkUI) showConfirmation(onConfirm func(bool), text string) {
 		dialog.SetTransientFor(u.currentWindow)
 	}
 
+	if len(title) > 0 {
+		lbl, _ := builder.get("lblTitle").(gtki.Label)
+		lbl.SetText(title)
+	}
+
 	if len(text) > 0 {
 		lbl, _ := builder.get("lblText").(gtki.Label)
 		lbl.SetText(text)
 	}
 
+	if len(confirm) > 0 {
+		btn, _ := builder.get("btnConfirm").(gtki.Button)
+		_ = btn.SetProperty("label", confirm)
+	}
+
 	clean := func(op bool) {
 		dialog.Destroy()
 		u.enableCurrentWindow()
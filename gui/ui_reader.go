@@ -178,6 +178,12 @@ func (g Graphics) getImageBytes(filename string) []byte {
 }
 
 func (g Graphics) getImagePixbufForSize(imageName string, size int) (gdki.Pixbuf, error) {
+	return g.getPixbufFromBytes(g.getImage(imageName), size)
+}
+
+// getPixbufFromBytes loads an image that is not part of the
+// embedded files, like the ones generated while Wahay runs
+func (g Graphics) getPixbufFromBytes(bytes []byte, size int) (gdki.Pixbuf, error) {
 	var w sync.WaitGroup
 
 	pl, err := g.gdk.PixbufLoaderNew()
@@ -193,7 +199,7 @@ func (g Graphics) getImagePixbufForSize(imageName string, size int) (gdki.Pixbuf
 	if err != nil {
 		log.WithFields(log.Fields{
 			"caller": "pl.Connect(\"area-prepared\")",
-		}).Errorf("getImagePixbufForSize(): %s", err.Error())
+		}).Errorf("getPixbufFromBytes(): %s", err.Error())
 	}
 
 	_, err = pl.Connect("size-prepared", func() {
@@ -202,10 +208,9 @@ func (g Graphics) getImagePixbufForSize(imageName string, size int) (gdki.Pixbuf
 	if err != nil {
 		log.WithFields(log.Fields{
 			"caller": "pl.Connect(\"size-prepared\")",
-		}).Errorf("getImagePixbufForSize(): %s", err.Error())
+		}).Errorf("getPixbufFromBytes(): %s", err.Error())
 	}
 
-	bytes := g.getImage(imageName)
 	if _, err := pl.Write(bytes); err != nil {
 		return nil, err
 	}
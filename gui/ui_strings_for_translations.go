@@ -6,6 +6,7 @@ func init() {
 	noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt3()
 	noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt4()
 	noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt5()
+	noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt6()
 }
 
 func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt1() {
@@ -183,3 +184,110 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt5() {
 	_ = i18n.Sprintf("Start a new meeting \u0026 join")
 	_ = i18n.Sprintf("Start a new meeting")
 }
+
+func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt6() {
+	_ = i18n.Sprintf("Make participants wait in a lobby")
+	_ = i18n.Sprintf("Participants will wait in a lobby until you admit them into the meeting. " +
+		"This requires joining as super user")
+	_ = i18n.Sprintf("Waiting room")
+	_ = i18n.Sprintf("Somebody wants to join the meeting")
+	_ = i18n.Sprintf("A participant is waiting in the lobby")
+	_ = i18n.Sprintf("Deny")
+	_ = i18n.Sprintf("Admit")
+	_ = i18n.Sprintf("Personal Invitations")
+	_ = i18n.Sprintf("Personal invitations")
+	_ = i18n.Sprintf("Every person gets their own password, which you can revoke at any time. " +
+		"When the meeting has no password, only the invited people will be able to join.")
+	_ = i18n.Sprintf("Name of the invited person")
+	_ = i18n.Sprintf("Invite")
+	_ = i18n.Sprintf("Name")
+	_ = i18n.Sprintf("Password")
+	_ = i18n.Sprintf("Revoke")
+	_ = i18n.Sprintf("Publish the events of my meetings locally")
+	_ = i18n.Sprintf("Let other programs on this computer follow what happens in the meetings you host")
+	_ = i18n.Sprintf("Participants joining and leaving, mute changes and chat messages are written to " +
+		"a socket in your runtime directory. Programs reading it need the token stored next to it")
+	_ = i18n.Sprintf("Let participants join from Tor Browser")
+	_ = i18n.Sprintf("Participants using a web based Mumble client in Tor Browser will be able to join without installing Mumble")
+	_ = i18n.Sprintf("Participants:")
+	_ = i18n.Sprintf("Uptime:")
+	_ = i18n.Sprintf("Traffic:")
+	_ = i18n.Sprintf("Participant")
+	_ = i18n.Sprintf("Ping")
+	_ = i18n.Sprintf("Packet loss")
+	_ = i18n.Sprintf("Close")
+	_ = i18n.Sprintf("Schedule")
+	_ = i18n.Sprintf("Scheduled meetings")
+	_ = i18n.Sprintf("A scheduled meeting keeps its meeting ID, so you can send the invitations days before it starts. " +
+		"Wahay will offer to start the meeting when the time arrives.")
+	_ = i18n.Sprintf("Title")
+	_ = i18n.Sprintf("Name of the meeting")
+	_ = i18n.Sprintf("Starts at")
+	_ = i18n.Sprintf("YYYY-MM-DD HH:MM")
+	_ = i18n.Sprintf("Duration (minutes)")
+	_ = i18n.Sprintf("Meeting password")
+	_ = i18n.Sprintf("Optional")
+	_ = i18n.Sprintf("Meeting ID")
+	_ = i18n.Sprintf("The calendar file has been saved")
+	_ = i18n.Sprintf("Add to Calendar")
+	_ = i18n.Sprintf("Save the meeting as an iCalendar (.ics) file")
+	_ = i18n.Sprintf("Start Now")
+	_ = i18n.Sprintf("Remove")
+	_ = i18n.Sprintf("Participants can scan this code instead of typing the meeting ID")
+	_ = i18n.Sprintf("Include the password")
+	_ = i18n.Sprintf("Save QR as PNG")
+	_ = i18n.Sprintf("Use the same identity in every meeting")
+	_ = i18n.Sprintf("Allow the hosts to recognize you when you join their meetings again")
+	_ = i18n.Sprintf("When this option is checked, you will join the meetings with the same certificate, " +
+		"so the hosts can recognize you. Otherwise, a new certificate is created every time you join a meeting. " +
+		"The certificate is kept in the configuration file, so you should also encrypt it.")
+	_ = i18n.Sprintf("Export")
+	_ = i18n.Sprintf("Import")
+	_ = i18n.Sprintf("Create a new identity")
+	_ = i18n.Sprintf("Connected")
+	_ = i18n.Sprintf("Mute")
+	_ = i18n.Sprintf("Turn your microphone off or on")
+	_ = i18n.Sprintf("Deafen")
+	_ = i18n.Sprintf("Stop or start hearing the other participants")
+	_ = i18n.Sprintf("Audio")
+	_ = i18n.Sprintf("Sound system")
+	_ = i18n.Sprintf("Input device")
+	_ = i18n.Sprintf("Output device")
+	_ = i18n.Sprintf("Default device")
+	_ = i18n.Sprintf("Transmit")
+	_ = i18n.Sprintf("Voice activity threshold")
+	_ = i18n.Sprintf("Push-to-talk key")
+	_ = i18n.Sprintf("Quality")
+	_ = i18n.Sprintf("Mumble is given these settings every time it runs, " +
+		"so the changes you make to them inside Mumble are not kept.")
+	_ = i18n.Sprintf("Chat")
+	_ = i18n.Sprintf("Connecting to the chat...")
+	_ = i18n.Sprintf("Write a message")
+	_ = i18n.Sprintf("Send")
+	_ = i18n.Sprintf("Only join meetings whose certificate can be checked")
+	_ = i18n.Sprintf("Don't join a meeting if its certificate can't be fetched or is not the one its server uses")
+	_ = i18n.Sprintf("Before joining a meeting, Wahay fetches the certificate of its server, so Mumble can trust it. " +
+		"When this option is checked and the certificate can't be fetched, or the server presents a different one, " +
+		"Wahay doesn't join the meeting. Otherwise, Mumble asks you whether to trust the server.")
+	_ = i18n.Sprintf("Move your settings to another computer")
+	_ = i18n.Sprintf("The settings are exported to a single file, encrypted with a passphrase that you choose. " +
+		"The file has your preferences, your identity and your scheduled meetings, " +
+		"with the keys of their onion addresses, so keep it safe.")
+	_ = i18n.Sprintf("Export settings")
+	_ = i18n.Sprintf("Import settings")
+	_ = i18n.Sprintf("Change master password")
+	_ = i18n.Sprintf("Please enter the current master password, and the new one twice.")
+	_ = i18n.Sprintf("Current password")
+	_ = i18n.Sprintf("New password")
+	_ = i18n.Sprintf("Repeat the new password")
+	_ = i18n.Sprintf("Use Argon2id to derive the keys from the password")
+	_ = i18n.Sprintf("Argon2id is more resistant than scrypt against attacks with special hardware, " +
+		"but older versions of Wahay can't read the file")
+	_ = i18n.Sprintf("Change")
+	_ = i18n.Sprintf("Choose a profile")
+	_ = i18n.Sprintf("Every profile has its own settings, identity and scheduled meetings. Please choose the profile to use.")
+	_ = i18n.Sprintf("Or create a new profile:")
+	_ = i18n.Sprintf("Name of the new profile")
+	_ = i18n.Sprintf("Profiles")
+	_ = i18n.Sprintf("Create profile")
+}
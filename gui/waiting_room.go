@@ -0,0 +1,92 @@
+package gui
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+func (h *hostData) watchWaitingRoom() {
+	wr, err := h.service.WaitingRoom()
+	if err != nil {
+		log.Errorf("watchWaitingRoom(): %s", err)
+		return
+	}
+
+	h.knockWindows = make(map[uint32]gtki.Window)
+
+	wr.OnLeave(func(k hosting.Knock) {
+		h.u.doInUIThread(func() {
+			h.closeKnockWindow(k.Session)
+		})
+	})
+
+	wr.OnKnock(func(k hosting.Knock) {
+		h.u.doInUIThread(func() {
+			h.showKnock(wr, k)
+		})
+	})
+}
+
+func (h *hostData) showKnock(wr hosting.WaitingRoom, k hosting.Knock) {
+	if _, ok := h.knockWindows[k.Session]; ok {
+		return
+	}
+
+	builder := h.u.g.uiBuilderFor("KnockWindow")
+	builder.i18nProperties(
+		"title", "dialog",
+		"label", "lblTitle",
+		"button", "btnDeny",
+		"button", "btnAdmit")
+
+	win := builder.get("dialog").(gtki.Window)
+
+	lblText := builder.get("lblText").(gtki.Label)
+	lblText.SetText(i18n.Sprintf("%s is waiting in the lobby", k.Username))
+
+	respond := func(f func(uint32) error) {
+		win.Hide()
+		go func() {
+			err := f(k.Session)
+			if err != nil {
+				log.Errorf("waiting room: %s", err)
+				h.u.doInUIThread(func() {
+					h.closeKnockWindow(k.Session)
+				})
+			}
+		}()
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_admit": func() {
+			respond(wr.Admit)
+		},
+		"on_deny": func() {
+			respond(wr.Deny)
+		},
+	})
+
+	h.knockWindows[k.Session] = win
+
+	win.SetUrgencyHint(true)
+	win.Present()
+	win.Show()
+}
+
+func (h *hostData) closeKnockWindow(session uint32) {
+	win, ok := h.knockWindows[session]
+	if !ok {
+		return
+	}
+
+	delete(h.knockWindows, session)
+	win.Destroy()
+}
+
+func (h *hostData) closeAllKnockWindows() {
+	for session := range h.knockWindows {
+		h.closeKnockWindow(session)
+	}
+}
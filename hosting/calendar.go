@@ -0,0 +1,97 @@
+package hosting
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+const (
+	icsTimeFormat = "20060102T150405Z"
+	icsLineLength = 75
+	icsProductID  = "-//Wahay//Scheduled meetings//EN"
+)
+
+// CalendarEvent is a meeting that can be added to a calendar
+// application, as described in RFC 5545
+type CalendarEvent struct {
+	UID         string
+	Title       string
+	Description string
+	Location    string
+	Start       time.Time
+	Duration    time.Duration
+}
+
+// ICS returns the event as the content of an iCalendar (.ics) file
+func (e CalendarEvent) ICS() []byte {
+	var b bytes.Buffer
+
+	line := func(name, value string) {
+		writeICSLine(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", icsProductID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("BEGIN", "VEVENT")
+	line("UID", escapeICSText(e.UID))
+	line("DTSTAMP", time.Now().UTC().Format(icsTimeFormat))
+	line("DTSTART", e.Start.UTC().Format(icsTimeFormat))
+	line("DTEND", e.Start.Add(e.Duration).UTC().Format(icsTimeFormat))
+	line("SUMMARY", escapeICSText(e.Title))
+
+	if len(e.Location) != 0 {
+		line("LOCATION", escapeICSText(e.Location))
+	}
+
+	if len(e.Description) != 0 {
+		line("DESCRIPTION", escapeICSText(e.Description))
+	}
+
+	line("END", "VEVENT")
+	line("END", "VCALENDAR")
+
+	return b.Bytes()
+}
+
+var icsTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// writeICSLine folds the line so that no part of it is longer than
+// 75 octets, without ever splitting a multi-byte character
+func writeICSLine(b *bytes.Buffer, l string) {
+	limit := icsLineLength
+
+	for len(l) > limit {
+		cut := limit
+		for cut > 0 && !isUTF8Start(l[cut]) {
+			cut--
+		}
+
+		b.WriteString(l[:cut])
+		b.WriteString("\r\n ")
+		l = l[cut:]
+
+		// The space that starts the continuation line counts
+		limit = icsLineLength - 1
+	}
+
+	b.WriteString(l)
+	b.WriteString("\r\n")
+}
+
+func isUTF8Start(c byte) bool {
+	return c&0xC0 != 0x80
+}
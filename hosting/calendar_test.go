@@ -0,0 +1,52 @@
+package hosting
+
+import (
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type HostingCalendarSuite struct{}
+
+var _ = Suite(&HostingCalendarSuite{})
+
+func (s *HostingCalendarSuite) Test_CalendarEvent_ICS_containsTheEventInUTC(c *C) {
+	start := time.Date(2020, 5, 4, 10, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+	e := CalendarEvent{
+		UID:      "abc@wahay",
+		Title:    "Weekly meeting",
+		Location: "qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion",
+		Start:    start,
+		Duration: 90 * time.Minute,
+	}
+
+	ics := string(e.ICS())
+
+	c.Assert(strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"), Equals, true)
+	c.Assert(strings.HasSuffix(ics, "END:VCALENDAR\r\n"), Equals, true)
+	c.Assert(strings.Contains(ics, "\r\nDTSTART:20200504T153000Z\r\n"), Equals, true)
+	c.Assert(strings.Contains(ics, "\r\nDTEND:20200504T170000Z\r\n"), Equals, true)
+	c.Assert(strings.Contains(ics, "\r\nSUMMARY:Weekly meeting\r\n"), Equals, true)
+	c.Assert(strings.Contains(ics, "\r\nUID:abc@wahay\r\n"), Equals, true)
+}
+
+func (s *HostingCalendarSuite) Test_CalendarEvent_ICS_escapesAndFoldsTheText(c *C) {
+	e := CalendarEvent{
+		Title:       "One, two; three\\four",
+		Description: "Meeting ID: " + strings.Repeat("x", 80) + "\nPassword: ñandú",
+		Start:       time.Now(),
+		Duration:    time.Hour,
+	}
+
+	ics := string(e.ICS())
+
+	c.Assert(strings.Contains(ics, "SUMMARY:One\\, two\\; three\\\\four\r\n"), Equals, true)
+
+	for _, l := range strings.Split(ics, "\r\n") {
+		c.Assert(len(l) <= 75, Equals, true)
+	}
+
+	unfolded := strings.Replace(ics, "\r\n ", "", -1)
+	c.Assert(strings.Contains(unfolded, "DESCRIPTION:Meeting ID: "+strings.Repeat("x", 80)+"\\nPassword: ñandú\r\n"), Equals, true)
+}
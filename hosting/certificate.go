@@ -25,9 +25,14 @@ type webserver struct {
 	cert    []byte
 	running bool
 	server  *http.Server
+
+	onRedeemInvitation func(token, certHash string) (string, error)
 }
 
-const certServerPort = 8181
+const (
+	certServerPort = 8181
+	invitationPath = "/invitation"
+)
 
 func newCertificateServer(dir string) (*webserver, error) {
 	certFile := filepath.Join(dir, "cert.pem")
@@ -51,6 +56,7 @@ func newCertificateServer(dir string) (*webserver, error) {
 
 	h := http.NewServeMux()
 	h.HandleFunc("/", s.handleCertificateRequest)
+	h.HandleFunc(invitationPath, s.handleInvitationRequest)
 
 	s.server = &http.Server{
 		Addr:    address,
@@ -130,6 +136,23 @@ func (h *webserver) handleCertificateRequest(w http.ResponseWriter, r *http.Requ
 	fmt.Fprint(w, string(h.cert))
 }
 
+func (h *webserver) handleInvitationRequest(w http.ResponseWriter, r *http.Request) {
+	if h.onRedeemInvitation == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	password, err := h.onRedeemInvitation(r.FormValue("token"), r.FormValue("digest"))
+	if err != nil {
+		log.Debugf("handleInvitationRequest(): %s", err)
+		http.NotFound(w, r)
+		return
+	}
+
+	log.Debug("handleInvitationRequest(): invitation redeemed")
+	fmt.Fprint(w, password)
+}
+
 func fileExists(filename string) bool {
 	_, err := os.Stat(filename)
 	return err == nil
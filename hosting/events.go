@@ -0,0 +1,359 @@
+package hosting
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+// The event feed lets local programs follow what happens in a hosted meeting.
+// Events are written as newline delimited JSON to a Unix socket that only the
+// current user can open. Since other programs running as the same user could
+// still connect, every reader has to send the meeting token as its first line
+
+const (
+	// EventMeetingStarted is sent when the meeting is ready for participants
+	EventMeetingStarted = "meeting_started"
+	// EventMeetingFinished is sent right before the meeting is closed
+	EventMeetingFinished = "meeting_finished"
+	// EventParticipantJoined is sent when somebody connects to the meeting
+	EventParticipantJoined = "participant_joined"
+	// EventParticipantLeft is sent when somebody disconnects from the meeting
+	EventParticipantLeft = "participant_left"
+	// EventMuteChanged is sent when somebody mutes or deafens, or stops doing it
+	EventMuteChanged = "mute_changed"
+	// EventTextMessage is sent for every chat message in the meeting
+	EventTextMessage = "text_message"
+
+	eventFeedTokenLength  = 32
+	eventFeedAuthTimeout  = 10 * time.Second
+	eventFeedWriteTimeout = 10 * time.Second
+	// eventFeedQueueLength is how many events a reader can fall behind
+	// before it's disconnected, so a slow reader never holds the meeting
+	eventFeedQueueLength = 256
+)
+
+var errEventFeedDisabled = errors.New("the event feed is not enabled")
+
+// Event is a single entry in the event feed
+type Event struct {
+	Type     string    `json:"type"`
+	Time     time.Time `json:"time"`
+	Meeting  string    `json:"meeting"`
+	Session  uint32    `json:"session,omitempty"`
+	Username string    `json:"username,omitempty"`
+	Muted    *bool     `json:"muted,omitempty"`
+	Deafened *bool     `json:"deafened,omitempty"`
+	Message  string    `json:"message,omitempty"`
+}
+
+// EventFeed gives the details needed to read the events of a meeting
+type EventFeed interface {
+	// Path is the Unix socket to connect to
+	Path() string
+	// Token must be sent, followed by a new line, right after connecting
+	Token() string
+	// TokenFile is a file only readable by the current user containing the token
+	TokenFile() string
+}
+
+type eventFeed struct {
+	sync.Mutex
+
+	meeting   string
+	path      string
+	token     string
+	tokenFile string
+	listener  net.Listener
+	readers   map[*eventReader]bool
+
+	// companions are the sessions of the chats left out of the feed
+	companions map[uint32]bool
+}
+
+// eventReader is a program reading the feed. Its events are written
+// from its own goroutine, as they arrive to the queue
+type eventReader struct {
+	conn   net.Conn
+	events chan []byte
+}
+
+func eventFeedDir() string {
+	return filepath.Join(config.XdgRuntimeDir(), "wahay")
+}
+
+func newEventFeed(meeting string) (*eventFeed, error) {
+	// Without XDG_RUNTIME_DIR the directory is in the shared temporary directory,
+	// where another user could have created it to read or replace our files
+	dir := eventFeedDir()
+	err := config.EnsurePrivateDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, eventFeedTokenLength)
+	err = config.RandomString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	name := meeting
+	if len(name) > 16 {
+		name = name[:16]
+	}
+
+	f := &eventFeed{
+		meeting:   meeting,
+		path:      filepath.Join(dir, name+".sock"),
+		token:     string(token),
+		tokenFile: filepath.Join(dir, name+".token"),
+		readers:   make(map[*eventReader]bool),
+
+		companions: make(map[uint32]bool),
+	}
+
+	// A previous Wahay could have died without cleaning up
+	_ = os.Remove(f.path)
+	_ = os.Remove(f.tokenFile)
+
+	f.listener, err = net.Listen("unix", f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(f.path, 0600)
+	if err == nil {
+		err = writeNewFile(f.tokenFile, token)
+	}
+	if err != nil {
+		f.close()
+		return nil, err
+	}
+
+	go f.accept(f.listener)
+
+	log.WithFields(log.Fields{
+		"socket": f.path,
+		"token":  f.tokenFile,
+	}).Info("Event feed for the meeting started")
+
+	return f, nil
+}
+
+// writeNewFile writes a file that only the current user can read,
+// failing if it already exists instead of following a symbolic link
+func writeNewFile(name string, data []byte) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (f *eventFeed) Path() string {
+	return f.path
+}
+
+func (f *eventFeed) Token() string {
+	return f.token
+}
+
+func (f *eventFeed) TokenFile() string {
+	return f.tokenFile
+}
+
+func (f *eventFeed) accept(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		go f.authenticate(conn)
+	}
+}
+
+func (f *eventFeed) authenticate(conn net.Conn) {
+	_ = conn.SetReadDeadline(time.Now().Add(eventFeedAuthTimeout))
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || !f.isValidToken(strings.TrimSpace(line)) {
+		log.Debug("Event feed reader didn't send a valid token")
+		_ = conn.Close()
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Time{})
+
+	f.Lock()
+	defer f.Unlock()
+
+	if f.listener == nil {
+		_ = conn.Close()
+		return
+	}
+
+	r := &eventReader{
+		conn:   conn,
+		events: make(chan []byte, eventFeedQueueLength),
+	}
+	f.readers[r] = true
+
+	go f.write(r)
+}
+
+// write sends the queued events to the reader until the queue is closed
+func (f *eventFeed) write(r *eventReader) {
+	for data := range r.events {
+		_ = r.conn.SetWriteDeadline(time.Now().Add(eventFeedWriteTimeout))
+		_, err := r.conn.Write(data)
+		if err != nil {
+			f.Lock()
+			f.dropReader(r)
+			f.Unlock()
+		}
+	}
+
+	_ = r.conn.Close()
+}
+
+// dropReader disconnects the reader without sending the rest of
+// its queue. It must be called with the feed locked
+func (f *eventFeed) dropReader(r *eventReader) {
+	if !f.readers[r] {
+		return
+	}
+
+	delete(f.readers, r)
+	close(r.events)
+	_ = r.conn.Close()
+}
+
+func (f *eventFeed) isValidToken(t string) bool {
+	return subtle.ConstantTimeCompare([]byte(t), []byte(f.token)) == 1
+}
+
+func (f *eventFeed) publish(e Event) {
+	e.Meeting = f.meeting
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf("hosting: event feed publish(): %s", err)
+		return
+	}
+	data = append(data, '\n')
+
+	f.Lock()
+	defer f.Unlock()
+
+	for r := range f.readers {
+		select {
+		case r.events <- data:
+		default:
+			log.Debug("Event feed reader is too slow, disconnecting it")
+			f.dropReader(r)
+		}
+	}
+}
+
+// handlers leave out the chats that Wahay connects for the participants,
+// and their messages are published with the name of the participant. A
+// chat is left out only when the moderator recognizes it as it joins
+func (f *eventFeed) handlers(m *moderator) mumble.Handlers {
+	return mumble.Handlers{
+		OnUserJoined: func(u mumble.User) {
+			if m.isCompanion(u) {
+				f.setCompanion(u.Session, true)
+				return
+			}
+			f.publish(Event{Type: EventParticipantJoined, Session: u.Session, Username: u.Name})
+		},
+		OnUserLeft: func(u mumble.User) {
+			if f.isCompanion(u.Session) {
+				f.setCompanion(u.Session, false)
+				return
+			}
+			f.publish(Event{Type: EventParticipantLeft, Session: u.Session, Username: u.Name})
+		},
+		OnUserMuted: func(u mumble.User) {
+			if f.isCompanion(u.Session) {
+				return
+			}
+			muted, deafened := u.Muted, u.Deafened
+			f.publish(Event{
+				Type:     EventMuteChanged,
+				Session:  u.Session,
+				Username: u.Name,
+				Muted:    &muted,
+				Deafened: &deafened,
+			})
+		},
+		OnTextMessage: func(from mumble.User, message string) {
+			name := from.Name
+			if p, ok := from.CompanionOf(m.client.Users()); ok {
+				name = p.Name
+			}
+			f.publish(Event{Type: EventTextMessage, Session: from.Session, Username: name, Message: message})
+		},
+	}
+}
+
+func (f *eventFeed) setCompanion(session uint32, companion bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	if companion {
+		f.companions[session] = true
+	} else {
+		delete(f.companions, session)
+	}
+}
+
+func (f *eventFeed) isCompanion(session uint32) bool {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.companions[session]
+}
+
+func (f *eventFeed) close() {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.listener != nil {
+		_ = f.listener.Close()
+		f.listener = nil
+	}
+
+	// The readers still get the events in their queues,
+	// and they are disconnected when they are sent
+	for r := range f.readers {
+		delete(f.readers, r)
+		close(r.events)
+	}
+
+	_ = os.Remove(f.path)
+	_ = os.Remove(f.tokenFile)
+}
@@ -0,0 +1,289 @@
+package hosting
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+// The participant's Wahay redeems the invitation token against our certificate
+// HTTP server, telling us the certificate it is going to use to connect. We
+// answer with the current meeting password, and from then on the moderator
+// recognizes that certificate as the invited person. Invitations are not
+// Grumble registered users, since Grumble lets a client without a certificate
+// and without a password connect as any registered user.
+
+const (
+	invitationTokenLength = 24
+
+	revokedReason = "Your invitation to this meeting has been revoked"
+)
+
+var (
+	errInvalidInvitationName = errors.New("the invitation needs a name")
+	errInvitationNameInUse   = errors.New("there is already an invitation with that name")
+	errUnknownInvitation     = errors.New("the invitation doesn't exist")
+	errInvalidCertDigest     = errors.New("invalid certificate digest")
+)
+
+// Invitation is a personal and revocable way of joining a meeting. The
+// token goes where the meeting password would go in the invitation URL
+type Invitation struct {
+	Name  string
+	Token string
+}
+
+type invitation struct {
+	Invitation
+	// certHashes are the certificates the invitation was redeemed
+	// for. Wahay creates a new one every time it launches Mumble
+	certHashes []string
+}
+
+type invitations struct {
+	sync.Mutex
+	byToken    map[string]*invitation
+	byCertHash map[string]*invitation
+}
+
+func newInvitations() *invitations {
+	return &invitations{
+		byToken:    make(map[string]*invitation),
+		byCertHash: make(map[string]*invitation),
+	}
+}
+
+func newInvitationToken() (string, error) {
+	t := make([]byte, invitationTokenLength)
+	err := config.RandomString(t)
+	if err != nil {
+		return "", err
+	}
+	return string(t), nil
+}
+
+func (i *invitations) create(name string) (*invitation, error) {
+	if len(name) == 0 {
+		return nil, errInvalidInvitationName
+	}
+
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	for _, inv := range i.byToken {
+		if inv.Name == name {
+			return nil, errInvitationNameInUse
+		}
+	}
+
+	inv := &invitation{
+		Invitation: Invitation{Name: name, Token: token},
+	}
+
+	i.byToken[token] = inv
+
+	return inv, nil
+}
+
+// redeem records that the invited person is going to connect with the given
+// certificate. It returns false if the invitation doesn't exist
+func (i *invitations) redeem(token, certHash string) (Invitation, bool) {
+	i.Lock()
+	defer i.Unlock()
+
+	inv, ok := i.byToken[token]
+	if !ok {
+		return Invitation{}, false
+	}
+
+	if other, used := i.byCertHash[certHash]; !used || other == inv {
+		if !used {
+			inv.certHashes = append(inv.certHashes, certHash)
+		}
+		i.byCertHash[certHash] = inv
+		return inv.Invitation, true
+	}
+
+	// Somebody else already redeemed an invitation for this certificate
+	return Invitation{}, false
+}
+
+// forCertificate returns the invitation that was
+// redeemed for the given certificate, if any
+func (i *invitations) forCertificate(certHash string) (Invitation, bool) {
+	if len(certHash) == 0 {
+		return Invitation{}, false
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	inv, ok := i.byCertHash[certHash]
+	if !ok {
+		return Invitation{}, false
+	}
+	return inv.Invitation, true
+}
+
+// remove deletes the invitation and returns the
+// certificates it was redeemed for
+func (i *invitations) remove(token string) (Invitation, []string, bool) {
+	i.Lock()
+	defer i.Unlock()
+
+	inv, ok := i.byToken[token]
+	if !ok {
+		return Invitation{}, nil, false
+	}
+
+	delete(i.byToken, token)
+	for _, h := range inv.certHashes {
+		delete(i.byCertHash, h)
+	}
+
+	return inv.Invitation, inv.certHashes, true
+}
+
+func (i *invitations) list() []Invitation {
+	i.Lock()
+	defer i.Unlock()
+
+	result := []Invitation{}
+	for _, inv := range i.byToken {
+		result = append(result, inv.Invitation)
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Name < result[b].Name
+	})
+
+	return result
+}
+
+func isValidCertDigest(digest string) bool {
+	if len(digest) != 40 {
+		return false
+	}
+
+	for _, c := range digest {
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *service) NewInvitation(name string) (Invitation, error) {
+	inv, err := s.invitations.create(name)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	if s.room != nil {
+		err = s.room.lock()
+		if err != nil {
+			s.invitations.remove(inv.Token)
+			return Invitation{}, err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"invitation": inv.Name,
+	}).Info("New personal invitation created")
+
+	return inv.Invitation, nil
+}
+
+func (s *service) Invitations() []Invitation {
+	return s.invitations.list()
+}
+
+func (s *service) RevokeInvitation(token string) error {
+	inv, certHashes, ok := s.invitations.remove(token)
+	if !ok {
+		return errUnknownInvitation
+	}
+
+	log.WithFields(log.Fields{
+		"invitation": inv.Name,
+	}).Info("Personal invitation revoked")
+
+	if s.room == nil {
+		return nil
+	}
+
+	// The person got the meeting password when redeeming the invitation,
+	// so it has to change for them not to be able to join again
+	err := s.room.changeLockPassword()
+	if err != nil {
+		return err
+	}
+
+	if len(certHashes) == 0 {
+		return nil
+	}
+
+	m, err := s.room.getModerator()
+	if err != nil {
+		return err
+	}
+	m.kickCertificates(certHashes, revokedReason)
+
+	return nil
+}
+
+// redeemInvitation returns the password of the meeting to the
+// invited person, and remembers the certificate they will use
+func (s *service) redeemInvitation(token, certHash string) (string, error) {
+	if !isValidCertDigest(certHash) {
+		return "", errInvalidCertDigest
+	}
+
+	if s.room == nil {
+		return "", errUnknownInvitation
+	}
+
+	inv, ok := s.invitations.redeem(token, certHash)
+	if !ok {
+		return "", errUnknownInvitation
+	}
+
+	log.WithFields(log.Fields{
+		"invitation": inv.Name,
+	}).Debug("Personal invitation redeemed")
+
+	return s.room.currentPassword(), nil
+}
+
+// isInvited returns true for the participants that
+// connected with the certificate of an invitation
+func (s *service) isInvited(u mumble.User) bool {
+	_, ok := s.invitations.forCertificate(u.Hash)
+	return ok
+}
+
+// logInvitationJoins leaves a trace of which invitation
+// was used by every person joining the meeting
+func (s *service) logInvitationJoins(u mumble.User) {
+	inv, ok := s.invitations.forCertificate(u.Hash)
+	if !ok {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"invitation": inv.Name,
+		"token":      inv.Token,
+		"session":    u.Session,
+	}).Info("Participant joined the meeting using a personal invitation")
+}
@@ -0,0 +1,341 @@
+package hosting
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1" // #nosec
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+type HostingInvitationsSuite struct{}
+
+var _ = Suite(&HostingInvitationsSuite{})
+
+// Grumble uses global state, so all the tests share the same servers
+var (
+	testServersOnce sync.Once
+	testServers     Servers
+	testServersErr  error
+)
+
+func testServerCollection(c *C) Servers {
+	testServersOnce.Do(func() {
+		testServers, testServersErr = create()
+	})
+	c.Assert(testServersErr, IsNil)
+	return testServers
+}
+
+type fakeOnion struct{}
+
+func (fakeOnion) ID() string    { return "wahaytesting.onion" }
+func (fakeOnion) Delete() error { return nil }
+
+func startTestMeeting(c *C, waitingRoom bool, password string, invitations ...string) (*service, []Invitation) {
+	col := testServerCollection(c)
+
+	httpServer, err := newCertificateServer(col.DataDir())
+	c.Assert(err, IsNil)
+
+	s := &service{
+		port:        config.GetRandomPort(),
+		mumblePort:  DefaultPort,
+		waitingRoom: waitingRoom,
+		onion:       fakeOnion{},
+		invitations: newInvitations(),
+		httpServer:  httpServer,
+		collection:  col,
+	}
+	httpServer.onRedeemInvitation = s.redeemInvitation
+
+	result := []Invitation{}
+	for _, name := range invitations {
+		inv, err := s.NewInvitation(name)
+		c.Assert(err, IsNil)
+		result = append(result, inv)
+	}
+
+	c.Assert(s.NewConferenceRoom(password, SuperUserData{}), IsNil)
+
+	return s, result
+}
+
+// stopTestMeeting doesn't use Close, since
+// it removes the data of all the servers
+func stopTestMeeting(s *service) {
+	_ = s.httpServer.stop()
+	_ = s.room.close()
+}
+
+func testClientCertificate(c *C) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, IsNil)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	c.Assert(err, IsNil)
+
+	// #nosec
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, fmt.Sprintf("%x", sha1.Sum(der))
+}
+
+// redeemThroughCertServer does what the Wahay of an invited person does
+// before launching Mumble. It returns the answer and the HTTP status
+func redeemThroughCertServer(c *C, s *service, token, digest string) (string, int) {
+	params := url.Values{}
+	params.Set("token", token)
+	params.Set("digest", digest)
+	u := "http://" + s.httpServer.address + invitationPath + "?" + params.Encode()
+
+	// The certificate server is started in the background
+	var resp *http.Response
+	var err error
+	for i := 0; i < 50; i++ {
+		resp, err = http.Get(u)
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	c.Assert(err, IsNil)
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	c.Assert(err, IsNil)
+
+	return string(content), resp.StatusCode
+}
+
+func joinTestMeeting(s *service, name, password string, h mumble.Handlers, certs ...tls.Certificate) (mumble.Client, error) {
+	return mumble.Connect(&mumble.Config{
+		Address:      net.JoinHostPort(defaultHost, strconv.Itoa(s.port)),
+		Username:     name,
+		Password:     password,
+		Certificates: certs,
+		Handlers:     h,
+	})
+}
+
+func channelOf(cl mumble.Client) uint32 {
+	me, _ := cl.User(cl.Session())
+	return me.ChannelID
+}
+
+func waitUntil(f func() bool) bool {
+	for i := 0; i < 50; i++ {
+		if f() {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return false
+}
+
+func (s *HostingInvitationsSuite) Test_invitations_create_needsANameThatIsNotInUse(c *C) {
+	i := newInvitations()
+
+	_, err := i.create("")
+	c.Assert(err, Equals, errInvalidInvitationName)
+
+	alice, err := i.create("alice")
+	c.Assert(err, IsNil)
+	c.Assert(alice.Token, Matches, "[0-9a-f]{24}")
+
+	_, err = i.create("alice")
+	c.Assert(err, Equals, errInvitationNameInUse)
+
+	bob, err := i.create("bob")
+	c.Assert(err, IsNil)
+	c.Assert(bob.Token, Not(Equals), alice.Token)
+
+	c.Assert(i.list(), DeepEquals, []Invitation{alice.Invitation, bob.Invitation})
+}
+
+func (s *HostingInvitationsSuite) Test_invitations_rememberTheCertificatesUntilRevoked(c *C) {
+	i := newInvitations()
+	alice, _ := i.create("alice")
+	bob, _ := i.create("bob")
+
+	_, ok := i.redeem("unknown", "aaaa")
+	c.Assert(ok, Equals, false)
+
+	inv, ok := i.redeem(alice.Token, "aaaa")
+	c.Assert(ok, Equals, true)
+	c.Assert(inv, DeepEquals, alice.Invitation)
+	_, ok = i.redeem(alice.Token, "bbbb")
+	c.Assert(ok, Equals, true)
+
+	// The certificate of somebody else can't be taken
+	_, ok = i.redeem(bob.Token, "aaaa")
+	c.Assert(ok, Equals, false)
+
+	inv, ok = i.forCertificate("bbbb")
+	c.Assert(ok, Equals, true)
+	c.Assert(inv, DeepEquals, alice.Invitation)
+	_, ok = i.forCertificate("")
+	c.Assert(ok, Equals, false)
+
+	_, hashes, ok := i.remove(alice.Token)
+	c.Assert(ok, Equals, true)
+	c.Assert(hashes, DeepEquals, []string{"aaaa", "bbbb"})
+
+	_, ok = i.forCertificate("aaaa")
+	c.Assert(ok, Equals, false)
+	_, _, ok = i.remove(alice.Token)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *HostingInvitationsSuite) Test_NewInvitation_locksARunningMeeting(c *C) {
+	m, _ := startTestMeeting(c, false, "")
+	defer stopTestMeeting(m)
+
+	before, err := joinTestMeeting(m, "bob", "", mumble.Handlers{})
+	c.Assert(err, IsNil)
+	defer before.Close()
+
+	_, err = m.NewInvitation("alice")
+	c.Assert(err, IsNil)
+	c.Assert(m.room.currentPassword(), Not(Equals), "")
+
+	_, err = joinTestMeeting(m, "carol", "", mumble.Handlers{})
+	c.Assert(err, NotNil)
+
+	// The people that were already in the meeting stay
+	_, ok := before.User(before.Session())
+	c.Assert(ok, Equals, true)
+}
+
+func (s *HostingInvitationsSuite) Test_NewInvitation_keepsThePasswordOfTheHost(c *C) {
+	m, invitations := startTestMeeting(c, false, "our password", "alice")
+	defer stopTestMeeting(m)
+
+	_, hash := testClientCertificate(c)
+	password, status := redeemThroughCertServer(c, m, invitations[0].Token, hash)
+	c.Assert(status, Equals, http.StatusOK)
+	c.Assert(password, Equals, "our password")
+
+	c.Assert(m.RevokeInvitation(invitations[0].Token), IsNil)
+	c.Assert(m.room.currentPassword(), Equals, "our password")
+}
+
+func (s *HostingInvitationsSuite) Test_redeemInvitation_letsTheInvitedPersonSkipTheLobby(c *C) {
+	m, invitations := startTestMeeting(c, true, "", "alice")
+	defer stopTestMeeting(m)
+
+	cert, hash := testClientCertificate(c)
+	password, status := redeemThroughCertServer(c, m, invitations[0].Token, hash)
+	c.Assert(status, Equals, http.StatusOK)
+	c.Assert(password, Equals, m.room.currentPassword())
+
+	alice, err := joinTestMeeting(m, "alice", password, mumble.Handlers{}, cert)
+	c.Assert(err, IsNil)
+	defer alice.Close()
+
+	meeting, _ := alice.ChannelByName(meetingChannelName)
+	c.Assert(waitUntil(func() bool { return channelOf(alice) == meeting.ID }), Equals, true)
+	c.Assert(m.room.waitingRoom.Waiting(), HasLen, 0)
+}
+
+func (s *HostingInvitationsSuite) Test_redeemInvitation_refusesUnknownTokensAndInvalidDigests(c *C) {
+	m, invitations := startTestMeeting(c, false, "", "alice")
+	defer stopTestMeeting(m)
+
+	_, hash := testClientCertificate(c)
+
+	_, status := redeemThroughCertServer(c, m, "not an invitation", hash)
+	c.Assert(status, Equals, http.StatusNotFound)
+
+	_, status = redeemThroughCertServer(c, m, invitations[0].Token, "not a digest")
+	c.Assert(status, Equals, http.StatusNotFound)
+}
+
+func (s *HostingInvitationsSuite) Test_invitation_cantBeUsedWithoutItsCertificate(c *C) {
+	m, invitations := startTestMeeting(c, true, "", "alice")
+	defer stopTestMeeting(m)
+
+	_, hash := testClientCertificate(c)
+	_, status := redeemThroughCertServer(c, m, invitations[0].Token, hash)
+	c.Assert(status, Equals, http.StatusOK)
+
+	_, err := joinTestMeeting(m, "alice", "", mumble.Handlers{})
+	c.Assert(err, NotNil)
+
+	_, err = joinTestMeeting(m, "alice", invitations[0].Token, mumble.Handlers{})
+	c.Assert(err, NotNil)
+
+	// With the password but another certificate, it's just somebody in the lobby
+	other, _ := testClientCertificate(c)
+	impostor, err := joinTestMeeting(m, "alice", m.room.currentPassword(), mumble.Handlers{}, other)
+	c.Assert(err, IsNil)
+	defer impostor.Close()
+
+	c.Assert(waitUntil(func() bool { return len(m.room.waitingRoom.Waiting()) == 1 }), Equals, true)
+	lobby, _ := impostor.ChannelByName(lobbyChannelName)
+	c.Assert(channelOf(impostor), Equals, lobby.ID)
+}
+
+func (s *HostingInvitationsSuite) Test_RevokeInvitation_kicksThePersonAndKeepsThemOut(c *C) {
+	m, invitations := startTestMeeting(c, false, "", "alice", "bob")
+	defer stopTestMeeting(m)
+
+	cert, hash := testClientCertificate(c)
+	password, _ := redeemThroughCertServer(c, m, invitations[0].Token, hash)
+
+	disconnected := make(chan error, 1)
+	alice, err := joinTestMeeting(m, "alice", password, mumble.Handlers{
+		OnDisconnect: func(err error) { disconnected <- err },
+	}, cert)
+	c.Assert(err, IsNil)
+	defer alice.Close()
+
+	bobCert, bobHash := testClientCertificate(c)
+	bobPassword, _ := redeemThroughCertServer(c, m, invitations[1].Token, bobHash)
+	bob, err := joinTestMeeting(m, "bob", bobPassword, mumble.Handlers{}, bobCert)
+	c.Assert(err, IsNil)
+	defer bob.Close()
+
+	c.Assert(m.RevokeInvitation(invitations[0].Token), IsNil)
+
+	select {
+	case <-disconnected:
+	case <-time.After(5 * time.Second):
+		c.Fatal("the revoked participant was not kicked")
+	}
+
+	// The password they got is not valid anymore, and the invitation can't be redeemed again
+	_, err = joinTestMeeting(m, "alice", password, mumble.Handlers{}, cert)
+	c.Assert(err, NotNil)
+
+	_, status := redeemThroughCertServer(c, m, invitations[0].Token, hash)
+	c.Assert(status, Equals, http.StatusNotFound)
+
+	// The other invited people stay, and can join again by redeeming their invitation
+	_, ok := bob.User(bob.Session())
+	c.Assert(ok, Equals, true)
+
+	bobPassword, status = redeemThroughCertServer(c, m, invitations[1].Token, bobHash)
+	c.Assert(status, Equals, http.StatusOK)
+	c.Assert(bobPassword, Equals, m.room.currentPassword())
+	c.Assert(bobPassword, Not(Equals), password)
+}
@@ -0,0 +1,179 @@
+package hosting
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidMeetingAddr is returned when a meeting address can't be used
+var ErrInvalidMeetingAddr = errors.New("invalid meeting address")
+
+// InvitationScheme is the scheme of the links that open Wahay to join a
+// meeting, like wahay://<meeting ID>:<port>?username=<suggested name>
+const InvitationScheme = "wahay"
+
+const (
+	invitationPrefix = InvitationScheme + "://"
+	mumblePrefix     = "mumble://"
+
+	invitationUsernameParam = "username"
+)
+
+// ParseMeetingAddress accepts a plain meeting ID, a wahay:// invitation or
+// a mumble:// URL. The username and password are taken from the address
+// when it has them. The meeting ID has to follow the same rules as the
+// ones typed in the join window
+func ParseMeetingAddress(address string) (MeetingData, error) {
+	data := MeetingData{}
+	host := address
+
+	if strings.HasPrefix(address, invitationPrefix) || strings.HasPrefix(address, mumblePrefix) {
+		u, err := url.Parse(address)
+		if err != nil {
+			return data, ErrInvalidMeetingAddr
+		}
+
+		if u.User != nil {
+			data.Username = u.User.Username()
+			data.Password, _ = u.User.Password()
+		}
+
+		if username := u.Query().Get(invitationUsernameParam); len(username) != 0 {
+			data.Username = username
+		}
+
+		host = u.Host
+	}
+
+	id, port, err := ExtractMeetingIDandPort(host)
+	if err != nil {
+		return data, err
+	}
+
+	data.MeetingID = id
+	data.Port = port
+
+	return data, nil
+}
+
+// InvitationURI returns the wahay:// link that opens Wahay ready
+// to join the meeting. The password is never part of it
+func (d *MeetingData) InvitationURI() string {
+	u := url.URL{
+		Scheme: InvitationScheme,
+		Host:   d.Address(),
+	}
+
+	if len(d.Username) != 0 {
+		u.RawQuery = url.Values{invitationUsernameParam: {d.Username}}.Encode()
+	}
+
+	return u.String()
+}
+
+// Address returns the meeting ID, followed by the port
+// only when it is not the default one
+func (d *MeetingData) Address() string {
+	if d.Port != 0 && d.Port != DefaultPort {
+		return net.JoinHostPort(d.MeetingID, strconv.Itoa(d.Port))
+	}
+	return d.MeetingID
+}
+
+// ExtractMeetingIDandPort validates a meeting address, with or without the
+// mumble scheme, and returns the onion address and the port of the meeting
+func ExtractMeetingIDandPort(meetingURL string) (meetingID string, port int, err error) {
+	if !IsAValidMeetingID(meetingURL) {
+		err = ErrInvalidMeetingAddr
+		return
+	}
+
+	if !strings.HasPrefix(meetingURL, "mumble://") {
+		v := url.URL{
+			Scheme: "mumble",
+			Host:   meetingURL,
+		}
+		meetingURL = v.String()
+	}
+
+	h, p, e := extractURLAndPort(meetingURL)
+	if e != nil {
+		err = ErrInvalidMeetingAddr
+		return
+	}
+
+	meetingID = h
+	port = p
+
+	return
+}
+
+func extractURLAndPort(urlToParse string) (host string, port int, err error) {
+	var h string
+	var p string
+	var e error
+
+	u, e := url.Parse(urlToParse)
+	if e != nil {
+		err = e
+		return
+	}
+
+	if u.Port() != "" {
+		h, p, e = net.SplitHostPort(u.Host)
+		if e != nil {
+			err = e
+			return
+		}
+
+		host = h
+
+		port, e = strconv.Atoi(p)
+		if e != nil {
+			err = e
+		}
+
+		return
+	}
+
+	host = u.Host
+	port = DefaultPort
+
+	return host, port, err
+}
+
+const onionServiceLength = 62
+
+// IsAValidMeetingID checks that the given string looks like an onion address,
+// optionally followed by a port.
+// TODO: This function needs to be improved in order to make a real validation of
+// the Meeting ID or Onion Address.
+// At the moment, this function helps to test the error code window render.
+func IsAValidMeetingID(meetingID string) bool {
+	if len(meetingID) < onionServiceLength {
+		return false
+	}
+
+	if len(meetingID) == onionServiceLength &&
+		!strings.HasSuffix(meetingID, ".onion") {
+		return false
+	}
+
+	// TODO[OB]: this one is very confusing to me. It can NEVER be true
+	// since this code is dead
+	if len(meetingID) > onionServiceLength &&
+		len(meetingID[:onionServiceLength]) < onionServiceLength {
+		return false
+	}
+
+	if len(meetingID) > onionServiceLength &&
+		strings.Index(meetingID, ":") != onionServiceLength &&
+		strings.Index(meetingID, ".onion") != onionServiceLength-4 {
+		return false
+	}
+
+	return true
+}
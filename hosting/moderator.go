@@ -0,0 +1,183 @@
+package hosting
+
+import (
+	"net"
+	"strconv"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+const (
+	// The moderator is a registered user that Wahay connects to a hosted
+	// meeting when the host needs it to manage the people in it. We use a
+	// big ID so it never collides with the users registered by the Mumble clients
+	moderatorUserID = 1 << 24
+	moderatorName   = "Wahay"
+
+	moderatorNameSuffixLength = 8
+)
+
+// setModerator registers the moderator user and gives it
+// every permission in the whole server
+func setModerator(name, password string) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		moderator, err := grumbleServer.NewUser(moderatorUserID, name)
+		if err != nil {
+			log.Errorf("hosting: setModerator(): %s", err)
+			return
+		}
+		moderator.Password = password
+		serv.Users[moderator.Id] = moderator
+		serv.UserNameMap[moderator.Name] = moderator
+
+		root := serv.RootChannel()
+		root.ACL.ACLs = append(root.ACL.ACLs, acl.ACL{
+			UserId:    moderatorUserID,
+			ApplyHere: true,
+			ApplySubs: true,
+			Allow:     acl.AllPermissions,
+		})
+	}
+}
+
+// newModeratorName gives the moderator of every meeting a different name.
+// Grumble lets a client without a certificate and without a password take
+// the place of a registered user with the same name, so participants
+// shouldn't be able to guess it
+func newModeratorName() (string, error) {
+	suffix := make([]byte, moderatorNameSuffixLength)
+	err := config.RandomString(suffix)
+	if err != nil {
+		return "", err
+	}
+	return moderatorName + "-" + string(suffix), nil
+}
+
+func newModeratorPassword() (string, error) {
+	p := make([]byte, 32)
+	err := config.RandomString(p)
+	if err != nil {
+		return "", err
+	}
+	return string(p), nil
+}
+
+type moderator struct {
+	sync.Mutex
+	client    mumble.Client
+	listeners []mumble.Handlers
+}
+
+func connectModerator(port int, name, password string) (*moderator, error) {
+	m := &moderator{}
+
+	c, err := mumble.Connect(&mumble.Config{
+		Address:  net.JoinHostPort(defaultHost, strconv.Itoa(port)),
+		Username: name,
+		Password: password,
+		Handlers: mumble.Handlers{
+			OnUserJoined:  m.userJoined,
+			OnUserMoved:   m.userMoved,
+			OnUserMuted:   m.userMuted,
+			OnUserLeft:    m.userLeft,
+			OnTextMessage: m.textMessage,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.client = c
+
+	return m, nil
+}
+
+// addListener registers a new set of functions to be called
+// when something happens in the meeting
+func (m *moderator) addListener(h mumble.Handlers) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.listeners = append(m.listeners, h)
+}
+
+func (m *moderator) currentListeners() []mumble.Handlers {
+	m.Lock()
+	defer m.Unlock()
+
+	return append([]mumble.Handlers{}, m.listeners...)
+}
+
+func (m *moderator) userJoined(u mumble.User) {
+	for _, l := range m.currentListeners() {
+		if l.OnUserJoined != nil {
+			l.OnUserJoined(u)
+		}
+	}
+}
+
+func (m *moderator) userMoved(u mumble.User, from uint32) {
+	for _, l := range m.currentListeners() {
+		if l.OnUserMoved != nil {
+			l.OnUserMoved(u, from)
+		}
+	}
+}
+
+func (m *moderator) userMuted(u mumble.User) {
+	for _, l := range m.currentListeners() {
+		if l.OnUserMuted != nil {
+			l.OnUserMuted(u)
+		}
+	}
+}
+
+func (m *moderator) userLeft(u mumble.User) {
+	for _, l := range m.currentListeners() {
+		if l.OnUserLeft != nil {
+			l.OnUserLeft(u)
+		}
+	}
+}
+
+func (m *moderator) textMessage(from mumble.User, message string) {
+	for _, l := range m.currentListeners() {
+		if l.OnTextMessage != nil {
+			l.OnTextMessage(from, message)
+		}
+	}
+}
+
+// kickCertificates disconnects whoever is connected
+// using one of the given certificates
+func (m *moderator) kickCertificates(certHashes []string, reason string) {
+	for _, u := range m.client.Users() {
+		for _, h := range certHashes {
+			if u.Hash != h {
+				continue
+			}
+
+			err := m.client.Kick(u.Session, reason)
+			if err != nil {
+				log.Errorf("hosting: kickCertificates(): %s", err)
+			}
+		}
+	}
+}
+
+func (m *moderator) close() error {
+	return m.client.Close()
+}
+
+// isCompanion returns true for the connections that Wahay makes
+// on behalf of a participant, like the one of the chat
+func (m *moderator) isCompanion(u mumble.User) bool {
+	_, ok := u.CompanionOf(m.client.Users())
+	return ok
+}
@@ -0,0 +1,92 @@
+package hosting
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+type HostingModeratorSuite struct{}
+
+var _ = Suite(&HostingModeratorSuite{})
+
+func (s *HostingModeratorSuite) Test_newModeratorName_isDifferentEveryTime(c *C) {
+	one, err := newModeratorName()
+	c.Assert(err, IsNil)
+	two, err := newModeratorName()
+	c.Assert(err, IsNil)
+
+	c.Assert(one, Matches, "Wahay-[0-9a-f]{8}")
+	c.Assert(two, Matches, "Wahay-[0-9a-f]{8}")
+	c.Assert(one, Not(Equals), two)
+}
+
+func (s *HostingModeratorSuite) Test_setModerator_registersTheModeratorWithEveryPermission(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	setModerator("Wahay-test", "secret")(serv)
+
+	u := serv.Users[moderatorUserID]
+	c.Assert(u, NotNil)
+	c.Assert(u.Name, Equals, "Wahay-test")
+	c.Assert(u.Password, Equals, "secret")
+	c.Assert(serv.UserNameMap["Wahay-test"], Equals, u)
+
+	acls := serv.RootChannel().ACL.ACLs
+	last := acls[len(acls)-1]
+	c.Assert(last.UserId, Equals, moderatorUserID)
+	c.Assert(last.ApplyHere, Equals, true)
+	c.Assert(last.ApplySubs, Equals, true)
+	c.Assert(last.Allow, Equals, acl.Permission(acl.AllPermissions))
+}
+
+func (s *HostingModeratorSuite) Test_moderator_tellsEveryListenerWhatHappens(c *C) {
+	m := &moderator{}
+	events := []string{}
+
+	m.addListener(mumble.Handlers{
+		OnUserJoined: func(u mumble.User) { events = append(events, "joined "+u.Name) },
+		OnUserLeft:   func(u mumble.User) { events = append(events, "left "+u.Name) },
+	})
+	m.addListener(mumble.Handlers{
+		OnUserJoined: func(u mumble.User) { events = append(events, "joined again "+u.Name) },
+		OnUserMoved:  func(u mumble.User, from uint32) { events = append(events, "moved "+u.Name) },
+		OnUserMuted:  func(u mumble.User) { events = append(events, "muted "+u.Name) },
+		OnTextMessage: func(from mumble.User, message string) {
+			events = append(events, from.Name+" says "+message)
+		},
+	})
+
+	alice := mumble.User{Session: 2, Name: "alice"}
+	m.userJoined(alice)
+	m.userMoved(alice, 0)
+	m.userMuted(alice)
+	m.textMessage(alice, "hello")
+	m.userLeft(alice)
+
+	c.Assert(events, DeepEquals, []string{
+		"joined alice",
+		"joined again alice",
+		"moved alice",
+		"muted alice",
+		"alice says hello",
+		"left alice",
+	})
+}
+
+func (s *HostingModeratorSuite) Test_moderator_kickCertificates_kicksOnlyThoseCertificates(c *C) {
+	f := &fakeModeratorClient{users: map[uint32]mumble.User{
+		2: {Session: 2, Name: "alice", UserID: -1, Hash: "aaaa"},
+		3: {Session: 3, Name: "bob", UserID: -1},
+		4: {Session: 4, Name: "carol", UserID: -1, Hash: "cccc"},
+	}}
+	m := &moderator{client: f}
+
+	m.kickCertificates([]string{"aaaa", "bbbb"}, "bye")
+
+	c.Assert(f.kicked, DeepEquals, []uint32{2})
+	c.Assert(f.users, HasLen, 2)
+}
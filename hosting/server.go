@@ -6,6 +6,10 @@ import grumbleServer "github.com/digitalautonomy/grumble/server"
 type Server interface {
 	Start() error
 	Stop() error
+
+	// setPassword changes the password of a server that
+	// might already be running. Connected people stay
+	setPassword(string)
 }
 
 type server struct {
@@ -27,3 +31,7 @@ func (s *server) Start() error {
 func (s *server) Stop() error {
 	return s.gs.Stop()
 }
+
+func (s *server) setPassword(password string) {
+	s.gs.SetServerPassword(password)
+}
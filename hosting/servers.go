@@ -22,6 +22,7 @@ type Servers interface {
 	DataDir() string
 	Cleanup()
 	NewService(port string, t tor.Instance) (Service, error)
+	NewServiceWithKey(port string, key tor.OnionKey, t tor.Instance) (Service, error)
 }
 
 // MeetingData is a representation of the data used to create a Mumble url
@@ -167,6 +168,15 @@ func setPort(port string) serverModifier {
 	}
 }
 
+// setWebSocketPort enables the Grumble web server, so clients
+// running in a browser can connect through WebSockets
+func setWebSocketPort(port string) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		serv.Set("NoWebServer", "false")
+		serv.Set("WebPort", port)
+	}
+}
+
 func setPassword(password string) serverModifier {
 	return func(serv *grumbleServer.Server) {
 		if len(password) != 0 {
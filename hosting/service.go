@@ -4,10 +4,13 @@ import (
 	"errors"
 	"net"
 	"strconv"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/mumble"
 	"github.com/digitalautonomy/wahay/tor"
 )
 
@@ -20,6 +23,10 @@ const (
 	// DefaultPort is a representation of the default port Mumble server
 	DefaultPort = 64738
 
+	// WebSocketServicePort is the onion port where browser
+	// clients can reach the meeting through WebSockets
+	WebSocketServicePort = 443
+
 	defaultHost = "127.0.0.1"
 )
 
@@ -39,18 +46,38 @@ type Service interface {
 	Port() int
 	ServicePort() int
 	SetWelcomeText(string)
+	SetWaitingRoom(bool)
+	WaitingRoom() (WaitingRoom, error)
+	SetEventFeed(bool)
+	SetWebSocket(bool)
+	WebSocketURL() string
+	Stats() (Stats, error)
+	EventFeed() (EventFeed, error)
+	NewInvitation(name string) (Invitation, error)
+	Invitations() []Invitation
+	RevokeInvitation(token string) error
 	NewConferenceRoom(password string, u SuperUserData) error
+	// CurrentPassword returns the password the running meeting accepts.
+	// It's not the one given to NewConferenceRoom when the meeting has
+	// been locked for personal invitations
+	CurrentPassword() string
 	Close() error
 }
 
 type service struct {
-	port        int
-	mumblePort  int
-	welcomeText string
-	onion       tor.Onion
-	room        *conferenceRoom
-	httpServer  *webserver
-	collection  Servers
+	port          int
+	mumblePort    int
+	webSocketPort int
+	relayPorts    map[int]int
+	webSocket     bool
+	welcomeText   string
+	waitingRoom   bool
+	eventFeed     bool
+	onion         tor.Onion
+	room          *conferenceRoom
+	invitations   *invitations
+	httpServer    *webserver
+	collection    Servers
 }
 
 func (s *service) ID() string {
@@ -72,22 +99,121 @@ func (s *service) ServicePort() int {
 	return s.mumblePort
 }
 
+func (s *service) CurrentPassword() string {
+	if s.room == nil {
+		return ""
+	}
+	return s.room.currentPassword()
+}
+
 func (s *service) SetWelcomeText(t string) {
 	s.welcomeText = t
 }
 
+func (s *service) SetWaitingRoom(enabled bool) {
+	s.waitingRoom = enabled
+}
+
+func (s *service) WaitingRoom() (WaitingRoom, error) {
+	if s.room == nil || s.room.waitingRoom == nil {
+		return nil, errWaitingRoomDisabled
+	}
+	return s.room.waitingRoom, nil
+}
+
+func (s *service) SetWebSocket(enabled bool) {
+	s.webSocket = enabled
+}
+
+// WebSocketURL returns the address browser clients should connect to,
+// or an empty string if WebSockets are not enabled in this meeting
+func (s *service) WebSocketURL() string {
+	if !s.webSocket {
+		return ""
+	}
+	return "wss://" + net.JoinHostPort(s.ID(), strconv.Itoa(WebSocketServicePort))
+}
+
+func (s *service) SetEventFeed(enabled bool) {
+	s.eventFeed = enabled
+}
+
+func (s *service) EventFeed() (EventFeed, error) {
+	if s.room == nil || s.room.eventFeed == nil {
+		return nil, errEventFeedDisabled
+	}
+	return s.room.eventFeed, nil
+}
+
 type conferenceRoom struct {
-	server Server
+	server      Server
+	started     time.Time
+	traffic     *trafficCounter
+	relays      []*trafficRelay
+	waitingRoom *waitingRoom
+	eventFeed   *eventFeed
+
+	port               int
+	moderatorName      string
+	moderatorPassword  string
+	moderatorListeners []mumble.Handlers
+	moderatorLock      sync.Mutex
+	moderator          *moderator
+
+	// isInvited tells if a participant connected
+	// with the certificate of a personal invitation
+	isInvited func(mumble.User) bool
+
+	// password is the current password of the meeting. When the host
+	// didn't choose one, the meeting is locked with a random password as
+	// soon as there are personal invitations. Only invited people get it,
+	// so it's changed when an invitation is revoked
+	sync.Mutex
+	password       string
+	randomPassword bool
 }
 
 func (s *service) NewConferenceRoom(password string, u SuperUserData) error {
-	serv, err := s.collection.CreateServer(
+	moderatorName, err := newModeratorName()
+	if err != nil {
+		return err
+	}
+
+	moderatorPassword, err := newModeratorPassword()
+	if err != nil {
+		return err
+	}
+
+	// When the host has sent personal invitations and there is no meeting
+	// password, only the invited people should be able to get in. If the
+	// first invitation is created later, the meeting is locked then
+	randomPassword := false
+	if len(password) == 0 && len(s.invitations.list()) > 0 {
+		password, err = newInvitationToken()
+		if err != nil {
+			return err
+		}
+		randomPassword = true
+	}
+
+	modifiers := []serverModifier{
 		setDefaultOptions,
 		setWelcomeText(s.welcomeText),
 		setPort(strconv.Itoa(s.port)),
 		setPassword(password),
 		setSuperUser(u.Username, u.Password),
-	)
+		setModerator(moderatorName, moderatorPassword),
+	}
+
+	if s.waitingRoom {
+		modifiers = append(modifiers, setWaitingRoom)
+	}
+
+	if s.webSocket {
+		modifiers = append(modifiers, setWebSocketPort(strconv.Itoa(s.webSocketPort)))
+	}
+
+	serv, err := s.collection.CreateServer(modifiers...)
 	if err != nil {
 		return err
 	}
@@ -98,7 +224,40 @@ func (s *service) NewConferenceRoom(password string, u SuperUserData) error {
 	}
 
 	s.room = &conferenceRoom{
-		server: serv,
+		server:            serv,
+		password:          password,
+		randomPassword:    randomPassword,
+		started:           time.Now(),
+		traffic:           &trafficCounter{},
+		port:              s.port,
+		moderatorName:     moderatorName,
+		moderatorPassword: moderatorPassword,
+		moderatorListeners: []mumble.Handlers{
+			{OnUserJoined: s.logInvitationJoins},
+		},
+		isInvited: s.isInvited,
+	}
+
+	err = s.room.startRelays(s.relayPorts)
+	if err != nil {
+		_ = s.room.close()
+		s.room = nil
+		return err
+	}
+
+	// The waiting room and the event feed need the moderator from the start.
+	// Otherwise, it's only connected when the host asks for something it does
+	if s.waitingRoom || s.eventFeed {
+		err = s.room.connectModerator(s.waitingRoom)
+		if err != nil {
+			_ = s.room.close()
+			s.room = nil
+			return err
+		}
+	}
+
+	if s.eventFeed {
+		s.room.startEventFeed(s.ID())
 	}
 
 	// Start our certification http server
@@ -110,12 +269,151 @@ func (s *service) NewConferenceRoom(password string, u SuperUserData) error {
 	return nil
 }
 
+func (r *conferenceRoom) connectModerator(waitingRoom bool) error {
+	m, err := r.getModerator()
+	if err != nil {
+		return err
+	}
+
+	if waitingRoom {
+		r.waitingRoom, err = newWaitingRoom(m, r.isInvited)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getModerator returns the moderator of the meeting,
+// connecting it the first time it's needed
+func (r *conferenceRoom) getModerator() (*moderator, error) {
+	r.moderatorLock.Lock()
+	defer r.moderatorLock.Unlock()
+
+	if r.moderator != nil {
+		return r.moderator, nil
+	}
+
+	m, err := connectModerator(r.port, r.moderatorName, r.moderatorPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range r.moderatorListeners {
+		m.addListener(l)
+	}
+	r.moderator = m
+
+	return m, nil
+}
+
+// lock sets a random password in a running meeting that has none. Then
+// only the people with a personal invitation can get in
+func (r *conferenceRoom) lock() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.password) > 0 {
+		return nil
+	}
+
+	return r.setRandomPassword()
+}
+
+// changeLockPassword gives a new random password to a meeting that was locked
+// because of the personal invitations. The people already in the meeting stay
+func (r *conferenceRoom) changeLockPassword() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.randomPassword {
+		return nil
+	}
+
+	return r.setRandomPassword()
+}
+
+// setRandomPassword must be called with the room locked
+func (r *conferenceRoom) setRandomPassword() error {
+	password, err := newInvitationToken()
+	if err != nil {
+		return err
+	}
+
+	r.server.setPassword(password)
+	r.password = password
+	r.randomPassword = true
+
+	return nil
+}
+
+func (r *conferenceRoom) currentPassword() string {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.password
+}
+
+// startRelays starts counting the traffic going from
+// the onion service ports to the given local ports
+func (r *conferenceRoom) startRelays(ports map[int]int) error {
+	for target, port := range ports {
+		relay, err := newTrafficRelay(port, target, r.traffic)
+		if err != nil {
+			return err
+		}
+		r.relays = append(r.relays, relay)
+	}
+	return nil
+}
+
+// startEventFeed doesn't stop the meeting if the feed
+// can't be created, since it's only a convenience
+func (r *conferenceRoom) startEventFeed(meeting string) {
+	f, err := newEventFeed(meeting)
+	if err != nil {
+		log.Errorf("hosting: startEventFeed(): %s", err)
+		return
+	}
+
+	r.eventFeed = f
+	r.moderator.addListener(f.handlers(r.moderator))
+	f.publish(Event{Type: EventMeetingStarted})
+}
+
 func (r *conferenceRoom) close() error {
+	if r.eventFeed != nil {
+		r.eventFeed.publish(Event{Type: EventMeetingFinished})
+		r.eventFeed.close()
+	}
+
+	for _, relay := range r.relays {
+		relay.close()
+	}
+
+	r.moderatorLock.Lock()
+	if r.moderator != nil {
+		err := r.moderator.close()
+		if err != nil {
+			log.Errorf("hosting close moderator: close(): %s", err)
+		}
+	}
+	r.moderatorLock.Unlock()
+
 	return r.server.Stop()
 }
 
 // NewService creates a new hosting service
 func (s *servers) NewService(port string, t tor.Instance) (Service, error) {
+	return s.NewServiceWithKey(port, "", t)
+}
+
+// NewServiceWithKey creates a new hosting service that uses the onion
+// address of the given key. This is how a scheduled meeting keeps the
+// address that was sent in the invitations. If the key is empty, a new
+// address is generated
+func (s *servers) NewServiceWithKey(port string, key tor.OnionKey, t tor.Instance) (Service, error) {
 	var onionPorts []tor.OnionPort
 
 	httpServer, err := newCertificateServer(s.DataDir())
@@ -137,27 +435,53 @@ func (s *servers) NewService(port string, t tor.Instance) (Service, error) {
 		}
 	}
 
+	// Tor doesn't connect directly to Grumble, but to a relay that
+	// counts the traffic of the meeting. See traffic.go
 	serverPort := config.GetRandomPort()
+	relayPorts := map[int]int{
+		serverPort: config.GetRandomPort(),
+	}
 
 	onionPorts = append(onionPorts, tor.OnionPort{
 		DestinationHost: defaultHost,
-		DestinationPort: serverPort,
+		DestinationPort: relayPorts[serverPort],
 		ServicePort:     p,
 	})
 
-	onion, err := t.NewOnionServiceWithMultiplePorts(onionPorts)
+	// The onion port for WebSockets always exists, since the onion service is
+	// created before the host decides. Nothing listens on it unless enabled
+	webSocketPort := config.GetRandomPort()
+	relayPorts[webSocketPort] = config.GetRandomPort()
+
+	onionPorts = append(onionPorts, tor.OnionPort{
+		DestinationHost: defaultHost,
+		DestinationPort: relayPorts[webSocketPort],
+		ServicePort:     WebSocketServicePort,
+	})
+
+	var onion tor.Onion
+	if key == "" {
+		onion, err = t.NewOnionServiceWithMultiplePorts(onionPorts)
+	} else {
+		onion, err = t.NewOnionServiceWithKey(onionPorts, key)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	ss := &service{
-		port:       serverPort,
-		mumblePort: p,
-		onion:      onion,
-		httpServer: httpServer,
-		collection: s,
+		port:          serverPort,
+		mumblePort:    p,
+		webSocketPort: webSocketPort,
+		relayPorts:    relayPorts,
+		onion:         onion,
+		invitations:   newInvitations(),
+		httpServer:    httpServer,
+		collection:    s,
 	}
 
+	httpServer.onRedeemInvitation = ss.redeemInvitation
+
 	return ss, nil
 }
 
@@ -0,0 +1,103 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type HostingServiceSuite struct{}
+
+var _ = Suite(&HostingServiceSuite{})
+
+type fakeTor struct {
+	tor.Instance
+	ports []tor.OnionPort
+}
+
+func (f *fakeTor) NewOnionServiceWithMultiplePorts(ports []tor.OnionPort) (tor.Onion, error) {
+	f.ports = ports
+	return fakeOnion{}, nil
+}
+
+func (f *fakeTor) onionPort(servicePort int) (tor.OnionPort, bool) {
+	for _, p := range f.ports {
+		if p.ServicePort == servicePort {
+			return p, true
+		}
+	}
+	return tor.OnionPort{}, false
+}
+
+func newTestService(c *C) (*service, *fakeTor) {
+	t := &fakeTor{}
+	s, err := testServerCollection(c).NewService("", t)
+	c.Assert(err, IsNil)
+	return s.(*service), t
+}
+
+func isListening(port int) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(defaultHost, strconv.Itoa(port)), time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+func (s *HostingServiceSuite) Test_NewService_sendsTheWebSocketOnionPortThroughARelay(c *C) {
+	serv, t := newTestService(c)
+
+	p, ok := t.onionPort(WebSocketServicePort)
+	c.Assert(ok, Equals, true)
+	c.Assert(p.DestinationHost, Equals, defaultHost)
+	c.Assert(p.DestinationPort, Equals, serv.relayPorts[serv.webSocketPort])
+	c.Assert(p.DestinationPort, Not(Equals), serv.webSocketPort)
+
+	mumble, ok := t.onionPort(DefaultPort)
+	c.Assert(ok, Equals, true)
+	c.Assert(mumble.DestinationPort, Equals, serv.relayPorts[serv.port])
+}
+
+func (s *HostingServiceSuite) Test_WebSocketURL_isOnlyGivenWhenEnabled(c *C) {
+	serv, _ := newTestService(c)
+
+	c.Assert(serv.WebSocketURL(), Equals, "")
+
+	serv.SetWebSocket(true)
+	c.Assert(serv.WebSocketURL(), Equals, "wss://wahaytesting.onion:443")
+}
+
+func (s *HostingServiceSuite) Test_NewConferenceRoom_doesntListenForWebSocketsUnlessEnabled(c *C) {
+	serv, _ := newTestService(c)
+
+	c.Assert(serv.NewConferenceRoom("", SuperUserData{}), IsNil)
+	defer stopTestMeeting(serv)
+
+	c.Assert(isListening(serv.port), Equals, true)
+	c.Assert(isListening(serv.webSocketPort), Equals, false)
+}
+
+func (s *HostingServiceSuite) Test_NewConferenceRoom_listensForWebSocketsBehindTheRelay(c *C) {
+	serv, t := newTestService(c)
+	serv.SetWebSocket(true)
+
+	c.Assert(serv.NewConferenceRoom("", SuperUserData{}), IsNil)
+	defer stopTestMeeting(serv)
+
+	// Grumble starts the web server in the background
+	c.Assert(waitUntil(func() bool { return isListening(serv.webSocketPort) }), Equals, true)
+
+	// What arrives to the onion port reaches the TLS web server of Grumble
+	p, _ := t.onionPort(WebSocketServicePort)
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 5 * time.Second}, "tcp",
+		net.JoinHostPort(p.DestinationHost, strconv.Itoa(p.DestinationPort)),
+		&tls.Config{InsecureSkipVerify: true}) // #nosec
+	c.Assert(err, IsNil)
+	_ = conn.Close()
+}
@@ -0,0 +1,112 @@
+package hosting
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+// statsDeadline is how long we wait for the statistics of all the participants
+const statsDeadline = 10 * time.Second
+
+var errNoMeeting = errors.New("there is no meeting running")
+
+// ParticipantStats is the health of the connection of a single participant
+type ParticipantStats struct {
+	Session  uint32
+	Username string
+	// Ping is the average round trip to the server in milliseconds
+	Ping float32
+	// PacketLoss is the percentage of voice packets the participant didn't receive
+	PacketLoss float64
+}
+
+// Stats is a summary of the health of a meeting
+type Stats struct {
+	Uptime       time.Duration
+	Participants []ParticipantStats
+	// BytesIn and BytesOut only count the traffic going through Tor,
+	// so the host's own connection is not included
+	BytesIn  uint64
+	BytesOut uint64
+}
+
+func (s *service) Stats() (Stats, error) {
+	if s.room == nil {
+		return Stats{}, errNoMeeting
+	}
+
+	m, err := s.room.getModerator()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	result := Stats{
+		Uptime:       time.Since(s.room.started),
+		Participants: []ParticipantStats{},
+		BytesIn:      s.room.traffic.bytesIn(),
+		BytesOut:     s.room.traffic.bytesOut(),
+	}
+
+	c := m.client
+	participants := []mumble.User{}
+	for _, u := range c.Users() {
+		if u.Session != c.Session() && !m.isCompanion(u) {
+			participants = append(participants, u)
+		}
+	}
+
+	// The participants are asked at the same time, so the ones
+	// that don't answer only delay the result until the deadline
+	type answer struct {
+		session uint32
+		stats   mumble.UserStats
+		err     error
+	}
+
+	answers := make(chan answer, len(participants))
+	for _, u := range participants {
+		go func(session uint32) {
+			us, err := c.Stats(session)
+			answers <- answer{session, us, err}
+		}(u.Session)
+	}
+
+	received := map[uint32]mumble.UserStats{}
+	deadline := time.After(statsDeadline)
+
+collect:
+	for range participants {
+		select {
+		case a := <-answers:
+			if a.err != nil {
+				log.Debugf("hosting: Stats(): %s", a.err)
+				continue
+			}
+			received[a.session] = a.stats
+		case <-deadline:
+			break collect
+		}
+	}
+
+	for _, u := range participants {
+		p := ParticipantStats{
+			Session:  u.Session,
+			Username: u.Name,
+		}
+
+		if us, ok := received[u.Session]; ok {
+			// Through Tor the voice goes over the TCP connection
+			// most of the time, so that is the ping we trust
+			p.Ping = us.TCPPing
+			p.PacketLoss = us.FromServer.Loss()
+		}
+
+		result.Participants = append(result.Participants, p)
+	}
+
+	return result, nil
+}
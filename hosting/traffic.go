@@ -0,0 +1,149 @@
+package hosting
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Grumble doesn't keep track of the bandwidth used by a meeting, so Tor
+// sends the connections to a relay that counts the bytes going through it
+// before passing them to Grumble
+
+type trafficCounter struct {
+	in  uint64
+	out uint64
+}
+
+func (t *trafficCounter) bytesIn() uint64 {
+	return atomic.LoadUint64(&t.in)
+}
+
+func (t *trafficCounter) bytesOut() uint64 {
+	return atomic.LoadUint64(&t.out)
+}
+
+type countingWriter struct {
+	w       io.Writer
+	counter *uint64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	atomic.AddUint64(c.counter, uint64(n))
+	return n, err
+}
+
+type trafficRelay struct {
+	sync.Mutex
+
+	target   string
+	counter  *trafficCounter
+	listener net.Listener
+	conns    map[net.Conn]bool
+}
+
+func newTrafficRelay(port, targetPort int, counter *trafficCounter) (*trafficRelay, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(defaultHost, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &trafficRelay{
+		target:   net.JoinHostPort(defaultHost, strconv.Itoa(targetPort)),
+		counter:  counter,
+		listener: l,
+		conns:    make(map[net.Conn]bool),
+	}
+
+	go r.accept(l)
+
+	return r, nil
+}
+
+func (r *trafficRelay) accept(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		go r.relay(conn)
+	}
+}
+
+func (r *trafficRelay) track(conns ...net.Conn) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.listener == nil {
+		return false
+	}
+
+	for _, c := range conns {
+		r.conns[c] = true
+	}
+
+	return true
+}
+
+func (r *trafficRelay) forget(conns ...net.Conn) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, c := range conns {
+		_ = c.Close()
+		delete(r.conns, c)
+	}
+}
+
+func (r *trafficRelay) relay(conn net.Conn) {
+	target, err := net.Dial("tcp", r.target)
+	if err != nil {
+		log.Debugf("hosting: traffic relay: %s", err)
+		_ = conn.Close()
+		return
+	}
+
+	if !r.track(conn, target) {
+		_ = conn.Close()
+		_ = target.Close()
+		return
+	}
+
+	done := make(chan bool, 2)
+
+	go func() {
+		_, _ = io.Copy(&countingWriter{target, &r.counter.in}, conn)
+		done <- true
+	}()
+
+	go func() {
+		_, _ = io.Copy(&countingWriter{conn, &r.counter.out}, target)
+		done <- true
+	}()
+
+	// When one side goes away, the other one is useless
+	<-done
+	r.forget(conn, target)
+	<-done
+}
+
+func (r *trafficRelay) close() {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.listener != nil {
+		_ = r.listener.Close()
+		r.listener = nil
+	}
+
+	for c := range r.conns {
+		_ = c.Close()
+		delete(r.conns, c)
+	}
+}
@@ -0,0 +1,321 @@
+package hosting
+
+import (
+	"errors"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+const (
+	lobbyChannelName   = "Lobby"
+	meetingChannelName = "Meeting"
+
+	deniedReason = "The host didn't let you into the meeting"
+)
+
+var (
+	errWaitingRoomDisabled = errors.New("the waiting room is not enabled")
+	errNotWaiting          = errors.New("the participant is not in the waiting room")
+)
+
+// Knock represents a participant waiting in the lobby to be admitted
+type Knock struct {
+	Session  uint32
+	Username string
+}
+
+// WaitingRoom lets the host decide who enters the meeting
+type WaitingRoom interface {
+	// OnKnock registers a function to be called when somebody arrives to
+	// the lobby. Participants already waiting are notified right away
+	OnKnock(func(Knock))
+	// OnLeave registers a function to be called when somebody stops waiting,
+	// either because they were admitted, denied or just left
+	OnLeave(func(Knock))
+	Waiting() []Knock
+	Admit(session uint32) error
+	Deny(session uint32) error
+}
+
+// setWaitingRoom turns the root channel into a lobby where nobody can talk,
+// and adds the real meeting channel below it. Only the moderator is allowed to
+// move people into the meeting
+func setWaitingRoom(serv *grumbleServer.Server) {
+	lobby := serv.RootChannel()
+	lobby.Name = lobbyChannelName
+	lobby.ACL.ACLs = append(lobby.ACL.ACLs, acl.ACL{
+		UserId:    -1,
+		Group:     "all",
+		ApplyHere: true,
+		Deny:      acl.SpeakPermission | acl.WhisperPermission,
+	})
+
+	meeting := serv.AddChannel(meetingChannelName)
+	meeting.ACL.InheritACL = true
+	meeting.ACL.ACLs = append(meeting.ACL.ACLs, acl.ACL{
+		UserId:    -1,
+		Group:     "all",
+		ApplyHere: true,
+		ApplySubs: true,
+		Deny:      acl.EnterPermission,
+	})
+	lobby.AddChild(meeting)
+
+	// The super user is the host, and it should go directly into the meeting
+	serv.Users[0].LastChannelId = meeting.Id
+
+	// The moderator needs to be in the meeting to hear what is said in the chat
+	if m, ok := serv.Users[moderatorUserID]; ok {
+		m.LastChannelId = meeting.Id
+	}
+}
+
+type waitingRoom struct {
+	sync.Mutex
+
+	client  mumble.Client
+	lobby   uint32
+	meeting uint32
+	waiting map[uint32]Knock
+	onKnock func(Knock)
+	onLeave func(Knock)
+
+	// isInvited tells which participants have a personal
+	// invitation. They go into the meeting without knocking
+	isInvited func(mumble.User) bool
+
+	// companions are the chats of participants that are not in the meeting
+	// yet, with the session of their participant. They don't knock, but get
+	// in or are sent away with their participant. A chat is only recognized
+	// when it uses the certificate of its participant, see mumble.User, so
+	// until its participant arrives it knocks like anybody else
+	companions map[uint32]uint32
+}
+
+func newWaitingRoom(m *moderator, isInvited func(mumble.User) bool) (*waitingRoom, error) {
+	lobby, ok1 := m.client.ChannelByName(lobbyChannelName)
+	meeting, ok2 := m.client.ChannelByName(meetingChannelName)
+	if !ok1 || !ok2 {
+		return nil, errors.New("the waiting room channels don't exist")
+	}
+
+	w := &waitingRoom{
+		client:  m.client,
+		lobby:   lobby.ID,
+		meeting: meeting.ID,
+		waiting: make(map[uint32]Knock),
+
+		isInvited:  isInvited,
+		companions: make(map[uint32]uint32),
+	}
+
+	m.addListener(mumble.Handlers{
+		OnUserJoined: w.userArrived,
+		OnUserMoved: func(u mumble.User, _ uint32) {
+			w.userArrived(u)
+		},
+		OnUserLeft: func(u mumble.User) {
+			w.stopWaiting(u.Session)
+		},
+	})
+
+	// People could have arrived while we were connecting
+	for _, u := range m.client.Users() {
+		if u.Session != m.client.Session() {
+			w.userArrived(u)
+		}
+	}
+
+	return w, nil
+}
+
+func (w *waitingRoom) userArrived(u mumble.User) {
+	w.Lock()
+	if u.ChannelID != w.lobby || u.UserID == 0 {
+		w.Unlock()
+		w.stopWaiting(u.Session)
+		w.bringCompanions(u)
+		return
+	}
+
+	_, waiting := w.waiting[u.Session]
+	_, companion := w.companions[u.Session]
+	if waiting || companion {
+		w.Unlock()
+		return
+	}
+
+	if w.isInvited != nil && w.isInvited(u) {
+		w.Unlock()
+		w.moveIntoMeeting(u.Session)
+		return
+	}
+
+	if p, ok := u.CompanionOf(w.client.Users()); ok {
+		if p.ChannelID == w.meeting || p.UserID == 0 {
+			w.Unlock()
+			w.moveIntoMeeting(u.Session)
+			return
+		}
+
+		w.companions[u.Session] = p.Session
+		w.Unlock()
+		return
+	}
+
+	k := Knock{Session: u.Session, Username: u.Name}
+	w.waiting[u.Session] = k
+	f := w.onKnock
+	w.Unlock()
+
+	if f != nil {
+		f(k)
+	}
+
+	w.bringCompanions(u)
+}
+
+// bringCompanions takes care of the chats of the participant that
+// arrived before them. They were knocking, since they couldn't be
+// recognized yet. Now they stop knocking and follow their participant
+func (w *waitingRoom) bringCompanions(p mumble.User) {
+	for _, u := range w.client.Users() {
+		if u.ChannelID != w.lobby || !u.IsCompanionOf(p) {
+			continue
+		}
+
+		if p.ChannelID != w.lobby || p.UserID == 0 {
+			w.moveIntoMeeting(u.Session)
+			continue
+		}
+
+		w.Lock()
+		k, ok := w.waiting[u.Session]
+		delete(w.waiting, u.Session)
+		w.companions[u.Session] = p.Session
+		f := w.onLeave
+		w.Unlock()
+
+		if ok && f != nil {
+			f(k)
+		}
+	}
+}
+
+func (w *waitingRoom) moveIntoMeeting(session uint32) {
+	err := w.client.Move(session, w.meeting)
+	if err != nil {
+		log.Errorf("hosting: moveIntoMeeting(): %s", err)
+	}
+}
+
+func (w *waitingRoom) stopWaiting(session uint32) {
+	w.Lock()
+	k, ok := w.waiting[session]
+	delete(w.waiting, session)
+	delete(w.companions, session)
+	f := w.onLeave
+	w.Unlock()
+
+	if ok && f != nil {
+		f(k)
+	}
+}
+
+func (w *waitingRoom) OnKnock(f func(Knock)) {
+	w.Lock()
+	w.onKnock = f
+	w.Unlock()
+
+	for _, k := range w.Waiting() {
+		f(k)
+	}
+}
+
+func (w *waitingRoom) OnLeave(f func(Knock)) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.onLeave = f
+}
+
+func (w *waitingRoom) Waiting() []Knock {
+	w.Lock()
+	defer w.Unlock()
+
+	result := []Knock{}
+	for _, k := range w.waiting {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+func (w *waitingRoom) knock(session uint32) (Knock, bool) {
+	w.Lock()
+	defer w.Unlock()
+
+	k, ok := w.waiting[session]
+	return k, ok
+}
+
+func (w *waitingRoom) companionsOf(session uint32) []uint32 {
+	w.Lock()
+	defer w.Unlock()
+
+	result := []uint32{}
+	for c, owner := range w.companions {
+		if owner == session {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+func (w *waitingRoom) Admit(session uint32) error {
+	_, ok := w.knock(session)
+	if !ok {
+		return errNotWaiting
+	}
+
+	err := w.client.Move(session, w.meeting)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range w.companionsOf(session) {
+		err = w.client.Move(c, w.meeting)
+		if err != nil {
+			log.Debugf("hosting: Admit(): the chat of the participant can't get in: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *waitingRoom) Deny(session uint32) error {
+	_, ok := w.knock(session)
+	if !ok {
+		return errNotWaiting
+	}
+
+	err := w.client.Kick(session, deniedReason)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range w.companionsOf(session) {
+		err = w.client.Kick(c, deniedReason)
+		if err != nil {
+			log.Debugf("hosting: Deny(): the chat of the participant can't be sent away: %s", err)
+		}
+	}
+
+	return nil
+}
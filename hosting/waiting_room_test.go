@@ -0,0 +1,251 @@
+package hosting
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/digitalautonomy/wahay/mumble"
+)
+
+type HostingWaitingRoomSuite struct{}
+
+var _ = Suite(&HostingWaitingRoomSuite{})
+
+type fakeModeratorClient struct {
+	mumble.Client
+	users  map[uint32]mumble.User
+	kicked []uint32
+}
+
+func (f *fakeModeratorClient) Users() []mumble.User {
+	result := []mumble.User{}
+	for _, u := range f.users {
+		result = append(result, u)
+	}
+	return result
+}
+
+func (f *fakeModeratorClient) User(session uint32) (mumble.User, bool) {
+	u, ok := f.users[session]
+	return u, ok
+}
+
+func (f *fakeModeratorClient) Move(session, channel uint32) error {
+	u := f.users[session]
+	u.ChannelID = channel
+	f.users[session] = u
+	return nil
+}
+
+func (f *fakeModeratorClient) Kick(session uint32, reason string) error {
+	f.kicked = append(f.kicked, session)
+	delete(f.users, session)
+	return nil
+}
+
+func newTestWaitingRoom(users ...mumble.User) (*waitingRoom, *fakeModeratorClient) {
+	f := &fakeModeratorClient{users: map[uint32]mumble.User{}}
+	for _, u := range users {
+		f.users[u.Session] = u
+	}
+
+	w := &waitingRoom{
+		client:     f,
+		lobby:      0,
+		meeting:    1,
+		waiting:    make(map[uint32]Knock),
+		companions: make(map[uint32]uint32),
+	}
+
+	return w, f
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_admitsTheChatWithItsParticipant(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1, Hash: "0a1b2c"}
+	chat := mumble.User{Session: 3, Name: "alice (chat)", UserID: -1, Hash: "0a1b2c"}
+	w, f := newTestWaitingRoom(chat)
+
+	// Until its participant arrives, nothing tells it's really a chat
+	w.userArrived(chat)
+	c.Assert(w.Waiting(), DeepEquals, []Knock{{Session: 3, Username: "alice (chat)"}})
+
+	f.users[alice.Session] = alice
+	w.userArrived(alice)
+
+	c.Assert(w.Waiting(), DeepEquals, []Knock{{Session: 2, Username: "alice"}})
+
+	c.Assert(w.Admit(2), IsNil)
+	c.Assert(f.users[2].ChannelID, Equals, uint32(1))
+	c.Assert(f.users[3].ChannelID, Equals, uint32(1))
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_sendsTheChatAwayWithItsParticipant(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1, Hash: "0a1b2c"}
+	chat := mumble.User{Session: 3, Name: "alice (chat)", UserID: -1, Hash: "0a1b2c"}
+	w, f := newTestWaitingRoom(alice, chat)
+
+	w.userArrived(alice)
+	w.userArrived(chat)
+
+	c.Assert(w.Waiting(), DeepEquals, []Knock{{Session: 2, Username: "alice"}})
+
+	c.Assert(w.Deny(2), IsNil)
+	c.Assert(f.kicked, DeepEquals, []uint32{2, 3})
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_theChatOfAParticipantAlreadyInGoesIn(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1, ChannelID: 1, Hash: "0a1b2c"}
+	chat := mumble.User{Session: 3, Name: "alice (chat)", UserID: -1, Hash: "0a1b2c"}
+	w, f := newTestWaitingRoom(alice, chat)
+
+	w.userArrived(chat)
+
+	c.Assert(w.Waiting(), HasLen, 0)
+	c.Assert(f.users[3].ChannelID, Equals, uint32(1))
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_somebodyWithTheNameOfAChatKnocks(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1, Hash: "0a1b2c"}
+	forged := mumble.User{Session: 3, Name: "alice (chat)", UserID: -1, Hash: "3d4e5f"}
+	anonymous := mumble.User{Session: 4, Name: "alice (chat)", UserID: -1}
+	w, f := newTestWaitingRoom(alice, forged, anonymous)
+
+	w.userArrived(alice)
+	w.userArrived(forged)
+	w.userArrived(anonymous)
+
+	c.Assert(w.Waiting(), HasLen, 3)
+
+	// They don't get in with alice
+	c.Assert(w.Admit(2), IsNil)
+	w.userArrived(f.users[2])
+	c.Assert(f.users[3].ChannelID, Equals, uint32(0))
+	c.Assert(f.users[4].ChannelID, Equals, uint32(0))
+	c.Assert(w.Waiting(), HasLen, 2)
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_onlyTheParticipantsInTheLobbyKnock(c *C) {
+	host := mumble.User{Session: 1, Name: "host", UserID: 0}
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1}
+	bob := mumble.User{Session: 3, Name: "bob", UserID: -1, ChannelID: 1}
+	w, _ := newTestWaitingRoom(host, alice, bob)
+
+	w.userArrived(host)
+	w.userArrived(alice)
+	w.userArrived(bob)
+
+	c.Assert(w.Waiting(), DeepEquals, []Knock{{Session: 2, Username: "alice"}})
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_tellsWhoKnocksAndWhoStopsWaiting(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1}
+	bob := mumble.User{Session: 3, Name: "bob", UserID: -1}
+	w, _ := newTestWaitingRoom(alice, bob)
+
+	w.userArrived(alice)
+
+	knocks := []Knock{}
+	left := []Knock{}
+	w.OnKnock(func(k Knock) { knocks = append(knocks, k) })
+	w.OnLeave(func(k Knock) { left = append(left, k) })
+
+	// Alice was already waiting when somebody started listening
+	c.Assert(knocks, DeepEquals, []Knock{{Session: 2, Username: "alice"}})
+
+	w.userArrived(bob)
+	w.userArrived(bob)
+	c.Assert(knocks, DeepEquals, []Knock{{Session: 2, Username: "alice"}, {Session: 3, Username: "bob"}})
+
+	w.stopWaiting(2)
+	c.Assert(left, DeepEquals, []Knock{{Session: 2, Username: "alice"}})
+	c.Assert(w.Waiting(), DeepEquals, []Knock{{Session: 3, Username: "bob"}})
+
+	// Somebody moved out of the lobby doesn't wait anymore
+	bob.ChannelID = 1
+	w.userArrived(bob)
+	c.Assert(left, DeepEquals, []Knock{{Session: 2, Username: "alice"}, {Session: 3, Username: "bob"}})
+	c.Assert(w.Waiting(), HasLen, 0)
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_Admit_movesTheParticipantIntoTheMeeting(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1}
+	w, f := newTestWaitingRoom(alice)
+	w.userArrived(alice)
+
+	c.Assert(w.Admit(2), IsNil)
+	c.Assert(f.users[2].ChannelID, Equals, uint32(1))
+
+	w.userArrived(f.users[2])
+	c.Assert(w.Admit(2), Equals, errNotWaiting)
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_Deny_kicksTheParticipant(c *C) {
+	alice := mumble.User{Session: 2, Name: "alice", UserID: -1}
+	w, f := newTestWaitingRoom(alice)
+	w.userArrived(alice)
+
+	c.Assert(w.Deny(2), IsNil)
+	c.Assert(f.kicked, DeepEquals, []uint32{2})
+
+	c.Assert(w.Deny(3), Equals, errNotWaiting)
+}
+
+func (s *HostingWaitingRoomSuite) Test_setWaitingRoom_addsTheMeetingBelowTheLobby(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+	setModerator("Wahay-test", "secret")(serv)
+
+	setWaitingRoom(serv)
+
+	lobby := serv.RootChannel()
+	c.Assert(lobby.Name, Equals, lobbyChannelName)
+
+	meeting := serv.Channels[1]
+	c.Assert(meeting.Name, Equals, meetingChannelName)
+	c.Assert(meeting.ACL.InheritACL, Equals, true)
+	c.Assert(meeting.ACL.ACLs[0].Deny, Equals, acl.Permission(acl.EnterPermission))
+
+	c.Assert(serv.Users[0].LastChannelId, Equals, meeting.Id)
+	c.Assert(serv.Users[moderatorUserID].LastChannelId, Equals, meeting.Id)
+}
+
+func (s *HostingWaitingRoomSuite) Test_waitingRoom_recognizesTheChatByTheCertificateOfItsParticipant(c *C) {
+	m, _ := startTestMeeting(c, true, "")
+	defer stopTestMeeting(m)
+
+	cert, _ := testClientCertificate(c)
+	alice, err := joinTestMeeting(m, "alice", "", mumble.Handlers{}, cert)
+	c.Assert(err, IsNil)
+	defer alice.Close()
+
+	c.Assert(waitUntil(func() bool { return len(m.room.waitingRoom.Waiting()) == 1 }), Equals, true)
+
+	// Taking the name of the chat of alice is not enough
+	other, _ := testClientCertificate(c)
+	forged, err := joinTestMeeting(m, "alice (chat)", "", mumble.Handlers{}, other)
+	c.Assert(err, IsNil)
+	c.Assert(waitUntil(func() bool { return len(m.room.waitingRoom.Waiting()) == 2 }), Equals, true)
+	_ = forged.Close()
+	c.Assert(waitUntil(func() bool { return len(m.room.waitingRoom.Waiting()) == 1 }), Equals, true)
+
+	chat, err := joinTestMeeting(m, "alice (chat)", "", mumble.Handlers{}, cert)
+	c.Assert(err, IsNil)
+	defer chat.Close()
+
+	c.Assert(waitUntil(func() bool {
+		_, ok := m.room.moderator.client.User(chat.Session())
+		return ok
+	}), Equals, true)
+	c.Assert(m.room.waitingRoom.Waiting(), DeepEquals, []Knock{{Session: alice.Session(), Username: "alice"}})
+
+	c.Assert(m.room.waitingRoom.Admit(alice.Session()), IsNil)
+
+	meeting, _ := chat.ChannelByName(meetingChannelName)
+	c.Assert(waitUntil(func() bool { return channelOf(chat) == meeting.ID }), Equals, true)
+
+	// Grumble can't be stopped while it's still disconnecting somebody
+	_ = chat.Close()
+	_ = alice.Close()
+	c.Assert(waitUntil(func() bool { return len(m.room.moderator.client.Users()) == 1 }), Equals, true)
+}
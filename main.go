@@ -1,14 +1,16 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/coyim/gotk3adapter/gdka"
 	"github.com/coyim/gotk3adapter/gliba"
 	"github.com/coyim/gotk3adapter/gtka"
+	"github.com/digitalautonomy/wahay/cli"
 	"github.com/digitalautonomy/wahay/config"
 	"github.com/digitalautonomy/wahay/gui"
-	log "github.com/sirupsen/logrus"
 )
 
 // BuildCommit contains which commit the build was based on
@@ -31,23 +33,25 @@ func main() {
 		return
 	}
 
-	initLogging()
+	config.InitLogging()
 
-	runClient()
-}
-
-func initLogging() {
-	log.SetLevel(log.InfoLevel)
-	if *config.Debug {
-		log.SetLevel(log.DebugLevel)
+	// The commands are also in wahay-cli, that can run
+	// where GTK is not installed. See cmd/wahay-cli
+	if code, ok := cli.Execute(flag.Args()); ok {
+		os.Exit(code)
 	}
-	if *config.Trace {
-		log.SetLevel(log.TraceLevel)
-	}
-	log.SetReportCaller(*config.DebugFunctionCalls)
+
+	runClient()
 }
 
 func runClient() {
 	g := gui.CreateGraphics(gtka.Real, gliba.Real, gdka.Real)
-	gui.NewGTK(g).Loop()
+	ui := gui.NewGTK(g)
+
+	// The desktop entry passes the clicked invitation link as the only argument
+	if args := flag.Args(); len(args) == 1 {
+		ui.OpenInvitation(args[0])
+	}
+
+	ui.Loop()
 }
@@ -0,0 +1,684 @@
+// Package mumble implements a minimal client for the control channel of a
+// Mumble server. It doesn't handle voice at all - it is only used to watch and
+// manage the people connected to a meeting.
+package mumble
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	clientVersion    = 0x10300
+	clientRelease    = "Wahay"
+	handshakeTimeout = 2 * time.Minute
+	pingInterval     = 15 * time.Second
+	statsTimeout     = 10 * time.Second
+)
+
+var (
+	// ErrClosed is returned when using a connection that has been closed
+	ErrClosed = errors.New("the connection to the server is closed")
+	// ErrUnknownUser is returned when referring to a session that is not connected
+	ErrUnknownUser = errors.New("the user is not connected")
+	// ErrStatsTimeout is returned when the server doesn't answer a request for statistics
+	ErrStatsTimeout = errors.New("the server didn't send the user statistics")
+)
+
+// RejectedError is returned when the server refuses our authentication
+type RejectedError struct {
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	if e.Reason == "" {
+		return "the server rejected the connection"
+	}
+	return "the server rejected the connection: " + e.Reason
+}
+
+// User is a representation of somebody connected to the server
+type User struct {
+	Session   uint32
+	Name      string
+	ChannelID uint32
+	// UserID is the registration ID of the user, or -1 if the
+	// user is not registered in the server
+	UserID int
+	// Hash is the digest of the certificate the user connected
+	// with, or empty if the user didn't present any
+	Hash string
+	// Muted and Deafened are true both when the user did it
+	// themselves, or when a moderator did it
+	Muted    bool
+	Deafened bool
+}
+
+// CompanionSuffix is added to the name of a participant for the
+// connections Wahay makes on their behalf, like the one of the chat
+const CompanionSuffix = " (chat)"
+
+// ClaimedOwner returns the name of the participant the user says
+// to connect for, or false if the name doesn't say that. Anybody
+// can take any name, so this is not enough to trust the claim
+func (u User) ClaimedOwner() (string, bool) {
+	if !strings.HasSuffix(u.Name, CompanionSuffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(u.Name, CompanionSuffix), true
+}
+
+// IsCompanionOf returns true if the user is a connection made on behalf
+// of the given participant. Besides having the name of the participant,
+// it must use the same client certificate, that nobody else has
+func (u User) IsCompanionOf(p User) bool {
+	owner, ok := u.ClaimedOwner()
+	return ok && u.Hash != "" && u.Hash == p.Hash &&
+		u.Session != p.Session && p.Name == owner
+}
+
+// CompanionOf returns the participant, among the given users, that
+// the user connected for, or false if the user is not a companion
+func (u User) CompanionOf(users []User) (User, bool) {
+	for _, p := range users {
+		if u.IsCompanionOf(p) {
+			return p, true
+		}
+	}
+	return User{}, false
+}
+
+// Channel is a representation of a channel in the server
+type Channel struct {
+	ID     uint32
+	Name   string
+	Parent uint32
+}
+
+// PacketStats counts the voice packets going in one direction
+type PacketStats struct {
+	Good uint32
+	Late uint32
+	Lost uint32
+}
+
+// Loss returns the percentage of packets that never arrived
+func (p PacketStats) Loss() float64 {
+	total := p.Good + p.Late + p.Lost
+	if total == 0 {
+		return 0
+	}
+	return float64(p.Lost) * 100 / float64(total)
+}
+
+// UserStats are the connection statistics of a user, as
+// reported by the user's client to the server
+type UserStats struct {
+	Session uint32
+	// The ping averages are in milliseconds
+	TCPPing float32
+	UDPPing float32
+	// FromClient are the packets the server received from the user,
+	// and FromServer the ones the user received from the server
+	FromClient PacketStats
+	FromServer PacketStats
+}
+
+// Handlers contains the functions that will be called when
+// something happens in the server. All of them are optional
+type Handlers struct {
+	OnUserJoined  func(User)
+	OnUserMoved   func(u User, from uint32)
+	OnUserMuted   func(User)
+	OnUserLeft    func(User)
+	OnTextMessage func(from User, message string)
+	OnDisconnect  func(error)
+}
+
+// Config contains everything needed to connect to a server
+type Config struct {
+	Address      string
+	Username     string
+	Password     string
+	Tokens       []string
+	Certificates []tls.Certificate
+	// Dial will be used to open the connection. If nil, a direct
+	// TCP connection will be used
+	Dial     func(network, address string) (net.Conn, error)
+	Handlers Handlers
+}
+
+// Client represents an authenticated connection to a Mumble server
+type Client interface {
+	Session() uint32
+	Users() []User
+	User(session uint32) (User, bool)
+	Channels() []Channel
+	ChannelByName(name string) (Channel, bool)
+	Move(session, channel uint32) error
+	Kick(session uint32, reason string) error
+	// SendText sends a text message to everyone in the channel
+	SendText(channel uint32, message string) error
+	// Stats asks the server for the connection statistics of a user
+	Stats(session uint32) (UserStats, error)
+	Close() error
+}
+
+// user keeps the separate flags that Mumble sends, since
+// every UserState message only contains what changed
+type user struct {
+	User
+	mute, selfMute, suppress bool
+	deaf, selfDeaf           bool
+}
+
+func (u *user) updateAudioState(m *mumbleproto.UserState) {
+	if m.Mute != nil {
+		u.mute = m.GetMute()
+	}
+	if m.SelfMute != nil {
+		u.selfMute = m.GetSelfMute()
+	}
+	if m.Suppress != nil {
+		u.suppress = m.GetSuppress()
+	}
+	if m.Deaf != nil {
+		u.deaf = m.GetDeaf()
+	}
+	if m.SelfDeaf != nil {
+		u.selfDeaf = m.GetSelfDeaf()
+	}
+
+	u.Muted = u.mute || u.selfMute || u.suppress
+	u.Deafened = u.deaf || u.selfDeaf
+}
+
+type client struct {
+	conn     *tls.Conn
+	handlers Handlers
+
+	writeLock sync.Mutex
+
+	sync.RWMutex
+	session  uint32
+	synced   bool
+	closed   bool
+	users    map[uint32]*user
+	channels map[uint32]*Channel
+	stats    map[uint32][]chan UserStats
+
+	done chan struct{}
+}
+
+// Connect opens a new connection to the server and authenticates with
+// the given configuration. It returns once the server has sent us the
+// full state of the channels and users
+func Connect(conf *Config) (Client, error) {
+	dial := conf.Dial
+	if dial == nil {
+		dial = (&net.Dialer{Timeout: handshakeTimeout}).Dial
+	}
+
+	raw, err := dial("tcp", conf.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The servers we connect to use self signed certificates, and we
+	// always reach them either through loopback or through an onion
+	// service, which already authenticates the other end
+	conn := tls.Client(raw, &tls.Config{
+		// #nosec
+		InsecureSkipVerify: true,
+		Certificates:       conf.Certificates,
+	})
+
+	c := &client{
+		conn:     conn,
+		handlers: conf.Handlers,
+		users:    make(map[uint32]*user),
+		channels: make(map[uint32]*Channel),
+		stats:    make(map[uint32][]chan UserStats),
+		done:     make(chan struct{}),
+	}
+
+	err = c.handshake(conf)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	go c.receive()
+	go c.ping()
+
+	return c, nil
+}
+
+func (c *client) handshake(conf *Config) error {
+	_ = c.conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	defer func() {
+		_ = c.conn.SetDeadline(time.Time{})
+	}()
+
+	err := c.conn.Handshake()
+	if err != nil {
+		return err
+	}
+
+	err = c.send(&mumbleproto.Version{
+		Version: proto.Uint32(clientVersion),
+		Release: proto.String(clientRelease),
+		Os:      proto.String(runtime.GOOS),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.send(&mumbleproto.Authenticate{
+		Username: proto.String(conf.Username),
+		Password: proto.String(conf.Password),
+		Tokens:   conf.Tokens,
+		Opus:     proto.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	for !c.synced {
+		kind, buf, err := readMessage(c.conn)
+		if err != nil {
+			return err
+		}
+
+		err = c.handle(kind, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *client) receive() {
+	var err error
+
+	for err == nil {
+		var kind uint16
+		var buf []byte
+
+		kind, buf, err = readMessage(c.conn)
+		if err == nil {
+			err = c.handle(kind, buf)
+		}
+	}
+
+	c.Lock()
+	alreadyClosed := c.closed
+	c.closed = true
+	c.Unlock()
+
+	close(c.done)
+	_ = c.conn.Close()
+
+	if alreadyClosed {
+		err = nil
+	}
+
+	if c.handlers.OnDisconnect != nil {
+		c.handlers.OnDisconnect(err)
+	}
+}
+
+func (c *client) ping() {
+	t := time.NewTicker(pingInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-t.C:
+			err := c.send(&mumbleproto.Ping{
+				Timestamp: proto.Uint64(uint64(time.Now().Unix())),
+			})
+			if err != nil {
+				log.Debugf("mumble ping: %s", err)
+			}
+		}
+	}
+}
+
+func (c *client) send(msg proto.Message) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	return writeMessage(c.conn, msg)
+}
+
+func (c *client) handle(kind uint16, buf []byte) error {
+	msg, err := decodeMessage(kind, buf)
+	if err != nil || msg == nil {
+		return err
+	}
+
+	switch m := msg.(type) {
+	case *mumbleproto.Reject:
+		return &RejectedError{Reason: m.GetReason()}
+	case *mumbleproto.ServerSync:
+		c.handleServerSync(m)
+	case *mumbleproto.ChannelState:
+		c.handleChannelState(m)
+	case *mumbleproto.ChannelRemove:
+		c.Lock()
+		delete(c.channels, m.GetChannelId())
+		c.Unlock()
+	case *mumbleproto.UserState:
+		c.handleUserState(m)
+	case *mumbleproto.UserRemove:
+		c.handleUserRemove(m)
+	case *mumbleproto.TextMessage:
+		c.handleTextMessage(m)
+	case *mumbleproto.UserStats:
+		c.handleUserStats(m)
+	case *mumbleproto.PermissionDenied:
+		log.Debugf("mumble: permission denied: %s", m.GetReason())
+	}
+
+	return nil
+}
+
+func (c *client) handleServerSync(m *mumbleproto.ServerSync) {
+	c.Lock()
+	c.session = m.GetSession()
+	c.synced = true
+	var existing []User
+	for _, u := range c.users {
+		if u.Session != c.session {
+			existing = append(existing, u.User)
+		}
+	}
+	c.Unlock()
+
+	if c.handlers.OnUserJoined != nil {
+		for _, u := range existing {
+			c.handlers.OnUserJoined(u)
+		}
+	}
+}
+
+func (c *client) handleChannelState(m *mumbleproto.ChannelState) {
+	c.Lock()
+	defer c.Unlock()
+
+	ch, ok := c.channels[m.GetChannelId()]
+	if !ok {
+		ch = &Channel{ID: m.GetChannelId()}
+		c.channels[ch.ID] = ch
+	}
+
+	if m.Name != nil {
+		ch.Name = m.GetName()
+	}
+
+	if m.Parent != nil {
+		ch.Parent = m.GetParent()
+	}
+}
+
+func (c *client) handleUserState(m *mumbleproto.UserState) {
+	c.Lock()
+	u, existed := c.users[m.GetSession()]
+	if !existed {
+		u = &user{User: User{Session: m.GetSession(), UserID: -1}}
+		c.users[u.Session] = u
+	}
+
+	from := u.ChannelID
+	wasMuted, wasDeafened := u.Muted, u.Deafened
+
+	if m.Name != nil {
+		u.Name = m.GetName()
+	}
+
+	if m.UserId != nil {
+		u.UserID = int(m.GetUserId())
+	}
+
+	if m.ChannelId != nil {
+		u.ChannelID = m.GetChannelId()
+	}
+
+	if m.Hash != nil {
+		u.Hash = m.GetHash()
+	}
+
+	u.updateAudioState(m)
+
+	current := u.User
+	notify := c.synced && u.Session != c.session
+	c.Unlock()
+
+	if !notify {
+		return
+	}
+
+	switch {
+	case !existed:
+		if c.handlers.OnUserJoined != nil {
+			c.handlers.OnUserJoined(current)
+		}
+	case from != current.ChannelID:
+		if c.handlers.OnUserMoved != nil {
+			c.handlers.OnUserMoved(current, from)
+		}
+	}
+
+	if existed && (wasMuted != current.Muted || wasDeafened != current.Deafened) {
+		if c.handlers.OnUserMuted != nil {
+			c.handlers.OnUserMuted(current)
+		}
+	}
+}
+
+func (c *client) handleUserRemove(m *mumbleproto.UserRemove) {
+	c.Lock()
+	u, ok := c.users[m.GetSession()]
+	delete(c.users, m.GetSession())
+	delete(c.stats, m.GetSession())
+	c.Unlock()
+
+	if ok && c.handlers.OnUserLeft != nil {
+		c.handlers.OnUserLeft(u.User)
+	}
+}
+
+func (c *client) handleTextMessage(m *mumbleproto.TextMessage) {
+	if c.handlers.OnTextMessage == nil {
+		return
+	}
+
+	from, _ := c.User(m.GetActor())
+	c.handlers.OnTextMessage(from, m.GetMessage())
+}
+
+func packetStatsFrom(m *mumbleproto.UserStats_Stats) PacketStats {
+	return PacketStats{
+		Good: m.GetGood(),
+		Late: m.GetLate(),
+		Lost: m.GetLost(),
+	}
+}
+
+func (c *client) handleUserStats(m *mumbleproto.UserStats) {
+	stats := UserStats{
+		Session:    m.GetSession(),
+		TCPPing:    m.GetTcpPingAvg(),
+		UDPPing:    m.GetUdpPingAvg(),
+		FromClient: packetStatsFrom(m.GetFromClient()),
+		FromServer: packetStatsFrom(m.GetFromServer()),
+	}
+
+	c.Lock()
+	waiting := c.stats[stats.Session]
+	delete(c.stats, stats.Session)
+	c.Unlock()
+
+	for _, w := range waiting {
+		w <- stats
+	}
+}
+
+func (c *client) Session() uint32 {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.session
+}
+
+func (c *client) Users() []User {
+	c.RLock()
+	defer c.RUnlock()
+
+	result := make([]User, 0, len(c.users))
+	for _, u := range c.users {
+		result = append(result, u.User)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Session < result[j].Session
+	})
+
+	return result
+}
+
+func (c *client) User(session uint32) (User, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	u, ok := c.users[session]
+	if !ok {
+		return User{}, false
+	}
+
+	return u.User, true
+}
+
+func (c *client) Channels() []Channel {
+	c.RLock()
+	defer c.RUnlock()
+
+	result := make([]Channel, 0, len(c.channels))
+	for _, ch := range c.channels {
+		result = append(result, *ch)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (c *client) ChannelByName(name string) (Channel, bool) {
+	for _, ch := range c.Channels() {
+		if ch.Name == name {
+			return ch, true
+		}
+	}
+	return Channel{}, false
+}
+
+func (c *client) Move(session, channel uint32) error {
+	if _, ok := c.User(session); !ok {
+		return ErrUnknownUser
+	}
+
+	return c.sendIfOpen(&mumbleproto.UserState{
+		Session:   proto.Uint32(session),
+		ChannelId: proto.Uint32(channel),
+	})
+}
+
+func (c *client) Kick(session uint32, reason string) error {
+	if _, ok := c.User(session); !ok {
+		return ErrUnknownUser
+	}
+
+	return c.sendIfOpen(&mumbleproto.UserRemove{
+		Session: proto.Uint32(session),
+		Reason:  proto.String(reason),
+	})
+}
+
+func (c *client) SendText(channel uint32, message string) error {
+	return c.sendIfOpen(&mumbleproto.TextMessage{
+		ChannelId: []uint32{channel},
+		Message:   proto.String(message),
+	})
+}
+
+func (c *client) Stats(session uint32) (UserStats, error) {
+	if _, ok := c.User(session); !ok {
+		return UserStats{}, ErrUnknownUser
+	}
+
+	// The channel is buffered, so the answer never blocks
+	// the receiving goroutine if we already gave up
+	result := make(chan UserStats, 1)
+
+	c.Lock()
+	c.stats[session] = append(c.stats[session], result)
+	c.Unlock()
+
+	err := c.sendIfOpen(&mumbleproto.UserStats{
+		Session:   proto.Uint32(session),
+		StatsOnly: proto.Bool(true),
+	})
+	if err != nil {
+		return UserStats{}, err
+	}
+
+	select {
+	case s := <-result:
+		return s, nil
+	case <-c.done:
+		return UserStats{}, ErrClosed
+	case <-time.After(statsTimeout):
+		return UserStats{}, ErrStatsTimeout
+	}
+}
+
+func (c *client) sendIfOpen(msg proto.Message) error {
+	c.RLock()
+	closed := c.closed
+	c.RUnlock()
+
+	if closed {
+		return ErrClosed
+	}
+
+	return c.send(msg)
+}
+
+func (c *client) Close() error {
+	c.Lock()
+	if c.closed {
+		c.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.Unlock()
+
+	return c.conn.Close()
+}
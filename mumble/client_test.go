@@ -0,0 +1,304 @@
+package mumble
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+type MumbleClientSuite struct{}
+
+var _ = Suite(&MumbleClientSuite{})
+
+func (s *MumbleClientSuite) Test_PacketStats_Loss_isAPercentageOfAllPackets(c *C) {
+	c.Assert(PacketStats{Good: 90, Late: 5, Lost: 5}.Loss(), Equals, float64(5))
+}
+
+func (s *MumbleClientSuite) Test_PacketStats_Loss_isZeroWithoutPackets(c *C) {
+	c.Assert(PacketStats{}.Loss(), Equals, float64(0))
+}
+
+func (s *MumbleClientSuite) Test_User_ClaimedOwner_returnsTheNameOfTheParticipant(c *C) {
+	owner, ok := User{Name: "alice (chat)"}.ClaimedOwner()
+	c.Assert(ok, Equals, true)
+	c.Assert(owner, Equals, "alice")
+
+	_, ok = User{Name: "alice"}.ClaimedOwner()
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MumbleClientSuite) Test_User_CompanionOf_needsTheCertificateOfTheParticipant(c *C) {
+	alice := User{Session: 1, Name: "alice", Hash: "0a1b2c"}
+	bob := User{Session: 2, Name: "bob", Hash: "3d4e5f"}
+	users := []User{alice, bob}
+
+	owner, ok := User{Session: 3, Name: "alice (chat)", Hash: "0a1b2c"}.CompanionOf(users)
+	c.Assert(ok, Equals, true)
+	c.Assert(owner, DeepEquals, alice)
+
+	_, ok = User{Session: 3, Name: "alice (chat)", Hash: "3d4e5f"}.CompanionOf(users)
+	c.Assert(ok, Equals, false)
+
+	_, ok = User{Session: 3, Name: "alice (chat)"}.CompanionOf([]User{{Session: 1, Name: "alice"}})
+	c.Assert(ok, Equals, false)
+
+	_, ok = User{Session: 3, Name: "carol (chat)", Hash: "0a1b2c"}.CompanionOf(users)
+	c.Assert(ok, Equals, false)
+}
+
+// fakeServer is the other end of a connection made by the client.
+// It answers the TLS handshake with a self-signed certificate
+type fakeServer struct {
+	conn net.Conn
+	auth *mumbleproto.Authenticate
+}
+
+func fakeServerCertificate() tls.Certificate {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// connectToFakeServer connects a client to a fake server that sends
+// the given messages after the authentication, followed by ServerSync
+func connectToFakeServer(c *C, session uint32, h Handlers, state ...proto.Message) (Client, *fakeServer) {
+	clientConn, serverConn := net.Pipe()
+	server := &fakeServer{
+		conn: tls.Server(serverConn, &tls.Config{Certificates: []tls.Certificate{fakeServerCertificate()}}),
+	}
+
+	go func() {
+		_, _, _ = readMessage(server.conn)
+		_, data, _ := readMessage(server.conn)
+
+		server.auth = &mumbleproto.Authenticate{}
+		_ = proto.Unmarshal(data, server.auth)
+
+		for _, m := range state {
+			server.send(m)
+		}
+		server.send(&mumbleproto.ServerSync{Session: proto.Uint32(session)})
+	}()
+
+	cl, err := Connect(&Config{
+		Address:  "meeting:64738",
+		Username: "someone",
+		Password: "secret",
+		Dial: func(network, address string) (net.Conn, error) {
+			return clientConn, nil
+		},
+		Handlers: h,
+	})
+	c.Assert(err, IsNil)
+
+	return cl, server
+}
+
+// stop closes the connection before the client does, so
+// the client doesn't wait for anybody to read its goodbye
+func (s *fakeServer) stop() {
+	_ = s.conn.Close()
+}
+
+// discard reads everything the client sends from now on
+func (s *fakeServer) discard() {
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, s.conn)
+	}()
+}
+
+func (s *fakeServer) send(m proto.Message) {
+	_ = writeMessage(s.conn, m)
+}
+
+func (s *fakeServer) receive() proto.Message {
+	kind, data, err := readMessage(s.conn)
+	if err != nil {
+		return nil
+	}
+
+	// Pings can arrive at any moment
+	if kind == mumbleproto.MessagePing {
+		return s.receive()
+	}
+
+	m := newMessageFor(kind)
+	if m == nil {
+		return nil
+	}
+	_ = proto.Unmarshal(data, m)
+
+	return m
+}
+
+func testServerState() []proto.Message {
+	return []proto.Message{
+		&mumbleproto.ChannelState{ChannelId: proto.Uint32(0), Name: proto.String("Lobby")},
+		&mumbleproto.ChannelState{ChannelId: proto.Uint32(1), Name: proto.String("Meeting"), Parent: proto.Uint32(0)},
+		&mumbleproto.UserState{Session: proto.Uint32(1), Name: proto.String("Wahay"), UserId: proto.Uint32(7), ChannelId: proto.Uint32(1)},
+		&mumbleproto.UserState{Session: proto.Uint32(2), Name: proto.String("alice"), ChannelId: proto.Uint32(0), Hash: proto.String("0a1b2c")},
+	}
+}
+
+func (s *MumbleClientSuite) Test_Connect_authenticatesAndReadsTheStateOfTheServer(c *C) {
+	joined := make(chan User, 1)
+	cl, server := connectToFakeServer(c, 1, Handlers{OnUserJoined: func(u User) { joined <- u }}, testServerState()...)
+	defer cl.Close()
+	defer server.stop()
+
+	c.Assert(server.auth.GetUsername(), Equals, "someone")
+	c.Assert(server.auth.GetPassword(), Equals, "secret")
+
+	c.Assert(cl.Session(), Equals, uint32(1))
+	c.Assert(cl.Channels(), DeepEquals, []Channel{{ID: 0, Name: "Lobby"}, {ID: 1, Name: "Meeting", Parent: 0}})
+
+	meeting, ok := cl.ChannelByName("Meeting")
+	c.Assert(ok, Equals, true)
+	c.Assert(meeting.ID, Equals, uint32(1))
+
+	me, ok := cl.User(1)
+	c.Assert(ok, Equals, true)
+	c.Assert(me.UserID, Equals, 7)
+	c.Assert(cl.Users(), HasLen, 2)
+
+	// The people that were already there are given to the handlers, but not us
+	c.Assert(<-joined, DeepEquals, User{Session: 2, Name: "alice", UserID: -1, Hash: "0a1b2c"})
+}
+
+func (s *MumbleClientSuite) Test_Connect_returnsTheReasonWhenTheServerRejectsUs(c *C) {
+	clientConn, serverConn := net.Pipe()
+	server := tls.Server(serverConn, &tls.Config{Certificates: []tls.Certificate{fakeServerCertificate()}})
+
+	go func() {
+		_, _, _ = readMessage(server)
+		_, _, _ = readMessage(server)
+		_ = writeMessage(server, &mumbleproto.Reject{Reason: proto.String("Invalid server password")})
+		_, _ = io.Copy(ioutil.Discard, server)
+	}()
+
+	_, err := Connect(&Config{
+		Address: "meeting:64738",
+		Dial: func(network, address string) (net.Conn, error) {
+			return clientConn, nil
+		},
+	})
+
+	c.Assert(err, DeepEquals, &RejectedError{Reason: "Invalid server password"})
+	c.Assert(err, ErrorMatches, "the server rejected the connection: Invalid server password")
+}
+
+func (s *MumbleClientSuite) Test_client_tellsTheHandlersWhatHappens(c *C) {
+	events := make(chan string, 10)
+	h := Handlers{
+		OnUserJoined: func(u User) { events <- "joined " + u.Name },
+		OnUserMoved:  func(u User, from uint32) { events <- fmt.Sprintf("moved %s from %d to %d", u.Name, from, u.ChannelID) },
+		OnUserMuted:  func(u User) { events <- fmt.Sprintf("muted %s %v %v", u.Name, u.Muted, u.Deafened) },
+		OnUserLeft:   func(u User) { events <- "left " + u.Name },
+		OnTextMessage: func(from User, message string) {
+			events <- fmt.Sprintf("%s says %s", from.Name, message)
+		},
+		OnDisconnect: func(err error) { events <- "disconnected" },
+	}
+
+	cl, server := connectToFakeServer(c, 1, h, testServerState()...)
+	defer cl.Close()
+	defer server.stop()
+	c.Assert(<-events, Equals, "joined alice")
+
+	server.send(&mumbleproto.UserState{Session: proto.Uint32(3), Name: proto.String("bob"), ChannelId: proto.Uint32(0)})
+	c.Assert(<-events, Equals, "joined bob")
+
+	server.send(&mumbleproto.UserState{Session: proto.Uint32(2), ChannelId: proto.Uint32(1)})
+	c.Assert(<-events, Equals, "moved alice from 0 to 1")
+
+	server.send(&mumbleproto.UserState{Session: proto.Uint32(2), SelfMute: proto.Bool(true)})
+	c.Assert(<-events, Equals, "muted alice true false")
+
+	server.send(&mumbleproto.UserState{Session: proto.Uint32(2), Deaf: proto.Bool(true)})
+	c.Assert(<-events, Equals, "muted alice true true")
+
+	server.send(&mumbleproto.TextMessage{Actor: proto.Uint32(3), Message: proto.String("hello")})
+	c.Assert(<-events, Equals, "bob says hello")
+
+	server.send(&mumbleproto.UserRemove{Session: proto.Uint32(3)})
+	c.Assert(<-events, Equals, "left bob")
+
+	_, ok := cl.User(3)
+	c.Assert(ok, Equals, false)
+
+	_ = server.conn.Close()
+	c.Assert(<-events, Equals, "disconnected")
+}
+
+func (s *MumbleClientSuite) Test_client_movesAndKicksUsers(c *C) {
+	cl, server := connectToFakeServer(c, 1, Handlers{}, testServerState()...)
+	defer cl.Close()
+	defer server.stop()
+
+	go func() {
+		c.Check(cl.Move(2, 1), IsNil)
+	}()
+	move := server.receive().(*mumbleproto.UserState)
+	c.Assert(move.GetSession(), Equals, uint32(2))
+	c.Assert(move.GetChannelId(), Equals, uint32(1))
+
+	go func() {
+		c.Check(cl.Kick(2, "go away"), IsNil)
+	}()
+	kick := server.receive().(*mumbleproto.UserRemove)
+	c.Assert(kick.GetSession(), Equals, uint32(2))
+	c.Assert(kick.GetReason(), Equals, "go away")
+
+	c.Assert(cl.Move(42, 1), Equals, ErrUnknownUser)
+	c.Assert(cl.Kick(42, ""), Equals, ErrUnknownUser)
+}
+
+func (s *MumbleClientSuite) Test_client_Stats_waitsForTheAnswerOfTheServer(c *C) {
+	cl, server := connectToFakeServer(c, 1, Handlers{}, testServerState()...)
+	defer cl.Close()
+	defer server.stop()
+
+	go func() {
+		req := server.receive().(*mumbleproto.UserStats)
+		server.send(&mumbleproto.UserStats{
+			Session:    req.Session,
+			TcpPingAvg: proto.Float32(250),
+			FromServer: &mumbleproto.UserStats_Stats{Good: proto.Uint32(95), Lost: proto.Uint32(5)},
+		})
+	}()
+
+	stats, err := cl.Stats(2)
+	c.Assert(err, IsNil)
+	c.Assert(stats.Session, Equals, uint32(2))
+	c.Assert(stats.TCPPing, Equals, float32(250))
+	c.Assert(stats.FromServer.Loss(), Equals, float64(5))
+
+	_, err = cl.Stats(42)
+	c.Assert(err, Equals, ErrUnknownUser)
+}
+
+func (s *MumbleClientSuite) Test_client_cantBeUsedAfterClosingIt(c *C) {
+	cl, server := connectToFakeServer(c, 1, Handlers{}, testServerState()...)
+	server.discard()
+
+	c.Assert(cl.Close(), IsNil)
+	c.Assert(cl.Move(2, 1), Equals, ErrClosed)
+}
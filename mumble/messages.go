@@ -0,0 +1,100 @@
+package mumble
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+)
+
+// maxMessageSize is the biggest control message we are willing to read.
+// Mumble itself refuses messages bigger than 8MB
+const maxMessageSize = 8 * 1024 * 1024
+
+var errMessageTooBig = errors.New("the received message is too big")
+
+// Every message on the control channel is prefixed by a 2 bytes type
+// and a 4 bytes length, both in network byte order
+func writeMessage(w io.Writer, msg proto.Message) error {
+	buf, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, 6)
+	binary.BigEndian.PutUint16(header[0:], mumbleproto.MessageType(msg))
+	binary.BigEndian.PutUint32(header[2:], uint32(len(buf)))
+
+	_, err = w.Write(append(header, buf...))
+	return err
+}
+
+func readMessage(r io.Reader) (uint16, []byte, error) {
+	header := make([]byte, 6)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	kind := binary.BigEndian.Uint16(header[0:])
+	size := binary.BigEndian.Uint32(header[2:])
+	if size > maxMessageSize {
+		return 0, nil, errMessageTooBig
+	}
+
+	buf := make([]byte, size)
+	_, err = io.ReadFull(r, buf)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return kind, buf, nil
+}
+
+// newMessageFor returns an empty message for the given wire type, or nil
+// if it is a message we don't care about
+func newMessageFor(kind uint16) proto.Message {
+	switch kind {
+	case mumbleproto.MessageVersion:
+		return &mumbleproto.Version{}
+	case mumbleproto.MessagePing:
+		return &mumbleproto.Ping{}
+	case mumbleproto.MessageReject:
+		return &mumbleproto.Reject{}
+	case mumbleproto.MessageServerSync:
+		return &mumbleproto.ServerSync{}
+	case mumbleproto.MessageChannelRemove:
+		return &mumbleproto.ChannelRemove{}
+	case mumbleproto.MessageChannelState:
+		return &mumbleproto.ChannelState{}
+	case mumbleproto.MessageUserRemove:
+		return &mumbleproto.UserRemove{}
+	case mumbleproto.MessageUserState:
+		return &mumbleproto.UserState{}
+	case mumbleproto.MessageTextMessage:
+		return &mumbleproto.TextMessage{}
+	case mumbleproto.MessagePermissionDenied:
+		return &mumbleproto.PermissionDenied{}
+	case mumbleproto.MessageUserStats:
+		return &mumbleproto.UserStats{}
+	case mumbleproto.MessageServerConfig:
+		return &mumbleproto.ServerConfig{}
+	}
+	return nil
+}
+
+func decodeMessage(kind uint16, buf []byte) (proto.Message, error) {
+	msg := newMessageFor(kind)
+	if msg == nil {
+		return nil, nil
+	}
+
+	err := proto.Unmarshal(buf, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,58 @@
+package mumble
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MumbleMessagesSuite struct{}
+
+var _ = Suite(&MumbleMessagesSuite{})
+
+func (s *MumbleMessagesSuite) Test_writeMessage_prefixesTheTypeAndLength(c *C) {
+	var buf bytes.Buffer
+
+	err := writeMessage(&buf, &mumbleproto.UserRemove{Session: proto.Uint32(42)})
+	c.Assert(err, IsNil)
+	c.Assert(buf.Bytes()[:6], DeepEquals, []byte{0x00, 0x08, 0x00, 0x00, 0x00, 0x02})
+}
+
+func (s *MumbleMessagesSuite) Test_readMessage_decodesWhatWasWritten(c *C) {
+	var buf bytes.Buffer
+
+	_ = writeMessage(&buf, &mumbleproto.UserState{
+		Session:   proto.Uint32(3),
+		Name:      proto.String("someone"),
+		ChannelId: proto.Uint32(1),
+	})
+
+	kind, data, err := readMessage(&buf)
+	c.Assert(err, IsNil)
+	c.Assert(kind, Equals, mumbleproto.MessageUserState)
+
+	msg, err := decodeMessage(kind, data)
+	c.Assert(err, IsNil)
+	us := msg.(*mumbleproto.UserState)
+	c.Assert(us.GetSession(), Equals, uint32(3))
+	c.Assert(us.GetName(), Equals, "someone")
+	c.Assert(us.GetChannelId(), Equals, uint32(1))
+}
+
+func (s *MumbleMessagesSuite) Test_readMessage_refusesMessagesThatAreTooBig(c *C) {
+	buf := bytes.NewBuffer([]byte{0x00, 0x09, 0x7f, 0xff, 0xff, 0xff})
+
+	_, _, err := readMessage(buf)
+	c.Assert(err, Equals, errMessageTooBig)
+}
+
+func (s *MumbleMessagesSuite) Test_decodeMessage_ignoresUnknownMessages(c *C) {
+	msg, err := decodeMessage(mumbleproto.MessageUDPTunnel, []byte{0x01, 0x02})
+	c.Assert(err, IsNil)
+	c.Assert(msg, IsNil)
+}
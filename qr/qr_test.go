@@ -0,0 +1,90 @@
+package qr
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type QRSuite struct{}
+
+var _ = Suite(&QRSuite{})
+
+func (s *QRSuite) Test_reedSolomonRemainder_calculatesTheErrorCorrection(c *C) {
+	// The codewords of "HELLO WORLD" in a 1-M code
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+
+	ecc := reedSolomonRemainder(data, reedSolomonDivisor(10))
+
+	c.Assert(ecc, DeepEquals, []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23})
+}
+
+func (s *QRSuite) Test_formatBits_matchesTheStandardTable(c *C) {
+	c.Assert(formatBits(0), Equals, 0x5412)
+	c.Assert(formatBits(5), Equals, 0x40CE)
+	c.Assert(formatBits(7), Equals, 0x4AA0)
+}
+
+func (s *QRSuite) Test_versionBits_matchesTheStandardTable(c *C) {
+	c.Assert(versionBits(7), Equals, 0x07C94)
+	c.Assert(versionBits(20), Equals, 0x149A6)
+}
+
+func (s *QRSuite) Test_alignmentPatternPositions(c *C) {
+	c.Assert(alignmentPatternPositions(1, 21), HasLen, 0)
+	c.Assert(alignmentPatternPositions(2, 25), DeepEquals, []int{6, 18})
+	c.Assert(alignmentPatternPositions(7, 45), DeepEquals, []int{6, 22, 38})
+	c.Assert(alignmentPatternPositions(14, 73), DeepEquals, []int{6, 26, 46, 66})
+}
+
+func (s *QRSuite) Test_Encode_usesTheSmallestVersionThatFits(c *C) {
+	code, err := Encode("hello")
+	c.Assert(err, IsNil)
+	c.Assert(code.Size, Equals, 21)
+
+	code, err = Encode(strings.Repeat("a", 14))
+	c.Assert(err, IsNil)
+	c.Assert(code.Size, Equals, 21)
+
+	code, err = Encode(strings.Repeat("a", 15))
+	c.Assert(err, IsNil)
+	c.Assert(code.Size, Equals, 25)
+}
+
+func (s *QRSuite) Test_Encode_failsWhenTheTextIsTooLong(c *C) {
+	_, err := Encode(strings.Repeat("a", capacity(maxVersion)+1))
+
+	c.Assert(err, Equals, ErrTooLong)
+}
+
+func (s *QRSuite) Test_Encode_drawsTheFinderPatterns(c *C) {
+	code, _ := Encode("qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion")
+
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		x, y := corner[0], corner[1]
+		c.Assert(code.Dark(x, y), Equals, true)
+		c.Assert(code.Dark(x+1, y+1), Equals, false)
+		c.Assert(code.Dark(x+3, y+3), Equals, true)
+	}
+}
+
+func (s *QRSuite) Test_Code_PNG_includesTheQuietZone(c *C) {
+	code, _ := Encode("hello")
+
+	data, err := code.PNG(3)
+	c.Assert(err, IsNil)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	c.Assert(err, IsNil)
+	c.Assert(img.Bounds().Dx(), Equals, (21+8)*3)
+
+	r, _, _, _ := img.At(0, 0).RGBA()
+	c.Assert(r, Equals, uint32(0xffff))
+	r, _, _, _ = img.At(4*3, 4*3).RGBA()
+	c.Assert(r, Equals, uint32(0))
+}
@@ -0,0 +1,519 @@
+package sqlite
+
+import (
+	"encoding/binary"
+)
+
+// The types of the b-tree pages
+const (
+	interiorIndexPage = 2
+	interiorTablePage = 5
+	leafIndexPage     = 10
+	leafTablePage     = 13
+
+	leafHeaderSize     = 8
+	interiorHeaderSize = 12
+)
+
+type cell struct {
+	left     int
+	rowID    int64
+	payload  []byte
+	overflow []int
+}
+
+// The most payload that a cell keeps in its own page.
+// We never write bigger cells, but we can read them
+func (db *Database) maxLocalTable() int {
+	return db.usable - 35
+}
+
+func (db *Database) maxLocalIndex() int {
+	return (db.usable-12)*64/255 - 23
+}
+
+func (db *Database) page(n int) ([]byte, error) {
+	if n < 1 || n > len(db.pages) {
+		return nil, ErrCorrupt
+	}
+	return db.pages[n-1], nil
+}
+
+// walk visits the tree that starts at the given page in order, and
+// returns all the pages that it uses, including the overflow pages
+func (db *Database) walk(root int, visit func(pageType byte, c cell)) ([]int, error) {
+	var pages []int
+	seen := make(map[int]bool)
+
+	var walkPage func(n int) error
+	walkPage = func(n int) error {
+		p, err := db.page(n)
+		if err != nil || seen[n] {
+			return ErrCorrupt
+		}
+
+		seen[n] = true
+		pages = append(pages, n)
+
+		offset := 0
+		if n == 1 {
+			offset = headerSize
+		}
+
+		pageType := p[offset]
+		size := leafHeaderSize
+		switch pageType {
+		case interiorIndexPage, interiorTablePage:
+			size = interiorHeaderSize
+		case leafIndexPage, leafTablePage:
+		default:
+			return ErrCorrupt
+		}
+
+		count := int(binary.BigEndian.Uint16(p[offset+3:]))
+		if offset+size+2*count > db.usable {
+			return ErrCorrupt
+		}
+
+		for i := 0; i < count; i++ {
+			start := int(binary.BigEndian.Uint16(p[offset+size+2*i:]))
+			if start >= db.usable {
+				return ErrCorrupt
+			}
+
+			c, err := db.readCell(pageType, p[start:db.usable])
+			if err != nil {
+				return err
+			}
+
+			pages = append(pages, c.overflow...)
+
+			if size == interiorHeaderSize {
+				if err := walkPage(c.left); err != nil {
+					return err
+				}
+			}
+
+			if pageType != interiorTablePage && visit != nil {
+				visit(pageType, c)
+			}
+		}
+
+		if size == interiorHeaderSize {
+			return walkPage(int(binary.BigEndian.Uint32(p[offset+8:])))
+		}
+
+		return nil
+	}
+
+	err := walkPage(root)
+	return pages, err
+}
+
+func (db *Database) readCell(pageType byte, b []byte) (cell, error) {
+	var c cell
+
+	if pageType == interiorTablePage || pageType == interiorIndexPage {
+		if len(b) < 4 {
+			return c, ErrCorrupt
+		}
+		c.left = int(binary.BigEndian.Uint32(b))
+		b = b[4:]
+	}
+
+	if pageType == interiorTablePage {
+		rowID, n := readVarint(b)
+		if n == 0 {
+			return c, ErrCorrupt
+		}
+		c.rowID = int64(rowID)
+		return c, nil
+	}
+
+	size, n := readVarint(b)
+	if n == 0 {
+		return c, ErrCorrupt
+	}
+	b = b[n:]
+
+	maxLocal := db.maxLocalIndex()
+	if pageType == leafTablePage {
+		rowID, n := readVarint(b)
+		if n == 0 {
+			return c, ErrCorrupt
+		}
+		c.rowID = int64(rowID)
+		b = b[n:]
+		maxLocal = db.maxLocalTable()
+	}
+
+	var err error
+	c.payload, c.overflow, err = db.readPayload(b, int(size), maxLocal)
+
+	return c, err
+}
+
+// readPayload returns the payload of a cell, following the
+// overflow pages for the part that doesn't fit in the cell
+func (db *Database) readPayload(b []byte, size, maxLocal int) ([]byte, []int, error) {
+	local := db.localSize(size, maxLocal)
+	if local == size {
+		if len(b) < size {
+			return nil, nil, ErrCorrupt
+		}
+		return b[:size], nil, nil
+	}
+
+	if len(b) < local+4 {
+		return nil, nil, ErrCorrupt
+	}
+
+	payload := append([]byte{}, b[:local]...)
+	next := int(binary.BigEndian.Uint32(b[local:]))
+
+	var overflow []int
+	for len(payload) < size {
+		p, err := db.page(next)
+		if err != nil || len(overflow) > len(db.pages) {
+			return nil, nil, ErrCorrupt
+		}
+
+		overflow = append(overflow, next)
+
+		chunk := p[4:db.usable]
+		if rest := size - len(payload); rest < len(chunk) {
+			chunk = chunk[:rest]
+		}
+
+		payload = append(payload, chunk...)
+		next = int(binary.BigEndian.Uint32(p))
+	}
+
+	return payload, overflow, nil
+}
+
+func (db *Database) localSize(size, maxLocal int) int {
+	if size <= maxLocal {
+		return size
+	}
+
+	minLocal := (db.usable-12)*32/255 - 23
+	k := minLocal + (size-minLocal)%(db.usable-4)
+	if k <= maxLocal {
+		return k
+	}
+
+	return minLocal
+}
+
+func (db *Database) readTable(root int) ([]Row, error) {
+	var rows []Row
+	var err error
+
+	_, walkErr := db.walk(root, func(pageType byte, c cell) {
+		if pageType != leafTablePage {
+			err = ErrCorrupt
+			return
+		}
+
+		values, e := decodeRecord(c.payload)
+		if e != nil {
+			err = e
+			return
+		}
+
+		rows = append(rows, Row{ID: c.rowID, Values: values})
+	})
+
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return rows, err
+}
+
+func (db *Database) readIndex(root int) ([][]interface{}, error) {
+	var entries [][]interface{}
+	var err error
+
+	_, walkErr := db.walk(root, func(pageType byte, c cell) {
+		if pageType != leafIndexPage && pageType != interiorIndexPage {
+			err = ErrCorrupt
+			return
+		}
+
+		values, e := decodeRecord(c.payload)
+		if e != nil {
+			err = e
+			return
+		}
+
+		entries = append(entries, values)
+	})
+
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return entries, err
+}
+
+// node is a page of a tree that is being built. The divider is what
+// its parent keeps after the pointer to it: the biggest rowid for
+// tables, and the entry that follows the page for indexes
+type node struct {
+	page    int
+	divider []byte
+}
+
+// treeBuilder writes a new tree over the pages of an old one,
+// adding pages to the file when they are not enough
+type treeBuilder struct {
+	db     *Database
+	root   int
+	unused []int
+}
+
+func (db *Database) newTreeBuilder(root int) (*treeBuilder, error) {
+	if root <= 1 {
+		return nil, ErrUnsupported
+	}
+
+	pages, err := db.walk(root, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &treeBuilder{db: db, root: root, unused: pages[1:]}, nil
+}
+
+func (tb *treeBuilder) allocate() int {
+	if len(tb.unused) > 0 {
+		n := tb.unused[0]
+		tb.unused = tb.unused[1:]
+		return n
+	}
+
+	tb.db.pages = append(tb.db.pages, make([]byte, tb.db.pageSize))
+	return len(tb.db.pages)
+}
+
+func (tb *treeBuilder) fits(headerSize int, cells [][]byte, extra []byte) bool {
+	used := headerSize + 2 + len(extra)
+	for _, c := range cells {
+		used += 2 + len(c)
+	}
+	return used <= tb.db.usable
+}
+
+// rebuildTable replaces the tree of a table with one that has
+// the given leaf cells, which are sorted by their rowid
+func (db *Database) rebuildTable(root int, cells [][]byte, ids []int64) error {
+	tb, err := db.newTreeBuilder(root)
+	if err != nil {
+		return err
+	}
+
+	var groups [][][]byte
+	var dividers [][]byte
+	var current [][]byte
+
+	for i, c := range cells {
+		if len(current) > 0 && !tb.fits(leafHeaderSize, current, c) {
+			groups = append(groups, current)
+			dividers = append(dividers, appendVarint(nil, uint64(ids[i-1])))
+			current = nil
+		}
+		current = append(current, c)
+	}
+
+	groups = append(groups, current)
+	if len(ids) > 0 {
+		dividers = append(dividers, appendVarint(nil, uint64(ids[len(ids)-1])))
+	} else {
+		dividers = append(dividers, nil)
+	}
+
+	return tb.finish(leafTablePage, interiorTablePage, groups, dividers)
+}
+
+// rebuildIndex replaces the tree of an index with one that has the
+// given cells, which are sorted. The entry between two leaves is kept
+// in their parent, so it is not part of any of them
+func (db *Database) rebuildIndex(root int, cells [][]byte) error {
+	tb, err := db.newTreeBuilder(root)
+	if err != nil {
+		return err
+	}
+
+	var groups [][][]byte
+	var dividers [][]byte
+	var current [][]byte
+
+	for i := 0; i < len(cells); i++ {
+		c := cells[i]
+		if len(current) > 0 && !tb.fits(leafHeaderSize, current, c) {
+			// The last entry can't be a divider, since the last
+			// leaf would be empty. The previous entry is used instead
+			if i == len(cells)-1 {
+				c = current[len(current)-1]
+				current = current[:len(current)-1]
+				i--
+			}
+
+			groups = append(groups, current)
+			dividers = append(dividers, c)
+			current = nil
+			continue
+		}
+		current = append(current, c)
+	}
+
+	groups = append(groups, current)
+	dividers = append(dividers, nil)
+
+	return tb.finish(leafIndexPage, interiorIndexPage, groups, dividers)
+}
+
+// finish writes the leaves and then the levels of interior pages
+// over them, until everything fits in the root page
+func (tb *treeBuilder) finish(leafType, interiorType byte, groups [][][]byte, dividers [][]byte) error {
+	if len(groups) == 1 {
+		tb.writePage(tb.root, leafType, groups[0], 0)
+		return tb.freeUnused()
+	}
+
+	nodes := make([]node, len(groups))
+	for i, g := range groups {
+		nodes[i] = node{page: tb.allocate(), divider: dividers[i]}
+		tb.writePage(nodes[i].page, leafType, g, 0)
+	}
+
+	for {
+		levels := tb.splitInterior(nodes)
+
+		if len(levels) == 1 {
+			tb.writeInterior(tb.root, interiorType, levels[0])
+			return tb.freeUnused()
+		}
+
+		next := make([]node, len(levels))
+		for i, children := range levels {
+			next[i] = node{page: tb.allocate(), divider: children[len(children)-1].divider}
+			tb.writeInterior(next[i].page, interiorType, children)
+		}
+
+		nodes = next
+	}
+}
+
+// splitInterior divides the nodes among interior pages. Every page
+// keeps a cell for each child except the last one, which is the right
+// pointer of the page, so every page must have at least two children
+func (tb *treeBuilder) splitInterior(nodes []node) [][]node {
+	var result [][]node
+	var current []node
+	var cells [][]byte
+
+	for _, n := range nodes {
+		if len(current) > 1 && !tb.fits(interiorHeaderSize, cells, interiorCell(current[len(current)-1])) {
+			result = append(result, current)
+			current = nil
+			cells = nil
+		}
+
+		if len(current) > 0 {
+			cells = append(cells, interiorCell(current[len(current)-1]))
+		}
+		current = append(current, n)
+	}
+
+	if len(current) == 1 && len(result) > 0 {
+		previous := result[len(result)-1]
+		result[len(result)-1] = previous[:len(previous)-1]
+		current = append([]node{previous[len(previous)-1]}, current...)
+	}
+
+	return append(result, current)
+}
+
+func interiorCell(n node) []byte {
+	c := make([]byte, 4, 4+len(n.divider))
+	binary.BigEndian.PutUint32(c, uint32(n.page))
+	return append(c, n.divider...)
+}
+
+func (tb *treeBuilder) writeInterior(n int, pageType byte, children []node) {
+	cells := make([][]byte, len(children)-1)
+	for i, c := range children[:len(children)-1] {
+		cells[i] = interiorCell(c)
+	}
+
+	tb.writePage(n, pageType, cells, children[len(children)-1].page)
+}
+
+func (tb *treeBuilder) writePage(n int, pageType byte, cells [][]byte, right int) {
+	p := make([]byte, tb.db.pageSize)
+
+	size := leafHeaderSize
+	if pageType == interiorIndexPage || pageType == interiorTablePage {
+		size = interiorHeaderSize
+		binary.BigEndian.PutUint32(p[8:], uint32(right))
+	}
+
+	p[0] = pageType
+	binary.BigEndian.PutUint16(p[3:], uint16(len(cells)))
+
+	end := tb.db.usable
+	for i, c := range cells {
+		end -= len(c)
+		copy(p[end:], c)
+		binary.BigEndian.PutUint16(p[size+2*i:], uint16(end))
+	}
+
+	// A content area that starts at 65536 is written as zero
+	binary.BigEndian.PutUint16(p[5:], uint16(end))
+
+	tb.db.pages[n-1] = p
+}
+
+// freeUnused adds the pages that the new tree doesn't
+// need to the list of free pages of the database
+func (tb *treeBuilder) freeUnused() error {
+	if len(tb.unused) == 0 {
+		return nil
+	}
+
+	header := tb.db.pages[0]
+	trunk := int(binary.BigEndian.Uint32(header[offsetFreelistTrunk:]))
+	total := int(binary.BigEndian.Uint32(header[offsetFreelistCount:])) + len(tb.unused)
+
+	// Old versions of SQLite don't read the last entries of a trunk page
+	perTrunk := tb.db.usable/4 - 8
+
+	pages := tb.unused
+	for len(pages) > 0 {
+		leaves := pages[1:]
+		if len(leaves) > perTrunk {
+			leaves = leaves[:perTrunk]
+		}
+
+		p := make([]byte, tb.db.pageSize)
+		binary.BigEndian.PutUint32(p, uint32(trunk))
+		binary.BigEndian.PutUint32(p[4:], uint32(len(leaves)))
+		for i, l := range leaves {
+			binary.BigEndian.PutUint32(p[8+4*i:], uint32(l))
+			tb.db.pages[l-1] = make([]byte, tb.db.pageSize)
+		}
+
+		trunk = pages[0]
+		tb.db.pages[trunk-1] = p
+		pages = pages[1+len(leaves):]
+	}
+
+	binary.BigEndian.PutUint32(header[offsetFreelistTrunk:], uint32(trunk))
+	binary.BigEndian.PutUint32(header[offsetFreelistCount:], uint32(total))
+	tb.unused = nil
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package sqlite
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// readVarint decodes the big-endian variable length integer
+// at the start of the buffer and returns it with its length
+func readVarint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		if i >= len(b) {
+			return 0, 0
+		}
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+
+	if len(b) < 9 {
+		return 0, 0
+	}
+
+	return v<<8 | uint64(b[8]), 9
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	if v > 0x00ffffffffffffff {
+		var buf [9]byte
+		buf[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			buf[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return append(b, buf[:]...)
+	}
+
+	var buf [8]byte
+	n := 0
+	for {
+		buf[n] = byte(v&0x7f) | 0x80
+		n++
+		v >>= 7
+		if v == 0 {
+			break
+		}
+	}
+
+	buf[0] &= 0x7f
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, buf[i])
+	}
+
+	return b
+}
+
+func varintLen(v uint64) int {
+	return len(appendVarint(nil, v))
+}
+
+// decodeRecord returns the values of a record: nil, int64,
+// float64, string or []byte, depending on the stored type
+func decodeRecord(payload []byte) ([]interface{}, error) {
+	headerSize, n := readVarint(payload)
+	if n == 0 || headerSize > uint64(len(payload)) {
+		return nil, ErrCorrupt
+	}
+
+	header := payload[n:headerSize]
+	body := payload[headerSize:]
+
+	var values []interface{}
+	for len(header) > 0 {
+		serialType, n := readVarint(header)
+		if n == 0 {
+			return nil, ErrCorrupt
+		}
+		header = header[n:]
+
+		size := serialTypeSize(serialType)
+		if size > len(body) {
+			return nil, ErrCorrupt
+		}
+
+		values = append(values, decodeValue(serialType, body[:size]))
+		body = body[size:]
+	}
+
+	return values, nil
+}
+
+var integerSizes = []int{0, 1, 2, 3, 4, 6, 8}
+
+func serialTypeSize(t uint64) int {
+	switch {
+	case t < 7:
+		return integerSizes[t]
+	case t == 7:
+		return 8
+	case t < 12:
+		return 0
+	default:
+		return int((t - 12) / 2)
+	}
+}
+
+func decodeValue(t uint64, b []byte) interface{} {
+	switch {
+	case t == 0:
+		return nil
+	case t < 7:
+		// Sign extension of a big-endian integer of any size
+		v := int64(int8(b[0]))
+		for _, x := range b[1:] {
+			v = v<<8 | int64(x)
+		}
+		return v
+	case t == 7:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	case t == 8:
+		return int64(0)
+	case t == 9:
+		return int64(1)
+	case t < 12:
+		return nil
+	case t%2 == 0:
+		return append([]byte{}, b...)
+	default:
+		return string(b)
+	}
+}
+
+// encodeRecord returns the record with the given values. Small
+// integers only use the 0 and 1 types when the schema allows it
+func encodeRecord(values []interface{}, compactIntegers bool) ([]byte, error) {
+	var types []uint64
+	var body []byte
+
+	for _, v := range values {
+		t, b, err := encodeValue(v, compactIntegers)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+		body = append(body, b...)
+	}
+
+	headerSize := 0
+	for _, t := range types {
+		headerSize += varintLen(t)
+	}
+
+	// The size of the header includes its own varint
+	total := headerSize + 1
+	for varintLen(uint64(total)) > total-headerSize {
+		total++
+	}
+
+	record := appendVarint(nil, uint64(total))
+	for _, t := range types {
+		record = appendVarint(record, t)
+	}
+
+	return append(record, body...), nil
+}
+
+func encodeValue(v interface{}, compactIntegers bool) (uint64, []byte, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil, nil
+	case int:
+		return encodeInteger(int64(x), compactIntegers)
+	case int64:
+		return encodeInteger(x, compactIntegers)
+	case float64:
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, math.Float64bits(x))
+		return 7, b, nil
+	case string:
+		return uint64(13 + 2*len(x)), []byte(x), nil
+	case []byte:
+		return uint64(12 + 2*len(x)), x, nil
+	}
+
+	return 0, nil, fmt.Errorf("unsupported value of type %T", v)
+}
+
+func encodeInteger(v int64, compactIntegers bool) (uint64, []byte, error) {
+	if compactIntegers && (v == 0 || v == 1) {
+		return uint64(8 + v), nil, nil
+	}
+
+	for t := uint64(1); t < 6; t++ {
+		size := uint(integerSizes[t])
+		limit := int64(1) << (size*8 - 1)
+		if v >= -limit && v < limit {
+			return t, bigEndian(v, size), nil
+		}
+	}
+
+	return 6, bigEndian(v, 8), nil
+}
+
+func bigEndian(v int64, size uint) []byte {
+	b := make([]byte, size)
+	for i := int(size) - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	return b
+}
+
+// compareValues sorts values in the same way SQLite does with
+// the BINARY collation: NULL, numbers, text and then blobs
+func compareValues(a, b interface{}) int {
+	ca, cb := valueClass(a), valueClass(b)
+	if ca != cb {
+		return ca - cb
+	}
+
+	switch x := a.(type) {
+	case int64, float64:
+		return compareNumbers(a, b)
+	case string:
+		return bytes.Compare([]byte(x), []byte(b.(string)))
+	case []byte:
+		return bytes.Compare(x, b.([]byte))
+	}
+
+	return 0
+}
+
+func valueClass(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case int64, float64:
+		return 1
+	case string:
+		return 2
+	}
+	return 3
+}
+
+func compareNumbers(a, b interface{}) int {
+	ia, aInt := a.(int64)
+	ib, bInt := b.(int64)
+	if aInt && bInt {
+		switch {
+		case ia < ib:
+			return -1
+		case ia > ib:
+			return 1
+		}
+		return 0
+	}
+
+	fa, fb := toFloat(a), toFloat(b)
+	switch {
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
+
+func toFloat(v interface{}) float64 {
+	if i, ok := v.(int64); ok {
+		return float64(i)
+	}
+	return v.(float64)
+}
+
+func compareRecords(a, b []interface{}) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareValues(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return len(a) - len(b)
+}
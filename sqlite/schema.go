@@ -0,0 +1,286 @@
+package sqlite
+
+import (
+	"strings"
+)
+
+// The columns of the sqlite_master table
+const (
+	schemaType = iota
+	schemaName
+	schemaTableName
+	schemaRootPage
+	schemaSQL
+)
+
+type table struct {
+	name    string
+	root    int
+	columns []string
+
+	// The column that is an alias of the rowid, or -1
+	rowIDAlias    int
+	autoIncrement bool
+	indexes       []*index
+}
+
+type index struct {
+	name    string
+	root    int
+	unique  bool
+	columns []int
+}
+
+// readSchema returns the tables described in sqlite_master.
+// The tables that can't be understood are kept without columns,
+// so that reading them fails with ErrUnsupported
+func (db *Database) readSchema() (map[string]*table, error) {
+	rows, err := db.readTable(1)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string]*table)
+	var indexRows [][]interface{}
+
+	for _, r := range rows {
+		if len(r.Values) <= schemaSQL {
+			return nil, ErrCorrupt
+		}
+
+		switch r.Values[schemaType] {
+		case "table":
+			name, _ := r.Values[schemaName].(string)
+			root, _ := r.Values[schemaRootPage].(int64)
+			sql, _ := r.Values[schemaSQL].(string)
+
+			t := &table{name: name, root: int(root), rowIDAlias: -1}
+			t.columns, t.rowIDAlias, t.autoIncrement = parseCreateTable(sql)
+			tables[name] = t
+		case "index":
+			indexRows = append(indexRows, r.Values)
+		}
+	}
+
+	for _, v := range indexRows {
+		t, ok := tables[toString(v[schemaTableName])]
+		if !ok {
+			continue
+		}
+
+		name, _ := v[schemaName].(string)
+		root, _ := v[schemaRootPage].(int64)
+		sql, _ := v[schemaSQL].(string)
+
+		idx := &index{name: name, root: int(root)}
+		idx.unique, idx.columns = parseCreateIndex(sql, t.columns)
+
+		// An index we don't understand can't be kept up to date
+		if idx.columns == nil {
+			t.columns = nil
+		}
+
+		t.indexes = append(t.indexes, idx)
+	}
+
+	return tables, nil
+}
+
+func toString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// parseCreateTable returns the names of the columns of a
+// CREATE TABLE statement. Tables without a rowid are not supported
+func parseCreateTable(sql string) (columns []string, rowIDAlias int, autoIncrement bool) {
+	rowIDAlias = -1
+
+	body, rest, ok := parenthesized(sql)
+	if !ok || strings.Contains(strings.ToUpper(rest), "WITHOUT") {
+		return nil, -1, false
+	}
+
+	for _, def := range splitTopLevel(body) {
+		words := tokens(def)
+		if len(words) == 0 {
+			return nil, -1, false
+		}
+
+		switch strings.ToUpper(words[0]) {
+		case "CHECK", "FOREIGN":
+			continue
+		case "CONSTRAINT", "PRIMARY", "UNIQUE":
+			// The automatic indexes of these constraints
+			// would have to be kept up to date too
+			return nil, -1, false
+		}
+
+		upper := strings.ToUpper(def)
+		if strings.Contains(upper, "UNIQUE") {
+			return nil, -1, false
+		}
+
+		if len(words) > 1 && strings.ToUpper(words[1]) == "INTEGER" && strings.Contains(upper, "PRIMARY KEY") {
+			rowIDAlias = len(columns)
+		} else if strings.Contains(upper, "PRIMARY KEY") {
+			return nil, -1, false
+		}
+
+		if strings.Contains(upper, "AUTOINCREMENT") {
+			autoIncrement = true
+		}
+
+		columns = append(columns, unquote(words[0]))
+	}
+
+	return columns, rowIDAlias, autoIncrement
+}
+
+// parseCreateIndex returns the positions in the table of the columns
+// of a CREATE INDEX statement, or nil if the index is anything more
+// than a list of columns in ascending order
+func parseCreateIndex(sql string, tableColumns []string) (unique bool, columns []int) {
+	unique = strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "CREATE UNIQUE")
+
+	body, rest, ok := parenthesized(sql)
+	if !ok || strings.TrimSpace(rest) != "" {
+		return unique, nil
+	}
+
+	for _, def := range splitTopLevel(body) {
+		words := tokens(def)
+		if len(words) == 2 && strings.ToUpper(words[1]) == "ASC" {
+			words = words[:1]
+		}
+
+		if len(words) != 1 {
+			return unique, nil
+		}
+
+		position := columnPosition(tableColumns, unquote(words[0]))
+		if position < 0 {
+			return unique, nil
+		}
+
+		columns = append(columns, position)
+	}
+
+	return unique, columns
+}
+
+func columnPosition(columns []string, name string) int {
+	for i, c := range columns {
+		if strings.EqualFold(c, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parenthesized returns what is between the first opening parenthesis
+// and the one that closes it, and what comes after that
+func parenthesized(sql string) (string, string, bool) {
+	start := strings.Index(sql, "(")
+	if start < 0 {
+		return "", "", false
+	}
+
+	depth := 0
+	var quote byte
+
+	for i := start; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return sql[start+1 : i], sql[i+1:], true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth := 0
+	var quote byte
+	last := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+
+	return append(parts, s[last:])
+}
+
+// tokens splits a definition in words, keeping quoted names together
+func tokens(s string) []string {
+	var result []string
+	s = strings.TrimSpace(s)
+
+	for len(s) > 0 {
+		end := strings.IndexAny(s, " \t\r\n")
+		if closing := closingQuote(s[0]); closing != 0 {
+			end = strings.IndexByte(s[1:], closing) + 2
+			if end == 1 {
+				end = len(s)
+			}
+		}
+
+		if end < 0 {
+			end = len(s)
+		}
+
+		result = append(result, s[:end])
+		s = strings.TrimSpace(s[end:])
+	}
+
+	return result
+}
+
+func closingQuote(c byte) byte {
+	switch c {
+	case '"', '`', '\'':
+		return c
+	case '[':
+		return ']'
+	}
+	return 0
+}
+
+func unquote(name string) string {
+	if len(name) >= 2 && closingQuote(name[0]) == name[len(name)-1] {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
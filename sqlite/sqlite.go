@@ -0,0 +1,437 @@
+// Package sqlite reads and edits SQLite database files without the
+// SQLite library. It only does what Wahay needs to manage the database
+// of the Mumble client: listing the rows of a table and replacing them,
+// keeping the indexes of the table up to date. Databases that use
+// features it doesn't understand are refused instead of being damaged.
+package sqlite
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const (
+	headerSize = 100
+	magic      = "SQLite format 3\x00"
+
+	// Header fields
+	offsetPageSize      = 16
+	offsetReservedSpace = 20
+	offsetChangeCounter = 24
+	offsetPageCount     = 28
+	offsetFreelistTrunk = 32
+	offsetFreelistCount = 36
+	offsetSchemaFormat  = 44
+	offsetAutoVacuum    = 52
+	offsetTextEncoding  = 56
+	offsetValidFor      = 92
+
+	encodingUTF8 = 1
+)
+
+var (
+	// ErrCorrupt is returned when the file is not a valid database
+	ErrCorrupt = errors.New("the database file is corrupt")
+
+	// ErrUnsupported is returned when the database uses a feature that this package doesn't understand
+	ErrUnsupported = errors.New("the database uses features that are not supported")
+
+	// ErrNoSuchTable is returned when the table doesn't exist
+	ErrNoSuchTable = errors.New("the table doesn't exist")
+
+	// ErrRowTooBig is returned when a row doesn't fit in a single page
+	ErrRowTooBig = errors.New("the row is too big")
+
+	// ErrConstraint is returned when two rows have the same ID or the same values in a unique index
+	ErrConstraint = errors.New("the rows break a unique constraint")
+
+	// ErrInUse is returned when another program has changes of the database that haven't been written yet
+	ErrInUse = errors.New("the database is being used by another program")
+)
+
+var errWrongNumberOfValues = errors.New("the number of values is different from the number of columns")
+
+// Row is a row of a table. The values are nil, int64, float64,
+// string or []byte, in the same order as the columns of the table
+type Row struct {
+	ID     int64
+	Values []interface{}
+}
+
+// Database is a database file loaded in memory
+type Database struct {
+	pages    [][]byte
+	pageSize int
+	usable   int
+	tables   map[string]*table
+}
+
+// Open loads the database in the given file
+func Open(filename string) (*Database, error) {
+	data, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(data)
+}
+
+// Load reads a database from the content of its file
+func Load(data []byte) (*Database, error) {
+	if len(data) < headerSize || !bytes.HasPrefix(data, []byte(magic)) {
+		return nil, ErrCorrupt
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(data[offsetPageSize:]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 || len(data)%pageSize != 0 {
+		return nil, ErrCorrupt
+	}
+
+	if binary.BigEndian.Uint32(data[offsetTextEncoding:]) != encodingUTF8 {
+		return nil, ErrUnsupported
+	}
+
+	count := len(data) / pageSize
+
+	// The page count in the header is only valid when it was
+	// written by a version of SQLite that knows about it
+	if binary.BigEndian.Uint32(data[offsetChangeCounter:]) == binary.BigEndian.Uint32(data[offsetValidFor:]) {
+		if n := int(binary.BigEndian.Uint32(data[offsetPageCount:])); n > 0 && n < count {
+			count = n
+		}
+	}
+
+	db := &Database{
+		pageSize: pageSize,
+		usable:   pageSize - int(data[offsetReservedSpace]),
+	}
+
+	for i := 0; i < count; i++ {
+		page := make([]byte, pageSize)
+		copy(page, data[i*pageSize:])
+		db.pages = append(db.pages, page)
+	}
+
+	tables, err := db.readSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	db.tables = tables
+
+	return db, nil
+}
+
+// Bytes returns the content of the database file
+func (db *Database) Bytes() []byte {
+	return bytes.Join(db.pages, nil)
+}
+
+// Save writes the database to the given file. It fails if there
+// is a journal that still has to be applied to the file, since
+// that means that some other program is using the database
+func (db *Database) Save(filename string) error {
+	for _, suffix := range []string{"-wal", "-journal"} {
+		info, err := os.Stat(filename + suffix)
+		if err == nil && info.Size() > 0 {
+			return ErrInUse
+		}
+	}
+
+	return ioutil.WriteFile(filename, db.Bytes(), 0600)
+}
+
+// Columns returns the names of the columns of the table
+func (db *Database) Columns(name string) ([]string, error) {
+	t, err := db.table(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{}, t.columns...), nil
+}
+
+// Rows returns all the rows of the table, sorted by their ID
+func (db *Database) Rows(name string) ([]Row, error) {
+	t, err := db.table(name)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.readTable(t.root)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		// Columns added to the table after the row
+		// was written have their default value
+		for len(rows[i].Values) < len(t.columns) {
+			rows[i].Values = append(rows[i].Values, nil)
+		}
+
+		if t.rowIDAlias >= 0 {
+			rows[i].Values[t.rowIDAlias] = rows[i].ID
+		}
+	}
+
+	return rows, nil
+}
+
+// SetRows replaces all the rows of the table with the given ones,
+// and rebuilds the indexes of the table. The value of the column that
+// is the INTEGER PRIMARY KEY is ignored, since the ID is used instead.
+// Either all the changes are done, or the database is left untouched
+func (db *Database) SetRows(name string, rows []Row) error {
+	t, err := db.table(name)
+	if err != nil {
+		return err
+	}
+
+	if err := db.checkWritable(); err != nil {
+		return err
+	}
+
+	rows, err = t.normalize(rows)
+	if err != nil {
+		return err
+	}
+
+	backup := db.copyPages()
+
+	err = db.setRows(t, rows)
+	if err != nil {
+		db.pages = backup
+		return err
+	}
+
+	db.touch()
+
+	return nil
+}
+
+func (db *Database) setRows(t *table, rows []Row) error {
+	compact := binary.BigEndian.Uint32(db.pages[0][offsetSchemaFormat:]) >= 4
+
+	cells := make([][]byte, len(rows))
+	ids := make([]int64, len(rows))
+
+	for i, r := range rows {
+		values := r.Values
+		if t.rowIDAlias >= 0 {
+			values = append([]interface{}{}, r.Values...)
+			values[t.rowIDAlias] = nil
+		}
+
+		payload, err := encodeRecord(values, compact)
+		if err != nil {
+			return err
+		}
+
+		if len(payload) > db.maxLocalTable() {
+			return ErrRowTooBig
+		}
+
+		cells[i] = append(appendVarint(appendVarint(nil, uint64(len(payload))), uint64(r.ID)), payload...)
+		ids[i] = r.ID
+	}
+
+	for _, idx := range t.indexes {
+		entries, err := idx.entries(t, rows, compact, db.maxLocalIndex())
+		if err != nil {
+			return err
+		}
+
+		err = db.rebuildIndex(idx.root, entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := db.rebuildTable(t.root, cells, ids)
+	if err != nil {
+		return err
+	}
+
+	if t.autoIncrement && len(ids) > 0 {
+		return db.updateSequence(t.name, ids[len(ids)-1])
+	}
+
+	return nil
+}
+
+// updateSequence makes sure that SQLite never gives again
+// one of the IDs that we have used for a table with AUTOINCREMENT
+func (db *Database) updateSequence(name string, last int64) error {
+	seq, err := db.table("sqlite_sequence")
+	if err != nil {
+		return nil
+	}
+
+	rows, err := db.Rows(seq.name)
+	if err != nil {
+		return err
+	}
+
+	var next int64 = 1
+	for i, r := range rows {
+		if len(r.Values) == 2 && r.Values[0] == name {
+			if current, ok := r.Values[1].(int64); ok && current >= last {
+				return nil
+			}
+
+			rows[i].Values[1] = last
+			return db.setRows(seq, rows)
+		}
+
+		next = r.ID + 1
+	}
+
+	rows = append(rows, Row{ID: next, Values: []interface{}{name, last}})
+
+	return db.setRows(seq, rows)
+}
+
+func (db *Database) table(name string) (*table, error) {
+	t, ok := db.tables[name]
+	if !ok {
+		return nil, ErrNoSuchTable
+	}
+
+	if t.columns == nil {
+		return nil, ErrUnsupported
+	}
+
+	return t, nil
+}
+
+// checkWritable refuses the databases where pages have
+// more content than the b-trees that we know how to write
+func (db *Database) checkWritable() error {
+	header := db.pages[0]
+	if db.usable != db.pageSize || binary.BigEndian.Uint32(header[offsetAutoVacuum:]) != 0 {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+func (db *Database) copyPages() [][]byte {
+	result := make([][]byte, len(db.pages))
+	for i, p := range db.pages {
+		result[i] = append([]byte{}, p...)
+	}
+	return result
+}
+
+// touch marks the database as changed, so that SQLite
+// doesn't use anything it had cached about the file
+func (db *Database) touch() {
+	header := db.pages[0]
+	counter := binary.BigEndian.Uint32(header[offsetChangeCounter:]) + 1
+
+	binary.BigEndian.PutUint32(header[offsetChangeCounter:], counter)
+	binary.BigEndian.PutUint32(header[offsetValidFor:], counter)
+	binary.BigEndian.PutUint32(header[offsetPageCount:], uint32(len(db.pages)))
+}
+
+func (t *table) normalize(rows []Row) ([]Row, error) {
+	result := make([]Row, len(rows))
+
+	for i, r := range rows {
+		if len(r.Values) != len(t.columns) {
+			return nil, errWrongNumberOfValues
+		}
+
+		values := make([]interface{}, len(r.Values))
+		for j, v := range r.Values {
+			switch x := v.(type) {
+			case int:
+				values[j] = int64(x)
+			case nil, int64, float64, string, []byte:
+				values[j] = v
+			default:
+				return nil, fmt.Errorf("unsupported value of type %T", v)
+			}
+		}
+
+		result[i] = Row{ID: r.ID, Values: values}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	for i := 1; i < len(result); i++ {
+		if result[i].ID == result[i-1].ID {
+			return nil, ErrConstraint
+		}
+	}
+
+	return result, nil
+}
+
+// entries returns the cells of the index for the given rows, sorted
+func (idx *index) entries(t *table, rows []Row, compact bool, maxLocal int) ([][]byte, error) {
+	keys := make([][]interface{}, len(rows))
+
+	for i, r := range rows {
+		key := make([]interface{}, 0, len(idx.columns)+1)
+		for _, c := range idx.columns {
+			if c == t.rowIDAlias {
+				key = append(key, r.ID)
+			} else {
+				key = append(key, r.Values[c])
+			}
+		}
+		keys[i] = append(key, r.ID)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return compareRecords(keys[i], keys[j]) < 0
+	})
+
+	if idx.unique {
+		for i := 1; i < len(keys); i++ {
+			if sameUniqueKey(keys[i-1][:len(idx.columns)], keys[i][:len(idx.columns)]) {
+				return nil, ErrConstraint
+			}
+		}
+	}
+
+	cells := make([][]byte, len(keys))
+	for i, k := range keys {
+		payload, err := encodeRecord(k, compact)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(payload) > maxLocal {
+			return nil, ErrRowTooBig
+		}
+
+		cells[i] = append(appendVarint(nil, uint64(len(payload))), payload...)
+	}
+
+	return cells, nil
+}
+
+// sameUniqueKey returns true when the keys are equal. NULL values
+// are different from each other for the unique constraints
+func sameUniqueKey(a, b []interface{}) bool {
+	for i := range a {
+		if a[i] == nil || b[i] == nil {
+			return false
+		}
+	}
+	return compareRecords(a, b) == 0
+}
@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SQLiteSuite struct{}
+
+var _ = Suite(&SQLiteSuite{})
+
+const testPageSize = 1024
+
+// newTestDatabase returns a database with no rows, the same as SQLite
+// would create it. The schema has the type, name, table and SQL of each object
+func newTestDatabase(c *C, schema ...[]interface{}) *Database {
+	pages := make([][]byte, len(schema)+1)
+
+	header := make([]byte, testPageSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint16(header[offsetPageSize:], testPageSize)
+	header[18], header[19] = 1, 1
+	header[21], header[22], header[23] = 64, 32, 32
+	binary.BigEndian.PutUint32(header[offsetSchemaFormat:], 4)
+	binary.BigEndian.PutUint32(header[offsetTextEncoding:], encodingUTF8)
+
+	var cells [][]byte
+	for i, values := range schema {
+		root := int64(i + 2)
+		record, err := encodeRecord([]interface{}{values[0], values[1], values[2], root, values[3]}, true)
+		c.Assert(err, IsNil)
+		cells = append(cells, append(appendVarint(appendVarint(nil, uint64(len(record))), uint64(i+1)), record...))
+
+		pages[i+1] = make([]byte, testPageSize)
+		pages[i+1][0] = leafTablePage
+		if values[0] == "index" {
+			pages[i+1][0] = leafIndexPage
+		}
+		binary.BigEndian.PutUint16(pages[i+1][5:], testPageSize)
+	}
+
+	header[headerSize] = leafTablePage
+	binary.BigEndian.PutUint16(header[headerSize+3:], uint16(len(cells)))
+	end := testPageSize
+	for i, cell := range cells {
+		end -= len(cell)
+		copy(header[end:], cell)
+		binary.BigEndian.PutUint16(header[headerSize+leafHeaderSize+2*i:], uint16(end))
+	}
+	binary.BigEndian.PutUint16(header[headerSize+5:], uint16(end))
+	pages[0] = header
+
+	var data []byte
+	for _, p := range pages {
+		data = append(data, p...)
+	}
+	binary.BigEndian.PutUint32(data[offsetPageCount:], uint32(len(pages)))
+
+	db, err := Load(data)
+	c.Assert(err, IsNil)
+
+	return db
+}
+
+func newCertDatabase(c *C) *Database {
+	return newTestDatabase(c,
+		[]interface{}{"table", "cert", "cert", "CREATE TABLE `cert` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `hostname` TEXT, `port` INTEGER, `digest` TEXT)"},
+		[]interface{}{"table", "sqlite_sequence", "sqlite_sequence", "CREATE TABLE sqlite_sequence(name,seq)"},
+		[]interface{}{"index", "cert_host_port", "cert", "CREATE UNIQUE INDEX `cert_host_port` ON `cert`(`hostname`,`port`)"})
+}
+
+func certRows(n int) []Row {
+	rows := make([]Row, n)
+	for i := range rows {
+		// The host names are not in the same order as the IDs
+		host := fmt.Sprintf("host-%04d.onion", (i*7919)%n)
+		rows[i] = Row{ID: int64(i + 1), Values: []interface{}{nil, host, 64738, fmt.Sprintf("%040x", i)}}
+	}
+	return rows
+}
+
+func (s *SQLiteSuite) Test_varint_roundTrips(c *C) {
+	for _, v := range []uint64{0, 1, 127, 128, 240, 2287, 16383, 16384, 1 << 56, 1<<56 - 1, 1<<64 - 1} {
+		b := appendVarint(nil, v)
+		read, n := readVarint(b)
+
+		c.Assert(read, Equals, v)
+		c.Assert(n, Equals, len(b))
+	}
+
+	c.Assert(appendVarint(nil, 300), DeepEquals, []byte{0x82, 0x2c})
+	c.Assert(len(appendVarint(nil, 1<<64-1)), Equals, 9)
+}
+
+func (s *SQLiteSuite) Test_encodeRecord_roundTripsAllTheTypes(c *C) {
+	values := []interface{}{nil, int64(0), int64(1), int64(-200), int64(1 << 40), 2.5, "héllo", []byte{1, 2}}
+
+	record, err := encodeRecord(values, true)
+	c.Assert(err, IsNil)
+
+	decoded, err := decodeRecord(record)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, DeepEquals, values)
+}
+
+func (s *SQLiteSuite) Test_compareValues_sortsLikeSQLite(c *C) {
+	sorted := []interface{}{nil, int64(-3), 2.5, int64(3), "A", "a", "ab", []byte{0}}
+
+	for i := 1; i < len(sorted); i++ {
+		c.Assert(compareValues(sorted[i-1], sorted[i]) < 0, Equals, true, Commentf("%v < %v", sorted[i-1], sorted[i]))
+	}
+}
+
+func (s *SQLiteSuite) Test_parseCreateTable_findsTheColumnsAndTheRowIDAlias(c *C) {
+	columns, alias, autoIncrement := parseCreateTable(
+		"CREATE TABLE `servers` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `name` TEXT, \"port\" INTEGER DEFAULT 64738, [url] TEXT, CHECK (port > 0))")
+
+	c.Assert(columns, DeepEquals, []string{"id", "name", "port", "url"})
+	c.Assert(alias, Equals, 0)
+	c.Assert(autoIncrement, Equals, true)
+}
+
+func (s *SQLiteSuite) Test_parseCreateTable_refusesWhatItCantKeepUpToDate(c *C) {
+	c1, _, _ := parseCreateTable("CREATE TABLE t (a TEXT UNIQUE)")
+	c2, _, _ := parseCreateTable("CREATE TABLE t (a TEXT, PRIMARY KEY (a))")
+	c3, _, _ := parseCreateTable("CREATE TABLE t (a TEXT PRIMARY KEY) WITHOUT ROWID")
+
+	c.Assert(c1, IsNil)
+	c.Assert(c2, IsNil)
+	c.Assert(c3, IsNil)
+}
+
+func (s *SQLiteSuite) Test_parseCreateIndex_onlyAcceptsPlainColumns(c *C) {
+	columns := []string{"id", "hostname", "port"}
+
+	unique, positions := parseCreateIndex("CREATE UNIQUE INDEX `i` ON `cert`(`hostname`, port ASC)", columns)
+	c.Assert(unique, Equals, true)
+	c.Assert(positions, DeepEquals, []int{1, 2})
+
+	_, positions = parseCreateIndex("CREATE INDEX i ON cert(hostname DESC)", columns)
+	c.Assert(positions, IsNil)
+
+	_, positions = parseCreateIndex("CREATE INDEX i ON cert(hostname) WHERE port > 0", columns)
+	c.Assert(positions, IsNil)
+}
+
+func (s *SQLiteSuite) Test_SetRows_buildsTreesThatCanBeReadBack(c *C) {
+	for _, n := range []int{0, 1, 10, 500, 3000} {
+		db := newCertDatabase(c)
+
+		c.Assert(db.SetRows("cert", certRows(n)), IsNil)
+
+		loaded, err := Load(db.Bytes())
+		c.Assert(err, IsNil)
+
+		rows, err := loaded.Rows("cert")
+		c.Assert(err, IsNil)
+		c.Assert(rows, HasLen, n)
+
+		for i, r := range rows {
+			c.Assert(r.ID, Equals, int64(i+1))
+			c.Assert(r.Values[0], Equals, r.ID)
+			c.Assert(r.Values[3], Equals, fmt.Sprintf("%040x", i))
+		}
+
+		entries, err := loaded.readIndex(loaded.tables["cert"].indexes[0].root)
+		c.Assert(err, IsNil)
+		c.Assert(entries, HasLen, n)
+
+		for i := 1; i < len(entries); i++ {
+			c.Assert(compareRecords(entries[i-1], entries[i]) < 0, Equals, true)
+		}
+	}
+}
+
+func (s *SQLiteSuite) Test_SetRows_freesThePagesItDoesntNeedAnymore(c *C) {
+	db := newCertDatabase(c)
+	c.Assert(db.SetRows("cert", certRows(3000)), IsNil)
+	pages := len(db.pages)
+
+	c.Assert(db.SetRows("cert", certRows(2)), IsNil)
+
+	free := binary.BigEndian.Uint32(db.pages[0][offsetFreelistCount:])
+	c.Assert(len(db.pages), Equals, pages)
+	c.Assert(int(free), Equals, pages-4)
+
+	c.Assert(db.SetRows("cert", certRows(3000)), IsNil)
+	rows, err := db.Rows("cert")
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 3000)
+}
+
+func (s *SQLiteSuite) Test_SetRows_updatesTheSequenceOfTheTable(c *C) {
+	db := newCertDatabase(c)
+
+	c.Assert(db.SetRows("cert", certRows(5)), IsNil)
+	c.Assert(db.SetRows("cert", certRows(2)), IsNil)
+
+	rows, err := db.Rows("sqlite_sequence")
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 1)
+	c.Assert(rows[0].Values, DeepEquals, []interface{}{"cert", int64(5)})
+}
+
+func (s *SQLiteSuite) Test_SetRows_leavesTheDatabaseUntouchedWhenItFails(c *C) {
+	db := newCertDatabase(c)
+	c.Assert(db.SetRows("cert", certRows(3)), IsNil)
+	before := db.Bytes()
+
+	rows := certRows(3)
+	rows[2].Values[1] = rows[0].Values[1]
+
+	c.Assert(db.SetRows("cert", rows), Equals, ErrConstraint)
+	c.Assert(db.SetRows("cert", []Row{{ID: 1, Values: []interface{}{nil}}}), Equals, errWrongNumberOfValues)
+	c.Assert(db.SetRows("missing", nil), Equals, ErrNoSuchTable)
+	c.Assert(db.Bytes(), DeepEquals, before)
+}
+
+func (s *SQLiteSuite) Test_Load_refusesFilesThatAreNotDatabases(c *C) {
+	_, err := Load([]byte("this is not a database"))
+
+	c.Assert(err, Equals, ErrCorrupt)
+}
@@ -20,6 +20,7 @@ type Control interface {
 	SetPassword(string)
 	UseCookieAuth()
 	CreateNewOnionServiceWithMultiplePorts(ports []OnionPort) (serviceID string, err error)
+	CreateOnionServiceWithKey(ports []OnionPort, key OnionKey) (serviceID string, err error)
 	CreateNewOnionService(destinationHost string, destinationPort int, port int) (serviceID string, err error)
 	DeleteOnionService(serviceID string) error
 	DeleteOnionServices()
@@ -81,12 +82,27 @@ type OnionPort struct {
 
 func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPort) (serviceID string, err error) {
 	log.Debugf("CreateNewOnionServiceWithMultiplePorts(%v)", ports)
+	return cntrl.createOnionService(ports, "NEW", "ED25519-V3")
+}
+
+// CreateOnionServiceWithKey creates an onion service that always
+// has the same address, given by the key
+func (cntrl *controller) CreateOnionServiceWithKey(ports []OnionPort, key OnionKey) (serviceID string, err error) {
+	log.Debugf("CreateOnionServiceWithKey(%v)", ports)
+	privateKey, err := key.torPrivateKey()
+	if err != nil {
+		return "", err
+	}
+	return cntrl.createOnionService(ports, "ED25519-V3", privateKey)
+}
+
+func (cntrl *controller) createOnionService(ports []OnionPort, keyType, key string) (serviceID string, err error) {
 	tc, err := cntrl.getTorController()
 	if err != nil {
 		return
 	}
 
-	log.Debug("CreateNewOnionServiceWithMultiplePorts() - authenticating")
+	log.Debug("createOnionService() - authenticating")
 	if cntrl.authType != nil {
 		err = (*cntrl.authType)(tc)
 		if err != nil {
@@ -115,8 +131,8 @@ func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPor
 
 	onion := &torgo.Onion{
 		Ports:          finalPorts,
-		PrivateKeyType: "NEW",
-		PrivateKey:     "ED25519-V3",
+		PrivateKeyType: keyType,
+		PrivateKey:     key,
 	}
 
 	err = tc.AddOnion(onion)
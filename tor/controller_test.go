@@ -271,3 +271,36 @@ func (s *WahayTorSuite) Test_controller_DeleteOnionService_returnsErrorIfFails(c
 	//error if delete fail
 	c.Assert(e, ErrorMatches, "service deletion error")
 }
+
+func (s *WahayTorSuite) Test_controller_CreateOnionServiceWithKey_usesTheGivenKey(c *C) {
+	mock := &controllerMock{}
+
+	var a authenticationMethod = authenticatePassword(passw)
+	cntrl := &controller{
+		torHost:  "127.1.2.3",
+		torPort:  9052,
+		password: passw,
+		authType: &a,
+		tc:       mock.createTestGotor,
+	}
+
+	key, _ := NewOnionKey()
+	expected, _ := key.torPrivateKey()
+
+	_, e := cntrl.CreateOnionServiceWithKey([]OnionPort{{ServicePort: 7877, DestinationPort: 42, DestinationHost: "127.0.42.1"}}, key)
+
+	c.Assert(mock.addOnionCalled, Equals, true)
+	c.Assert(e, IsNil)
+	o := mock.addOnionArg1
+	c.Assert(o.PrivateKeyType, Equals, "ED25519-V3")
+	c.Assert(o.PrivateKey, Equals, expected)
+	c.Assert(o.Ports[7877], Equals, "127.0.42.1:42")
+}
+
+func (s *WahayTorSuite) Test_controller_CreateOnionServiceWithKey_failsWithAnInvalidKey(c *C) {
+	cntrl := &controller{}
+
+	_, e := cntrl.CreateOnionServiceWithKey([]OnionPort{{ServicePort: 7877, DestinationPort: 42}}, OnionKey("not a key"))
+
+	c.Assert(e, Equals, errInvalidOnionKey)
+}
@@ -31,7 +31,10 @@ type Instance interface {
 	GetController() Control
 	HTTPrequest(url string) (string, error)
 	NewService(string, []string, ModifyCommand) (Service, error)
+	NewProxiedService(string, []string, ModifyCommand) (Service, error)
+	SocksProxy() (string, int)
 	NewOnionServiceWithMultiplePorts([]OnionPort) (Onion, error)
+	NewOnionServiceWithKey([]OnionPort, OnionKey) (Onion, error)
 }
 
 type instance struct {
@@ -80,6 +83,12 @@ func (i *instance) HTTPrequest(u string) (string, error) {
 	return httpf.HTTPRequest(i.controlHost, i.socksPort, u)
 }
 
+// SocksProxy returns the host and port of the Tor SOCKS proxy, for the
+// programs that connect through it by themselves instead of using torsocks
+func (i *instance) SocksProxy() (string, int) {
+	return i.controlHost, i.socksPort
+}
+
 type runningTor struct {
 	cmd               *exec.Cmd
 	ctx               context.Context
@@ -129,6 +138,26 @@ func (i *instance) NewOnionServiceWithMultiplePorts(ports []OnionPort) (Onion, e
 	return s, nil
 }
 
+// NewOnionServiceWithKey creates an Onion service with
+// the address that corresponds to the given key
+func (i *instance) NewOnionServiceWithKey(ports []OnionPort, key OnionKey) (Onion, error) {
+	log.Debugf("NewOnionServiceWithKey(%v)", ports)
+	controller := i.GetController()
+
+	serviceID, err := controller.CreateOnionServiceWithKey(ports, key)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &onion{
+		id:    serviceID,
+		ports: ports,
+		t:     i,
+	}
+
+	return s, nil
+}
+
 var (
 	// ErrTorBinaryNotFound is an error to be trown when wasn't
 	// possible to find any available or valid Tor binary
@@ -341,6 +370,22 @@ func (i *instance) exec(command string, args []string, pre ModifyCommand) (*Runn
 	cmd.Env = append(cmd.Env, fmt.Sprintf("TORSOCKS_TOR_ADDRESS=%s", i.controlHost))
 	cmd.Env = append(cmd.Env, fmt.Sprintf("TORSOCKS_TOR_PORT=%d", i.socksPort))
 
+	return startCommand(ctx, cancelFunc, cmd, pre)
+}
+
+// execProxied runs a command without torsocks. It's only for the commands
+// that are configured to use the Tor SOCKS proxy by themselves
+func (i *instance) execProxied(command string, args []string, pre ModifyCommand) (*RunningCommand, error) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	// This executes a command, and the args which are both under control of the code
+	/* #nosec G204 */
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Env = osf.Environ()
+
+	return startCommand(ctx, cancelFunc, cmd, pre)
+}
+
+func startCommand(ctx context.Context, cancelFunc context.CancelFunc, cmd *exec.Cmd, pre ModifyCommand) (*RunningCommand, error) {
 	if pre != nil {
 		pre(cmd)
 	}
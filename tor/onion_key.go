@@ -0,0 +1,77 @@
+package tor
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// OnionKey is the private key of an onion service. Keeping it makes
+// it possible to create the same onion address again later, so meetings
+// can be announced before they start. It is the base64 encoded seed of
+// an Ed25519 key
+type OnionKey string
+
+const onionServiceVersion = 3
+
+var errInvalidOnionKey = errors.New("invalid onion service key")
+
+// NewOnionKey generates a new random key for an onion service
+func NewOnionKey() (OnionKey, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return "", err
+	}
+
+	return OnionKey(base64.StdEncoding.EncodeToString(seed)), nil
+}
+
+func (k OnionKey) seed() ([]byte, error) {
+	seed, err := base64.StdEncoding.DecodeString(string(k))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errInvalidOnionKey
+	}
+	return seed, nil
+}
+
+// ServiceID returns the onion address that Tor will use for this key,
+// calculated as described in section 6 of the rend-spec-v3
+func (k OnionKey) ServiceID() (string, error) {
+	seed, err := k.seed()
+	if err != nil {
+		return "", err
+	}
+
+	pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+	checksum := sha3.Sum256(append(append([]byte(".onion checksum"), pub...), onionServiceVersion))
+
+	address := append([]byte{}, pub...)
+	address = append(address, checksum[:2]...)
+	address = append(address, onionServiceVersion)
+
+	return strings.ToLower(base32.StdEncoding.EncodeToString(address)) + ".onion", nil
+}
+
+// torPrivateKey returns the key in the format that the ADD_ONION command
+// expects, which is the expanded secret key instead of the seed
+func (k OnionKey) torPrivateKey() (string, error) {
+	seed, err := k.seed()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+
+	return base64.StdEncoding.EncodeToString(h[:]), nil
+}
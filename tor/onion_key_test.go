@@ -0,0 +1,37 @@
+package tor
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayOnionKeySuite struct{}
+
+var _ = Suite(&WahayOnionKeySuite{})
+
+func (s *WahayOnionKeySuite) Test_OnionKey_ServiceID_isAStableV3Address(c *C) {
+	key, err := NewOnionKey()
+	c.Assert(err, IsNil)
+
+	id1, err := key.ServiceID()
+	c.Assert(err, IsNil)
+	id2, _ := key.ServiceID()
+
+	c.Assert(id1, Equals, id2)
+	c.Assert(id1, HasLen, 62)
+	c.Assert(strings.HasSuffix(id1, "d.onion"), Equals, true)
+}
+
+func (s *WahayOnionKeySuite) Test_OnionKey_torPrivateKey_isTheExpandedKey(c *C) {
+	key, _ := NewOnionKey()
+
+	k, err := key.torPrivateKey()
+	c.Assert(err, IsNil)
+
+	raw, _ := base64.StdEncoding.DecodeString(k)
+	c.Assert(raw, HasLen, 64)
+	c.Assert(raw[0]&7, Equals, byte(0))
+	c.Assert(raw[31]&0xc0, Equals, byte(0x40))
+}
@@ -1,17 +1,71 @@
 package tor
 
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"time"
+)
+
 // Service is a representation of a service running through Tor
 type Service interface {
 	Close()
 	IsClosed() bool
 	OnClose(func())
+
+	// ExitStatus returns how the command of the service
+	// finished. It's only meaningful once it's closed
+	ExitStatus() ExitStatus
+
+	// Pid returns the process ID of the command of the service
+	Pid() int
+}
+
+// ExitStatus is how the command of a service finished
+type ExitStatus struct {
+	// Code is the exit code of the command,
+	// or -1 if it was killed by a signal
+	Code int
+
+	// Duration is how long the command was running
+	Duration time.Duration
+
+	// Closed is true when the service was stopped with Close
+	Closed bool
+
+	// Err is the error of the command, if it didn't finish successfully
+	Err error
+}
+
+// Crashed tells if the command finished by itself because something went
+// wrong. A command that exits successfully, like when the user quits it,
+// or that is stopped on purpose, has not crashed
+func (e ExitStatus) Crashed() bool {
+	return !e.Closed && e.Err != nil
+}
+
+func exitStatusOf(err error) ExitStatus {
+	status := ExitStatus{Err: err}
+
+	var ee *exec.ExitError
+	if errors.As(err, &ee) {
+		status.Code = ee.ExitCode()
+	} else if err != nil {
+		status.Code = -1
+	}
+
+	return status
 }
 
 type service struct {
+	sync.Mutex
 	rc *RunningCommand
 
 	onCloseFunctions []func()
 
+	started           time.Time
+	closing           bool
+	exitStatus        ExitStatus
 	finished          bool
 	finishedWithError error
 	finishChannel     chan bool
@@ -24,9 +78,25 @@ func (i *instance) NewService(cmd string, args []string, modifier ModifyCommand)
 		return nil, err
 	}
 
+	return newService(rc), nil
+}
+
+// NewProxiedService creates a new service for a command that doesn't need
+// torsocks, because it will connect through the Tor SOCKS proxy by itself
+func (i *instance) NewProxiedService(cmd string, args []string, modifier ModifyCommand) (Service, error) {
+	rc, err := i.execProxied(cmd, args, modifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return newService(rc), nil
+}
+
+func newService(rc *RunningCommand) *service {
 	s := &service{
 		rc:                rc,
 		onCloseFunctions:  nil,
+		started:           time.Now(),
 		finished:          false,
 		finishedWithError: nil,
 		finishChannel:     make(chan bool),
@@ -34,7 +104,7 @@ func (i *instance) NewService(cmd string, args []string, modifier ModifyCommand)
 
 	s.listenToFinish()
 
-	return s, nil
+	return s
 }
 
 func (s *service) IsClosed() bool {
@@ -42,9 +112,26 @@ func (s *service) IsClosed() bool {
 }
 
 func (s *service) Close() {
+	s.Lock()
+	s.closing = true
+	s.Unlock()
+
 	s.rc.CancelFunc()
 }
 
+func (s *service) ExitStatus() ExitStatus {
+	s.Lock()
+	defer s.Unlock()
+	return s.exitStatus
+}
+
+func (s *service) Pid() int {
+	if s.rc.Cmd.Process == nil {
+		return 0
+	}
+	return s.rc.Cmd.Process.Pid
+}
+
 func (s *service) OnClose(f func()) {
 	s.onCloseFunctions = append(s.onCloseFunctions, f)
 }
@@ -54,6 +141,13 @@ func (s *service) listenToFinish() {
 
 	go func() {
 		e := execf.WaitCommand(s.rc.Cmd)
+
+		s.Lock()
+		s.exitStatus = exitStatusOf(e)
+		s.exitStatus.Duration = time.Since(s.started)
+		s.exitStatus.Closed = s.closing
+		s.Unlock()
+
 		s.finished = true
 		s.finishedWithError = e
 		s.finishChannel <- true
@@ -0,0 +1,27 @@
+package tor
+
+import (
+	"errors"
+	"os/exec"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayServiceSuite struct{}
+
+var _ = Suite(&WahayServiceSuite{})
+
+func (s *WahayServiceSuite) Test_exitStatusOf_tellsACrashFromAQuit(c *C) {
+	quit := exitStatusOf(nil)
+	c.Assert(quit.Code, Equals, 0)
+	c.Assert(quit.Crashed(), Equals, false)
+
+	failed := exitStatusOf(exec.Command("sh", "-c", "exit 3").Run())
+	c.Assert(failed.Code, Equals, 3)
+	c.Assert(failed.Crashed(), Equals, true)
+
+	failed.Closed = true
+	c.Assert(failed.Crashed(), Equals, false)
+
+	c.Assert(exitStatusOf(errors.New("lost")).Code, Equals, -1)
+}